@@ -1,14 +1,29 @@
 package main
 
 import (
+	"LogWatcher/pkg/admin"
 	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/events"
+	"LogWatcher/pkg/health"
 	"LogWatcher/pkg/logger"
 	"LogWatcher/pkg/requests"
 	"LogWatcher/pkg/router"
 	"context"
+	"errors"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
 )
 
+const defaultShutdownTimeout = 30 * time.Second
+
 var ConfigPath = "config.yaml"
 
 func main() {
@@ -30,5 +45,125 @@ func main() {
 	if err != nil {
 		l.Fatalf("Failed to create Router: %s", err)
 	}
-	r.Listen()
+
+	var httpServer *http.Server
+	if cfg.Server.HTTPHost != "" {
+		mux := http.NewServeMux()
+		if cfg.Server.AdminToken != "" {
+			admin.NewHandler(r, cfg.Server.AdminToken, l).Register(mux)
+			admin.NewStatesHandler(r, cfg.Server.AdminToken).Register(mux)
+			if r.Outbox() != nil {
+				admin.NewUploadsHandler(r.Outbox(), cfg.Server.AdminToken, l).Register(mux)
+			}
+		} else {
+			l.Warn("AdminToken is not set, admin API is disabled")
+		}
+		eventsHandler := events.NewHandler(r.Events(), cfg.Server.EventsToken, l)
+		eventsHandler.Register(mux)
+		mux.Handle("/metrics", promhttp.Handler())
+		checker := health.NewChecker(cfg.Server.HealthTimeout)
+		checker.AddLiveness("udp_listener", r.CheckListener)
+		checker.AddReadiness("udp_listener", r.CheckListener)
+		checker.AddReadiness("mongo", r.CheckMongo)
+		checker.AddReadiness("workers", r.CheckWorkers)
+		checker.AddReadiness("pickup_api", r.CheckPickupAPI)
+		checker.Register(mux)
+		httpServer = &http.Server{Addr: cfg.Server.HTTPHost, Handler: mux}
+		httpServer.RegisterOnShutdown(eventsHandler.Close)
+		go func() {
+			l.Infof("HTTP API is listening on %s", cfg.Server.HTTPHost)
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				l.Fatalf("Failed to serve HTTP API: %s", err)
+			}
+		}()
+	}
+
+	// not bound to listenCtx, so matches uploaded on shutdown are announced as well, it is stopped by Close
+	go r.Notifier().Run(ctx)
+
+	listenCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if r.Outbox() != nil {
+		go r.Outbox().Run(listenCtx)
+	}
+	if r.Spool() != nil {
+		go r.Spool().Run(listenCtx)
+	}
+	if r.Archive() != nil {
+		go r.Archive().Run(listenCtx)
+	}
+
+	current := cfg
+	watcher := config.NewWatcher(ConfigPath, cfg.Server.ReloadInterval)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			l.Info("Received SIGHUP, reloading config")
+			watcher.Trigger()
+		}
+	}()
+	go watcher.Watch(listenCtx, func(newCfg *config.Config, err error) {
+		if err == nil {
+			err = config.CheckReload(current, newCfg)
+		}
+		if err != nil {
+			l.Errorf("Failed to reload config: %s", err)
+			return
+		}
+		reloadConfig(l, r, current, newCfg)
+		current = newCfg
+	})
+
+	if err = r.Listen(listenCtx); err != nil {
+		l.Fatalf("Router has stopped: %s", err)
+	}
+	stop()
+
+	timeout := cfg.Server.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	l.Infof("Shutting down, waiting up to %s for workers to finish", timeout)
+	shutdownCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if httpServer != nil {
+		if err = httpServer.Shutdown(shutdownCtx); err != nil {
+			l.Errorf("Failed to shutdown HTTP API: %s", err)
+		}
+	}
+	if err = r.Shutdown(shutdownCtx); err != nil {
+		l.Errorf("Failed to stop workers in time, matches in progress may be lost: %s", err)
+		return
+	}
+	if err = r.Notifier().Close(shutdownCtx); err != nil {
+		l.Errorf("Failed to deliver notifications in time, some of them are lost: %s", err)
+		return
+	}
+	l.Info("LogWatcher has stopped")
+}
+
+// reloadConfig applies changes of log level and client list to running app,
+// other server settings require restart
+func reloadConfig(l *logrus.Logger, r *router.Router, oldCfg, newCfg *config.Config) {
+	if newCfg.Server.LogLevel != oldCfg.Server.LogLevel {
+		if err := logger.SetLevel(l, newCfg.Server.LogLevel); err != nil {
+			l.Errorf("Failed to set log level %s: %s", newCfg.Server.LogLevel, err)
+		} else {
+			l.Infof("Log level changed to %s", newCfg.Server.LogLevel)
+		}
+	}
+	if newCfg.Server.Host != oldCfg.Server.Host || !reflect.DeepEqual(newCfg.Server.UDP, oldCfg.Server.UDP) ||
+		newCfg.Server.DSN != oldCfg.Server.DSN ||
+		newCfg.Server.APIKey != oldCfg.Server.APIKey || newCfg.Server.HTTPHost != oldCfg.Server.HTTPHost {
+		l.Warn("Server settings were changed in config, restart LogWatcher to apply them")
+	}
+	if !reflect.DeepEqual(newCfg.Sinks, oldCfg.Sinks) || !reflect.DeepEqual(newCfg.Webhooks, oldCfg.Webhooks) ||
+		!reflect.DeepEqual(newCfg.Server.PickupTokens, oldCfg.Server.PickupTokens) {
+		l.Warn("Sinks, webhooks or pickup tokens were changed in config, restart LogWatcher to apply them")
+	}
+	r.ApplyClients(oldCfg.Clients, newCfg.Clients)
+	l.Info("Config reloaded")
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"LogWatcher/pkg/packet"
 	"bufio"
 	"flag"
 	"fmt"
@@ -14,6 +15,7 @@ func main() {
 	logPath := flag.String("log", "", "Path to log file")
 	clientHost := flag.String("from", "localhost:27150", "Address of udp client")
 	serverHost := flag.String("to", "localhost:27100", "Address of LogWatcher app")
+	secret := flag.String("secret", "", "sv_logsecret value sent with every packet")
 	flag.Parse()
 
 	file, err := os.Open(*logPath)
@@ -41,7 +43,7 @@ func main() {
 	var counter int
 
 	for scanner.Scan() {
-		_, err = conn.Write([]byte(scanner.Text()))
+		_, err = conn.Write(packet.Encode(*secret, scanner.Text()))
 		if err != nil {
 			log.Printf("Failed to write to UDP socket: %s", err)
 		}
@@ -5,11 +5,15 @@ go 1.16
 require (
 	github.com/gojuno/minimock/v3 v3.0.10
 	github.com/google/go-cmp v0.5.6
+	github.com/gorilla/websocket v1.4.2
+	github.com/klauspost/compress v1.9.5
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/leighmacdonald/steamid v1.2.0
+	github.com/prometheus/client_golang v1.12.2
+	github.com/prometheus/client_model v0.2.0
 	github.com/rogpeppe/go-internal v1.8.0 // indirect
 	github.com/sirupsen/logrus v1.8.1
 	go.mongodb.org/mongo-driver v1.7.2
-	golang.org/x/sys v0.0.0-20210917161153-d61c044b1678 // indirect
+	golang.org/x/sys v0.0.0-20220114195835-da31bd327af9
 	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,249 @@
+package admin
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/requests"
+	"LogWatcher/pkg/router"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	clientsPath  = "/admin/clients"
+	hiddenSecret = "***"
+)
+
+// domainPattern allows only hostname-like domains, domain is a part of journal, spill and sink file names
+var domainPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`)
+
+// Registry manages game server clients at runtime
+type Registry interface {
+	Clients() []config.Client
+	AddClient(client config.Client) error
+	UpdateClient(client config.Client) error
+	RemoveClient(name string, discard bool) error
+}
+
+// Handler serves admin HTTP API:
+//
+//	GET    /admin/clients                         list running clients, secrets and pickup tokens are masked
+//	POST   /admin/clients                         add new client
+//	PUT    /admin/clients/<domain>/<id>           update client's address, secret, sinks, Discord webhook and pickup site,
+//	                                              omitted or masked secret and pickup token are kept
+//	DELETE /admin/clients/<domain>/<id>?discard=1 remove client, discarding match in progress
+type Handler struct {
+	Registry Registry
+	Token    string
+	Log      *logrus.Logger
+}
+
+// NewHandler is a factory for Handler, all requests are rejected if token is empty
+func NewHandler(registry Registry, token string, log *logrus.Logger) *Handler {
+	return &Handler{
+		Registry: registry,
+		Token:    token,
+		Log:      log,
+	}
+}
+
+// Register mounts admin endpoints to mux
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle(clientsPath, h)
+	mux.Handle(clientsPath+"/", h)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorize(w, r, h.Token) {
+		return
+	}
+
+	if r.URL.Path == clientsPath {
+		switch r.Method {
+		case http.MethodGet:
+			h.listClients(w)
+		case http.MethodPost:
+			h.addClient(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		}
+		return
+	}
+
+	client, ok := parseClientPath(clientsPath, r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		h.updateClient(w, r, client)
+	case http.MethodDelete:
+		h.removeClient(w, r, client)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+func (h *Handler) listClients(w http.ResponseWriter) {
+	clients := h.Registry.Clients()
+	for i := range clients {
+		if clients[i].Secret != "" {
+			clients[i].Secret = hiddenSecret
+		}
+		if clients[i].Pickup.Token != "" {
+			clients[i].Pickup.Token = hiddenSecret
+		}
+	}
+	writeJSON(w, http.StatusOK, clients)
+}
+
+func (h *Handler) addClient(w http.ResponseWriter, r *http.Request) {
+	var client config.Client
+	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if client.Domain == "" || client.Address == "" {
+		writeError(w, http.StatusBadRequest, errors.New("domain and address are required"))
+		return
+	}
+	if !domainPattern.MatchString(client.Domain) {
+		writeError(w, http.StatusBadRequest, errors.New("domain must be a hostname"))
+		return
+	}
+	if client.Secret == hiddenSecret || client.Pickup.Token == hiddenSecret {
+		writeError(w, http.StatusBadRequest, errors.New("masked secret can't be used as a value"))
+		return
+	}
+	if err := h.Registry.AddClient(client); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	h.Log.Infof("Client %s was added through admin API", client.Name())
+	w.WriteHeader(http.StatusCreated)
+}
+
+// clientUpdate is a body of PUT request, nil Secret and Token keep current values
+type clientUpdate struct {
+	Address string
+	Secret  *string
+	Sinks   []string
+	Discord string
+	Pickup  struct {
+		Provider string
+		URL      string
+		Token    *string
+	}
+}
+
+func (h *Handler) updateClient(w http.ResponseWriter, r *http.Request, client config.Client) {
+	var update clientUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if update.Address == "" {
+		writeError(w, http.StatusBadRequest, errors.New("address is required"))
+		return
+	}
+	client, ok := h.findClient(client.Name())
+	if !ok {
+		writeError(w, http.StatusNotFound, router.ErrClientNotFound)
+		return
+	}
+	client.Address = update.Address
+	client.Secret = keepHidden(client.Secret, update.Secret)
+	client.Sinks = update.Sinks
+	client.Discord = update.Discord
+	client.Pickup.Provider = update.Pickup.Provider
+	client.Pickup.URL = update.Pickup.URL
+	client.Pickup.Token = keepHidden(client.Pickup.Token, update.Pickup.Token)
+
+	if err := h.Registry.UpdateClient(client); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	h.Log.Infof("Client %s was updated through admin API", client.Name())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findClient returns running client by its name
+func (h *Handler) findClient(name string) (config.Client, bool) {
+	for _, client := range h.Registry.Clients() {
+		if client.Name() == name {
+			return client, true
+		}
+	}
+	return config.Client{}, false
+}
+
+// keepHidden returns updated secret, current one is kept if update omits it or sends it masked
+func keepHidden(current string, update *string) string {
+	if update == nil || *update == hiddenSecret {
+		return current
+	}
+	return *update
+}
+
+func (h *Handler) removeClient(w http.ResponseWriter, r *http.Request, client config.Client) {
+	discard, _ := strconv.ParseBool(r.URL.Query().Get("discard")) // false on bad values
+	if err := h.Registry.RemoveClient(client.Name(), discard); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	h.Log.Infof("Client %s was removed through admin API", client.Name())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseClientPath extracts client's domain and id from <prefix>/<domain>/<id>, e.g. /admin/clients/ru/1
+func parseClientPath(prefix, path string) (config.Client, bool) {
+	parts := strings.Split(strings.TrimPrefix(path, prefix+"/"), "/")
+	if len(parts) != 2 || !domainPattern.MatchString(parts[0]) {
+		return config.Client{}, false
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return config.Client{}, false
+	}
+	return config.Client{Domain: parts[0], Server: id}, true
+}
+
+// statusFor maps registry errors to HTTP statuses, unknown errors such as failed journal creation are internal
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, router.ErrClientNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, router.ErrUnknownSink), errors.Is(err, requests.ErrUnknownProvider):
+		return http.StatusBadRequest
+	case errors.Is(err, router.ErrClientExists), errors.Is(err, router.ErrAddressInUse), errors.Is(err, router.ErrSecretInUse):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// authorize checks Bearer token and writes 401 response if it doesn't match, every request is rejected if token is empty
+func authorize(w http.ResponseWriter, r *http.Request, token string) bool {
+	got := []byte(r.Header.Get("Authorization"))
+	if token == "" || subtle.ConstantTimeCompare(got, []byte("Bearer "+token)) != 1 {
+		writeError(w, http.StatusUnauthorized, errors.New("invalid token"))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,231 @@
+package admin_test
+
+import (
+	"LogWatcher/pkg/admin"
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/router"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tests := []struct {
+		name       string
+		registry   admin.Registry
+		method     string
+		path       string
+		body       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "list clients",
+			registry: mocks.NewRegistryMock(mc).ClientsMock.Return([]config.Client{
+				{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234"},
+				{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Pickup: config.Pickup{URL: "https://api.test", Token: "abcd"}},
+			}),
+			method:     http.MethodGet,
+			path:       "/admin/clients",
+			token:      "token",
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"ID":1,"Domain":"test","Address":"127.0.0.1:27150","Secret":"***","Pickup":{}},` +
+				`{"ID":2,"Domain":"test","Address":"127.0.0.1:27151","Pickup":{"URL":"https://api.test","Token":"***"}}]` + "\n",
+		},
+		{
+			name:       "bad token",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodGet,
+			path:       "/admin/clients",
+			token:      "bad",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "add client",
+			registry: mocks.NewRegistryMock(mc).AddClientMock.
+				Expect(config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151"}).Return(nil),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":2,"Domain":"test","Address":"127.0.0.1:27151"}`,
+			token:      "token",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "add existing client",
+			registry: mocks.NewRegistryMock(mc).AddClientMock.
+				Expect(config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150"}).Return(router.ErrClientExists),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":1,"Domain":"test","Address":"127.0.0.1:27150"}`,
+			token:      "token",
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "add client with failed journal",
+			registry: mocks.NewRegistryMock(mc).AddClientMock.
+				Expect(config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151"}).
+				Return(errors.New("failed to open journal: permission denied")),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":2,"Domain":"test","Address":"127.0.0.1:27151"}`,
+			token:      "token",
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "add client without address",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":1,"Domain":"test"}`,
+			token:      "token",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "add client bad json",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":`,
+			token:      "token",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "add client with bad domain",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":1,"Domain":"../test","Address":"127.0.0.1:27150"}`,
+			token:      "token",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "add client with masked secret",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodPost,
+			path:       "/admin/clients",
+			body:       `{"ID":1,"Domain":"test","Address":"127.0.0.1:27150","Secret":"***"}`,
+			token:      "token",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "update client",
+			registry: mocks.NewRegistryMock(mc).
+				ClientsMock.Return([]config.Client{{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234"}}).
+				UpdateClientMock.
+				Expect(config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27152", Secret: "4321"}).Return(nil),
+			method:     http.MethodPut,
+			path:       "/admin/clients/test/1",
+			body:       `{"Address":"127.0.0.1:27152","Secret":"4321"}`,
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "update client keeps omitted and masked secrets",
+			registry: mocks.NewRegistryMock(mc).
+				ClientsMock.Return([]config.Client{{
+				Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234", Pickup: config.Pickup{Token: "abcd"},
+			}}).
+				UpdateClientMock.Expect(config.Client{
+				Server: 1, Domain: "test", Address: "127.0.0.1:27152", Secret: "1234",
+				Pickup: config.Pickup{URL: "https://api.test", Token: "abcd"},
+			}).Return(nil),
+			method:     http.MethodPut,
+			path:       "/admin/clients/test/1",
+			body:       `{"Address":"127.0.0.1:27152","Pickup":{"URL":"https://api.test","Token":"***"}}`,
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "update client clears secret",
+			registry: mocks.NewRegistryMock(mc).
+				ClientsMock.Return([]config.Client{{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234"}}).
+				UpdateClientMock.Expect(config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150"}).Return(nil),
+			method:     http.MethodPut,
+			path:       "/admin/clients/test/1",
+			body:       `{"Address":"127.0.0.1:27150","Secret":""}`,
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "update unknown client",
+			registry:   mocks.NewRegistryMock(mc).ClientsMock.Return(nil),
+			method:     http.MethodPut,
+			path:       "/admin/clients/test/5",
+			body:       `{"Address":"127.0.0.1:27152"}`,
+			token:      "token",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "remove client",
+			registry:   mocks.NewRegistryMock(mc).RemoveClientMock.Expect("test#1", true).Return(nil),
+			method:     http.MethodDelete,
+			path:       "/admin/clients/test/1?discard=true",
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "bad client path",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodDelete,
+			path:       "/admin/clients/test/first",
+			token:      "token",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "method not allowed",
+			registry:   mocks.NewRegistryMock(mc),
+			method:     http.MethodPatch,
+			path:       "/admin/clients",
+			token:      "token",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			admin.NewHandler(tt.registry, "token", log).Register(mux)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("ServeHTTP() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("ServeHTTP() body = %v, want %v", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_EmptyToken(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	mux := http.NewServeMux()
+	admin.NewHandler(mocks.NewRegistryMock(mc), "", logrus.New()).Register(mux)
+	for _, auth := range []string{"", "Bearer "} {
+		req := httptest.NewRequest(http.MethodGet, "/admin/clients", nil)
+		req.Header.Set("Authorization", auth)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("ServeHTTP() with Authorization %q status = %v, want %v", auth, rec.Code, http.StatusUnauthorized)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	sm "LogWatcher/pkg/stateMachine"
+	"errors"
+	"net/http"
+)
+
+const statesPath = "/admin/states"
+
+// StateLister provides live state of running servers
+type StateLister interface {
+	States() []sm.Snapshot
+}
+
+// StatesHandler serves read-only admin HTTP API of live server states:
+//
+//	GET /admin/states               list states of all servers
+//	GET /admin/states/<domain>/<id> state of single server
+type StatesHandler struct {
+	States StateLister
+	Token  string
+}
+
+// NewStatesHandler is a factory for StatesHandler, all requests are rejected if token is empty
+func NewStatesHandler(states StateLister, token string) *StatesHandler {
+	return &StatesHandler{
+		States: states,
+		Token:  token,
+	}
+}
+
+// Register mounts state endpoints to mux
+func (h *StatesHandler) Register(mux *http.ServeMux) {
+	mux.Handle(statesPath, h)
+	mux.Handle(statesPath+"/", h)
+}
+
+func (h *StatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorize(w, r, h.Token) {
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	states := h.States.States()
+	if r.URL.Path == statesPath {
+		writeJSON(w, http.StatusOK, states)
+		return
+	}
+	client, ok := parseClientPath(statesPath, r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	for _, state := range states {
+		if state.Server == client.Name() {
+			writeJSON(w, http.StatusOK, state)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, errors.New("server not found"))
+}
@@ -0,0 +1,101 @@
+package admin_test
+
+import (
+	"LogWatcher/pkg/admin"
+	"LogWatcher/pkg/mocks"
+	sm "LogWatcher/pkg/stateMachine"
+	"LogWatcher/pkg/stats"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+func TestStatesHandler_ServeHTTP(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	states := []sm.Snapshot{
+		{Server: "test#1", State: "pregame", LastPacket: time.Unix(0, 0).UTC(), Players: map[string]stats.PlayerStats{}},
+		{
+			Server: "test#2", State: "game", Map: "cp_process_final", PickupID: 5,
+			Scores: stats.CurrentScores{Red: 1}, BufferSize: 100, LastPacket: time.Unix(0, 0).UTC(),
+			Players: map[string]stats.PlayerStats{"76561198439712695": {Kills: 1}}, Queued: 3, Dropped: 2,
+		},
+	}
+	tests := []struct {
+		name       string
+		states     admin.StateLister
+		method     string
+		path       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "list states",
+			states:     mocks.NewStateListerMock(mc).StatesMock.Return(states[:1]),
+			method:     http.MethodGet,
+			path:       "/admin/states",
+			token:      "token",
+			wantStatus: http.StatusOK,
+			wantBody: `[{"Server":"test#1","State":"pregame","Map":"","PickupID":0,"Scores":{"Red":0,"Blue":0},` +
+				`"BufferSize":0,"LastPacket":"1970-01-01T00:00:00Z","Players":{},"Queued":0,"Dropped":0}]` + "\n",
+		},
+		{
+			name:       "single server",
+			states:     mocks.NewStateListerMock(mc).StatesMock.Return(states),
+			method:     http.MethodGet,
+			path:       "/admin/states/test/2",
+			token:      "token",
+			wantStatus: http.StatusOK,
+			wantBody: `{"Server":"test#2","State":"game","Map":"cp_process_final","PickupID":5,"Scores":{"Red":1,"Blue":0},` +
+				`"BufferSize":100,"LastPacket":"1970-01-01T00:00:00Z","Players":{"76561198439712695":` +
+				`{"Kills":1,"Deaths":0,"DamageDone":0,"DamageTaken":0,"Healed":0,"HealsReceived":0}},"Queued":3,"Dropped":2}` + "\n",
+		},
+		{
+			name:       "unknown server",
+			states:     mocks.NewStateListerMock(mc).StatesMock.Return(states),
+			method:     http.MethodGet,
+			path:       "/admin/states/test/3",
+			token:      "token",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "bad token",
+			states:     mocks.NewStateListerMock(mc),
+			method:     http.MethodGet,
+			path:       "/admin/states",
+			token:      "bad",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "read only",
+			states:     mocks.NewStateListerMock(mc),
+			method:     http.MethodDelete,
+			path:       "/admin/states/test/1",
+			token:      "token",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			admin.NewStatesHandler(tt.states, "token").Register(mux)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("ServeHTTP() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("ServeHTTP() body = %v, want %v", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"LogWatcher/pkg/outbox"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const uploadsPath = "/admin/uploads"
+
+// UploadQueue manages failed logs.tf uploads waiting for retry
+type UploadQueue interface {
+	List() ([]outbox.Entry, error)
+	Retry(ctx context.Context, id string) error
+	Drop(id string) error
+}
+
+// UploadsHandler serves admin HTTP API of upload queue:
+//
+//	GET    /admin/uploads            list queued uploads
+//	POST   /admin/uploads/<id>/retry upload immediately
+//	DELETE /admin/uploads/<id>       drop upload from queue
+type UploadsHandler struct {
+	Queue UploadQueue
+	Token string
+	Log   *logrus.Logger
+}
+
+// NewUploadsHandler is a factory for UploadsHandler, all requests are rejected if token is empty
+func NewUploadsHandler(queue UploadQueue, token string, log *logrus.Logger) *UploadsHandler {
+	return &UploadsHandler{
+		Queue: queue,
+		Token: token,
+		Log:   log,
+	}
+}
+
+// Register mounts upload queue endpoints to mux
+func (h *UploadsHandler) Register(mux *http.ServeMux) {
+	mux.Handle(uploadsPath, h)
+	mux.Handle(uploadsPath+"/", h)
+}
+
+func (h *UploadsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorize(w, r, h.Token) {
+		return
+	}
+
+	if r.URL.Path == uploadsPath {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		entries, err := h.Queue.List()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, entries)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, uploadsPath+"/"), "/")
+	switch {
+	case len(parts) == 2 && parts[1] == "retry" && r.Method == http.MethodPost:
+		h.retry(r.Context(), w, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		h.drop(w, parts[0])
+	case len(parts) == 1 || len(parts) == 2 && parts[1] == "retry":
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func (h *UploadsHandler) retry(ctx context.Context, w http.ResponseWriter, id string) {
+	if err := h.Queue.Retry(ctx, id); err != nil {
+		status := http.StatusBadGateway
+		switch {
+		case errors.Is(err, outbox.ErrEntryNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, outbox.ErrEntryInFlight):
+			status = http.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+	h.Log.Infof("Queued upload %s was uploaded through admin API", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UploadsHandler) drop(w http.ResponseWriter, id string) {
+	if err := h.Queue.Drop(id); err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, outbox.ErrEntryNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, outbox.ErrEntryInFlight):
+			status = http.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+	h.Log.Infof("Queued upload %s was dropped through admin API", id)
+	w.WriteHeader(http.StatusNoContent)
+}
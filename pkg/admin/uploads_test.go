@@ -0,0 +1,121 @@
+package admin_test
+
+import (
+	"LogWatcher/pkg/admin"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/outbox"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sirupsen/logrus"
+)
+
+func TestUploadsHandler_ServeHTTP(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tests := []struct {
+		name       string
+		queue      admin.UploadQueue
+		method     string
+		path       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "list uploads",
+			queue: mocks.NewUploadQueueMock(mc).ListMock.Return([]outbox.Entry{
+				{ID: "abcd", Server: "test#1", Title: "tf2pickup.test #1", Attempts: 2, CreatedAt: time.Unix(0, 0).UTC()},
+			}, nil),
+			method:     http.MethodGet,
+			path:       "/admin/uploads",
+			token:      "token",
+			wantStatus: http.StatusOK,
+			wantBody: `[{"id":"abcd","server":"test#1","domain":"","pickup_id":0,"map":"","title":"tf2pickup.test #1",` +
+				`"attempts":2,"next_attempt":"0001-01-01T00:00:00Z","created_at":"1970-01-01T00:00:00Z","dead":false}]` + "\n",
+		},
+		{
+			name:       "bad token",
+			queue:      mocks.NewUploadQueueMock(mc),
+			method:     http.MethodGet,
+			path:       "/admin/uploads",
+			token:      "bad",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "retry upload",
+			queue:      mocks.NewUploadQueueMock(mc).RetryMock.Expect(context.Background(), "abcd").Return(nil),
+			method:     http.MethodPost,
+			path:       "/admin/uploads/abcd/retry",
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "retry failed",
+			queue:      mocks.NewUploadQueueMock(mc).RetryMock.Expect(context.Background(), "abcd").Return(errors.New("test error")),
+			method:     http.MethodPost,
+			path:       "/admin/uploads/abcd/retry",
+			token:      "token",
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "drop upload",
+			queue:      mocks.NewUploadQueueMock(mc).DropMock.Expect("abcd").Return(nil),
+			method:     http.MethodDelete,
+			path:       "/admin/uploads/abcd",
+			token:      "token",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "drop unknown upload",
+			queue:      mocks.NewUploadQueueMock(mc).DropMock.Expect("abcd").Return(outbox.ErrEntryNotFound),
+			method:     http.MethodDelete,
+			path:       "/admin/uploads/abcd",
+			token:      "token",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "method not allowed",
+			queue:      mocks.NewUploadQueueMock(mc),
+			method:     http.MethodGet,
+			path:       "/admin/uploads/abcd/retry",
+			token:      "token",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "bad path",
+			queue:      mocks.NewUploadQueueMock(mc),
+			method:     http.MethodPost,
+			path:       "/admin/uploads/abcd/upload",
+			token:      "token",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			admin.NewUploadsHandler(tt.queue, "token", log).Register(mux)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("ServeHTTP() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("ServeHTTP() body = %v, want %v", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package archive
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/internal/fileutil"
+	"LogWatcher/pkg/stats"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	Gzip = "gzip"
+	Zstd = "zstd"
+
+	DefaultCleanInterval = time.Hour
+	metaExtension        = ".json"
+	timeFormat           = "20060102T150405"
+)
+
+var logExtensions = map[string]string{
+	Gzip: ".log.gz",
+	Zstd: ".log.zst",
+}
+
+// Archiver is implemented by archives of finished match logs
+type Archiver interface {
+	Store(info stats.MongoMatchInfo, log bytes.Buffer) error
+}
+
+// Metadata is stored in JSON sidecar next to compressed log
+type Metadata struct {
+	stats.MongoMatchInfo
+	File        string
+	Compression string
+	ArchivedAt  time.Time
+}
+
+// Archive keeps compressed logs of finished matches in local directory,
+// each match is stored as <domain>_<server id>_<pickup id>_<end time>.log.gz (or .log.zst) with .json metadata
+type Archive struct {
+	dir           string
+	compression   string
+	maxAge        time.Duration
+	maxSize       int64
+	cleanInterval time.Duration
+	log           *logrus.Logger
+	now           func() time.Time
+}
+
+// New creates archive in configured dir, creating it if needed
+func New(cfg config.Archive, log *logrus.Logger) (*Archive, error) {
+	compression := cfg.Compression
+	if compression == "" {
+		compression = Gzip
+	}
+	if _, ok := logExtensions[compression]; !ok {
+		return nil, fmt.Errorf("unknown archive compression: %s", compression)
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	a := &Archive{
+		dir:           cfg.Dir,
+		compression:   compression,
+		maxAge:        cfg.MaxAge,
+		maxSize:       cfg.MaxSizeMB << 20,
+		cleanInterval: cfg.CleanInterval,
+		log:           log,
+		now:           time.Now,
+	}
+	if a.cleanInterval <= 0 {
+		a.cleanInterval = DefaultCleanInterval
+	}
+	return a, nil
+}
+
+// Name returns base name of archived match, e.g. "ru_1_123_20211017T220951"
+func Name(info stats.MongoMatchInfo) string {
+	return fmt.Sprintf("%s_%d_%s", strings.Replace(info.Server, "#", "_", 1), info.PickupID,
+		info.EndedAt.UTC().Format(timeFormat))
+}
+
+// Store compresses log and saves it along with match metadata
+func (a *Archive) Store(info stats.MongoMatchInfo, log bytes.Buffer) error {
+	if info.EndedAt.IsZero() {
+		info.EndedAt = a.now()
+	}
+	name := Name(info)
+	var compressed bytes.Buffer
+	if err := a.compress(&compressed, log.Bytes()); err != nil {
+		return err
+	}
+	file := name + logExtensions[a.compression]
+	if err := fileutil.WriteFile(filepath.Join(a.dir, file), compressed.Bytes()); err != nil {
+		return err
+	}
+	metadata, err := json.Marshal(Metadata{
+		MongoMatchInfo: info,
+		File:           file,
+		Compression:    a.compression,
+		ArchivedAt:     a.now(),
+	})
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFile(filepath.Join(a.dir, name+metaExtension), metadata)
+}
+
+func (a *Archive) compress(w io.Writer, log []byte) error {
+	var cw io.WriteCloser
+	switch a.compression {
+	case Zstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return err
+		}
+		cw = zw
+	default:
+		cw = gzip.NewWriter(w)
+	}
+	if _, err := cw.Write(log); err != nil {
+		cw.Close()
+		return err
+	}
+	return cw.Close()
+}
+
+// Run removes expired matches every clean interval until ctx is done
+func (a *Archive) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.cleanInterval)
+	defer ticker.Stop()
+	for {
+		if removed, err := a.Clean(); err != nil {
+			a.log.Errorf("Failed to clean archive: %s", err)
+		} else if removed > 0 {
+			a.log.Infof("Removed %d matches from archive", removed)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// archived is a match stored in archive with all its files
+type archived struct {
+	files   []string
+	size    int64
+	modTime time.Time
+}
+
+// Clean enforces retention policy: matches older than max age are removed,
+// then oldest ones are removed until total size fits max size. Zero limits are not enforced
+func (a *Archive) Clean() (int, error) {
+	matches, err := a.list()
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, m := range matches {
+		total += m.size
+	}
+	removed := 0
+	for _, m := range matches {
+		expired := a.maxAge > 0 && a.now().Sub(m.modTime) > a.maxAge
+		oversized := a.maxSize > 0 && total > a.maxSize
+		if !expired && !oversized {
+			break
+		}
+		for _, f := range m.files {
+			if err = os.Remove(filepath.Join(a.dir, f)); err != nil && !os.IsNotExist(err) {
+				return removed, err
+			}
+		}
+		total -= m.size
+		removed++
+	}
+	return removed, nil
+}
+
+// list returns archived matches ordered from oldest to newest
+func (a *Archive) list() ([]*archived, error) {
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*archived)
+	for _, e := range entries {
+		name, ok := baseName(e.Name())
+		if e.IsDir() || !ok {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		m, ok := byName[name]
+		if !ok {
+			m = &archived{}
+			byName[name] = m
+		}
+		m.files = append(m.files, e.Name())
+		m.size += info.Size()
+		if info.ModTime().After(m.modTime) {
+			m.modTime = info.ModTime()
+		}
+	}
+	matches := make([]*archived, 0, len(byName))
+	for _, m := range byName {
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].modTime.Before(matches[j].modTime)
+	})
+	return matches, nil
+}
+
+// baseName strips archive extensions from file name, temporary and unknown files are skipped
+func baseName(file string) (string, bool) {
+	if strings.HasSuffix(file, metaExtension) {
+		return strings.TrimSuffix(file, metaExtension), true
+	}
+	for _, ext := range logExtensions {
+		if strings.HasSuffix(file, ext) {
+			return strings.TrimSuffix(file, ext), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,158 @@
+package archive
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/stats"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+)
+
+func testInfo(pickupID int) stats.MongoMatchInfo {
+	return stats.MongoMatchInfo{
+		Server:   "test#1",
+		Domain:   "test",
+		PickupID: pickupID,
+		Map:      "cp_process_final",
+		EndedAt:  time.Date(2021, 10, 17, 22, 9, 51, 0, time.UTC),
+	}
+}
+
+func TestArchive_Store(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	tests := []struct {
+		compression string
+		file        string
+		decompress  func(r io.Reader) (io.Reader, error)
+	}{
+		{
+			compression: "",
+			file:        "test_1_5_20211017T220951.log.gz",
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			compression: Zstd,
+			file:        "test_1_5_20211017T220951.log.zst",
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return zstd.NewReader(r)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			dir := t.TempDir()
+			a, err := New(config.Archive{Dir: dir, Compression: tt.compression}, log)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if err = a.Store(testInfo(5), *bytes.NewBufferString("log line\n")); err != nil {
+				t.Fatalf("Store() error = %v", err)
+			}
+
+			f, err := os.Open(filepath.Join(dir, tt.file))
+			if err != nil {
+				t.Fatalf("Store() log is not stored: %v", err)
+			}
+			defer f.Close()
+			r, err := tt.decompress(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := ioutil.ReadAll(r); err != nil || string(got) != "log line\n" {
+				t.Errorf("Store() stored log = %q, err = %v", got, err)
+			}
+
+			raw, err := os.ReadFile(filepath.Join(dir, "test_1_5_20211017T220951.json"))
+			if err != nil {
+				t.Fatalf("Store() metadata is not stored: %v", err)
+			}
+			var metadata Metadata
+			if err = json.Unmarshal(raw, &metadata); err != nil {
+				t.Fatal(err)
+			}
+			if metadata.PickupID != 5 || metadata.Map != "cp_process_final" || metadata.File != tt.file {
+				t.Errorf("Store() stored metadata = %s", raw)
+			}
+		})
+	}
+}
+
+func TestNew_UnknownCompression(t *testing.T) {
+	if _, err := New(config.Archive{Dir: t.TempDir(), Compression: "lz4"}, logrus.New()); err == nil {
+		t.Errorf("New() expected error on unknown compression")
+	}
+}
+
+func TestArchive_Clean(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		cfg         config.Archive
+		wantRemoved int
+		wantKept    []int
+	}{
+		{name: "no limits", wantKept: []int{1, 2, 3}},
+		{name: "max age", cfg: config.Archive{MaxAge: 36 * time.Hour}, wantRemoved: 1, wantKept: []int{2, 3}},
+		{name: "max size", cfg: config.Archive{MaxSizeMB: 1}, wantRemoved: 2, wantKept: []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Dir = t.TempDir()
+			a, err := New(tt.cfg, log)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// pickups are stored 2 days, 1 day and 1 hour ago, 600KB each
+			for i, age := range []time.Duration{48 * time.Hour, 24 * time.Hour, time.Hour} {
+				info := testInfo(i + 1)
+				if err = a.Store(info, bytes.Buffer{}); err != nil {
+					t.Fatal(err)
+				}
+				name := filepath.Join(tt.cfg.Dir, Name(info))
+				if err = os.WriteFile(name+".log.gz", make([]byte, 600<<10), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				for _, ext := range []string{".log.gz", ".json"} {
+					os.Chtimes(name+ext, now.Add(-age), now.Add(-age))
+				}
+			}
+			os.WriteFile(filepath.Join(tt.cfg.Dir, "unrelated.txt"), nil, 0o644)
+
+			removed, err := a.Clean()
+			if err != nil {
+				t.Fatalf("Clean() error = %v", err)
+			}
+			if removed != tt.wantRemoved {
+				t.Errorf("Clean() removed = %d, want %d", removed, tt.wantRemoved)
+			}
+			matches, _ := a.list()
+			if len(matches) != len(tt.wantKept) {
+				t.Fatalf("Clean() kept %d matches, want %d", len(matches), len(tt.wantKept))
+			}
+			for _, id := range tt.wantKept {
+				if _, err = os.Stat(filepath.Join(tt.cfg.Dir, Name(testInfo(id))+".json")); err != nil {
+					t.Errorf("Clean() removed pickup %d", id)
+				}
+			}
+			if _, err = os.Stat(filepath.Join(tt.cfg.Dir, "unrelated.txt")); err != nil {
+				t.Errorf("Clean() removed unrelated file")
+			}
+		})
+	}
+}
@@ -1,31 +1,167 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"reflect"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
+var (
+	ErrEmptyConfig = errors.New("config is empty")
+	ErrNoClients   = errors.New("config has no clients")
+)
+
 type Client struct {
-	Server  int    `yaml:"ID"`
-	Domain  string `yaml:"Domain"`
-	Address string `yaml:"Address"`
+	Server int `yaml:"ID" json:"ID"`
+	// Domain is a suffix of tf2pickup site, e.g. ru for tf2pickup.ru, or any name for servers not tied to tf2pickup
+	Domain  string `yaml:"Domain" json:"Domain"`
+	Address string `yaml:"Address" json:"Address"`
+	Secret  string `yaml:"Secret" json:"Secret,omitempty"`
+	// Sinks are names of upload destinations, logs.tf only if empty
+	Sinks []string `yaml:"Sinks" json:"Sinks,omitempty"`
+	// Discord is url of Discord webhook receiving summary of every finished match
+	Discord string `yaml:"Discord" json:"Discord,omitempty"`
+	Pickup  Pickup `yaml:"Pickup" json:"Pickup"`
+}
+
+// Pickup configures pickup site of client, Provider is tf2pickup (default) or none for servers not tied to any site.
+// URL is base URL of tf2pickup API, https://api.tf2pickup.<Domain> if empty,
+// Token is used to report logs urls, token of domain from PickupTokens is used if it is empty
+type Pickup struct {
+	Provider string `yaml:"Provider" json:"Provider,omitempty"`
+	URL      string `yaml:"URL" json:"URL,omitempty"`
+	Token    string `yaml:"Token" json:"Token,omitempty"`
+}
+
+// Name returns client's unique key, e.g. "ru#1"
+func (c Client) Name() string {
+	return fmt.Sprintf("%s#%d", c.Domain, c.Server)
 }
 
 type Server struct {
-	Host            string `yaml:"Host"`
-	APIKey          string `yaml:"APIKey"`
-	DSN             string `yaml:"DSN"`
-	MongoDatabase   string `yaml:"MongoDatabase"`
-	MongoCollection string `yaml:"MongoCollection"`
-	LogLevel        string `yaml:"LogLevel"`
+	Host                 string        `yaml:"Host"`
+	APIKey               string        `yaml:"APIKey"`
+	DSN                  string        `yaml:"DSN"`
+	MongoDatabase        string        `yaml:"MongoDatabase"`
+	MongoCollection      string        `yaml:"MongoCollection"`
+	MongoMatchCollection string        `yaml:"MongoMatchCollection"`
+	LogLevel             string        `yaml:"LogLevel"`
+	HTTPHost             string        `yaml:"HTTPHost"`
+	AdminToken           string        `yaml:"AdminToken"`
+	EventsToken          string        `yaml:"EventsToken"`
+	ReloadInterval       time.Duration `yaml:"ReloadInterval"`
+	ShutdownTimeout      time.Duration `yaml:"ShutdownTimeout"`
+	HealthTimeout        time.Duration `yaml:"HealthTimeout"`
+	Journal              Journal       `yaml:"Journal"`
+	Outbox               Outbox        `yaml:"Outbox"`
+	MongoSpool           MongoSpool    `yaml:"MongoSpool"`
+	Archive              Archive       `yaml:"Archive"`
+	Queue                Queue         `yaml:"Queue"`
+	UDP                  UDP           `yaml:"UDP"`
+	Timeouts             Timeouts      `yaml:"Timeouts"`
+	// Finalizers is a number of workers uploading and saving finished matches
+	Finalizers int `yaml:"Finalizers"`
+	// PickupTokens are tf2pickup API tokens by domain, used to report logs urls
+	PickupTokens map[string]string `yaml:"PickupTokens"`
+}
+
+// Journal configures on-disk journaling of matches in progress, empty Dir disables it
+type Journal struct {
+	Dir          string        `yaml:"Dir"`
+	Sync         string        `yaml:"Sync"`
+	SyncInterval time.Duration `yaml:"SyncInterval"`
+	ResumeWindow time.Duration `yaml:"ResumeWindow"`
+}
+
+// UDP configures log sockets, Hosts are listened instead of Host if set, e.g. "[::]:27100" for dual stack.
+// Every address is read by Readers sockets sharing it with SO_REUSEPORT, ReadBufferKB sets their receive buffer
+type UDP struct {
+	Hosts        []string `yaml:"Hosts"`
+	Readers      int      `yaml:"Readers"`
+	ReadBufferKB int      `yaml:"ReadBufferKB"`
+}
+
+// Timeouts limit single requests of state machines: tf2pickup API calls, logs.tf uploads and db inserts
+type Timeouts struct {
+	PickupAPI time.Duration `yaml:"PickupAPI"`
+	Upload    time.Duration `yaml:"Upload"`
+	Mongo     time.Duration `yaml:"Mongo"`
+}
+
+// Queue configures per-server queues of log lines, Policy is applied when queue of Size lines is full:
+// drop_oldest (default), block or spill to SpillDir up to MaxSpillMB
+type Queue struct {
+	Size       int    `yaml:"Size"`
+	Policy     string `yaml:"Policy"`
+	SpillDir   string `yaml:"SpillDir"`
+	MaxSpillMB int64  `yaml:"MaxSpillMB"`
+}
+
+// Outbox configures on-disk queue of failed logs.tf uploads, empty Dir disables it
+type Outbox struct {
+	Dir         string        `yaml:"Dir"`
+	MaxAttempts int           `yaml:"MaxAttempts"`
+	BaseDelay   time.Duration `yaml:"BaseDelay"`
+	MaxDelay    time.Duration `yaml:"MaxDelay"`
+}
+
+// MongoSpool configures on-disk spool of stats failed to insert to db, empty Dir disables it
+type MongoSpool struct {
+	Dir            string        `yaml:"Dir"`
+	ReplayInterval time.Duration `yaml:"ReplayInterval"`
+}
+
+// Archive configures local archive of compressed match logs, empty Dir disables it.
+// Compression is gzip or zstd, matches older than MaxAge are removed and oldest ones are removed
+// while archive is bigger than MaxSizeMB, zero values disable these limits
+type Archive struct {
+	Dir           string        `yaml:"Dir"`
+	Compression   string        `yaml:"Compression"`
+	MaxAge        time.Duration `yaml:"MaxAge"`
+	MaxSizeMB     int64         `yaml:"MaxSizeMB"`
+	CleanInterval time.Duration `yaml:"CleanInterval"`
+}
+
+// Webhook configures notifications of match lifecycle posted to URL, Events are any of
+// match_start, match_end and upload_failed, all events are sent if it is empty.
+// Requests are signed with Secret, failed deliveries are retried MaxAttempts times starting with BaseDelay
+type Webhook struct {
+	Name        string        `yaml:"Name"`
+	URL         string        `yaml:"URL"`
+	Secret      string        `yaml:"Secret"`
+	Events      []string      `yaml:"Events"`
+	MaxAttempts int           `yaml:"MaxAttempts"`
+	BaseDelay   time.Duration `yaml:"BaseDelay"`
+}
+
+// Sink configures upload destination, fields used depend on Type: URL and APIKey for logstf,
+// URL for webhook, Dir for directory, Endpoint, Region, Bucket, Prefix and keys for s3
+type Sink struct {
+	Name      string `yaml:"Name"`
+	Type      string `yaml:"Type"`
+	URL       string `yaml:"URL"`
+	APIKey    string `yaml:"APIKey"`
+	Dir       string `yaml:"Dir"`
+	Endpoint  string `yaml:"Endpoint"`
+	Region    string `yaml:"Region"`
+	Bucket    string `yaml:"Bucket"`
+	Prefix    string `yaml:"Prefix"`
+	AccessKey string `yaml:"AccessKey"`
+	SecretKey string `yaml:"SecretKey"`
 }
 
 type Config struct {
-	Server  Server   `yaml:"Server"`
-	Clients []Client `yaml:"Clients"`
+	Server   Server    `yaml:"Server"`
+	Sinks    []Sink    `yaml:"Sinks"`
+	Webhooks []Webhook `yaml:"Webhooks"`
+	Clients  []Client  `yaml:"Clients"`
 }
 
+// LoadConfig reads config from path, empty config is rejected
 func LoadConfig(path string) (*Config, error) {
 	var config *Config
 	yamlFile, err := os.ReadFile(path)
@@ -35,5 +171,43 @@ func LoadConfig(path string) (*Config, error) {
 	if err = yaml.Unmarshal(yamlFile, &config); err != nil {
 		return nil, err
 	}
+	if config == nil {
+		return nil, ErrEmptyConfig
+	}
 	return config, nil
 }
+
+// CheckReload rejects reloaded config without clients if previous one had them,
+// it is likely a partly written file. Config without clients is fine at startup,
+// as all of them may be added with admin API
+func CheckReload(old, new *Config) error {
+	if len(new.Clients) == 0 && len(old.Clients) > 0 {
+		return ErrNoClients
+	}
+	return nil
+}
+
+// DiffClients compares client lists by client names and returns
+// clients which were added, removed or had any of their settings changed
+func DiffClients(old, new []Client) (added, removed, changed []Client) {
+	oldClients := make(map[string]Client, len(old))
+	for _, c := range old {
+		oldClients[c.Name()] = c
+	}
+	for _, c := range new {
+		oldClient, ok := oldClients[c.Name()]
+		switch {
+		case !ok:
+			added = append(added, c)
+		case !reflect.DeepEqual(oldClient, c):
+			changed = append(changed, c)
+		}
+		delete(oldClients, c.Name())
+	}
+	for _, c := range old {
+		if _, ok := oldClients[c.Name()]; ok {
+			removed = append(removed, c)
+		}
+	}
+	return added, removed, changed
+}
@@ -1,15 +1,19 @@
 package config
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 const (
-	testCfgPath = `test_config.yaml`
-	fakeCfgPath = `fake_config.yaml`
-	badCfgPath  = `bad_config.yaml`
+	testCfgPath      = `test_config.yaml`
+	fakeCfgPath      = `fake_config.yaml`
+	badCfgPath       = `bad_config.yaml`
+	emptyCfgPath     = `empty_config.yaml`
+	noClientsCfgPath = `no_clients_config.yaml`
 )
 
 func Test_LoadConfig(t *testing.T) {
@@ -33,9 +37,21 @@ func Test_LoadConfig(t *testing.T) {
 					MongoDatabase:   "db",
 					MongoCollection: "collection",
 					LogLevel:        "level",
+					HTTPHost:        "localhost:8080",
+					AdminToken:      "token",
+					ReloadInterval:  5 * time.Second,
+					ShutdownTimeout: time.Minute,
+					PickupTokens:    map[string]string{"test": "pickupToken"},
+				},
+				Sinks: []Sink{
+					{Name: "archive", Type: "directory", Dir: "logs"},
+				},
+				Webhooks: []Webhook{
+					{Name: "backend", URL: "http://backend/hooks", Secret: "hookSecret", Events: []string{"match_end"}},
 				},
 				Clients: []Client{
-					{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+					{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234", Sinks: []string{"logstf", "archive"}, Discord: "http://discord/webhook",
+						Pickup: Pickup{URL: "https://api.pickup.test", Token: "clientToken"}},
 				},
 			},
 			wantErr: false,
@@ -46,6 +62,17 @@ func Test_LoadConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "empty file",
+			args:    args{emptyCfgPath},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "no clients",
+			args: args{noClientsCfgPath},
+			want: &Config{Server: Server{Host: "localhost:27100"}},
+		},
 		{
 			name:    "bad yaml",
 			args:    args{badCfgPath},
@@ -66,3 +93,54 @@ func Test_LoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckReload(t *testing.T) {
+	withClients := &Config{Clients: []Client{{Server: 1, Domain: "test"}}}
+	tests := []struct {
+		name    string
+		old     *Config
+		new     *Config
+		wantErr error
+	}{
+		{name: "clients", old: withClients, new: withClients},
+		{name: "clients removed", old: withClients, new: &Config{}, wantErr: ErrNoClients},
+		{name: "no clients before", old: &Config{}, new: &Config{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckReload(tt.old, tt.new); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckReload() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_Name(t *testing.T) {
+	c := Client{Server: 1, Domain: "test"}
+	if got := c.Name(); got != "test#1" {
+		t.Errorf("Name() = %v, want %v", got, "test#1")
+	}
+}
+
+func TestDiffClients(t *testing.T) {
+	old := []Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "test", Address: "127.0.0.1:27151"},
+		{Server: 3, Domain: "test", Address: "127.0.0.1:27152"},
+	}
+	new := []Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Secret: "1234"},
+		{Server: 4, Domain: "test", Address: "127.0.0.1:27153"},
+	}
+	added, removed, changed := DiffClients(old, new)
+	if want := []Client{new[2]}; !cmp.Equal(added, want) {
+		t.Errorf("DiffClients() added = %v, want %v", added, want)
+	}
+	if want := []Client{old[2]}; !cmp.Equal(removed, want) {
+		t.Errorf("DiffClients() removed = %v, want %v", removed, want)
+	}
+	if want := []Client{new[1]}; !cmp.Equal(changed, want) {
+		t.Errorf("DiffClients() changed = %v, want %v", changed, want)
+	}
+}
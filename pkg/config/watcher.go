@@ -0,0 +1,75 @@
+package config
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+const DefaultReloadInterval = 10 * time.Second
+
+// Watcher polls config file for changes and reloads it,
+// reload also can be forced with Trigger, e.g. on SIGHUP
+type Watcher struct {
+	path     string
+	interval time.Duration
+	modTime  time.Time
+	trigger  chan struct{}
+}
+
+// NewWatcher is a factory for Watcher, zero interval means DefaultReloadInterval
+func NewWatcher(path string, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		trigger:  make(chan struct{}, 1),
+	}
+	if info, err := os.Stat(path); err == nil {
+		w.modTime = info.ModTime()
+	}
+	return w
+}
+
+// Trigger forces config reload on next Watch iteration
+func (w *Watcher) Trigger() {
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Watch calls onReload with freshly loaded config every time file is modified or reload is triggered,
+// it blocks until ctx is done
+func (w *Watcher) Watch(ctx context.Context, onReload func(cfg *Config, err error)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.trigger:
+			w.updateModTime()
+			onReload(LoadConfig(w.path))
+		case <-ticker.C:
+			if w.updateModTime() {
+				onReload(LoadConfig(w.path))
+			}
+		}
+	}
+}
+
+// updateModTime saves file's modification time and reports whether it has changed
+func (w *Watcher) updateModTime() bool {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false
+	}
+	if info.ModTime().Equal(w.modTime) {
+		return false
+	}
+	w.modTime = info.ModTime()
+	return true
+}
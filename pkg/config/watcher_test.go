@@ -0,0 +1,57 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcher_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("Server:\n  LogLevel: info\nClients:\n  - ID: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := NewWatcher(path, 10*time.Millisecond)
+	reloaded := make(chan *Config)
+	go w.Watch(ctx, func(cfg *Config, err error) {
+		if err != nil {
+			t.Errorf("Watch() error = %v", err)
+		}
+		reloaded <- cfg
+	})
+
+	if err := os.WriteFile(path, []byte("Server:\n  LogLevel: debug\nClients:\n  - ID: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// modification time may have coarse resolution, so make sure it differs
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case cfg := <-reloaded:
+		if cfg.Server.LogLevel != "debug" {
+			t.Errorf("Watch() LogLevel = %v, want debug", cfg.Server.LogLevel)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watch() config was not reloaded after file change")
+	}
+
+	w.Trigger()
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		t.Fatal("Watch() config was not reloaded after Trigger()")
+	}
+}
+
+func TestNewWatcher(t *testing.T) {
+	if w := NewWatcher(testCfgPath, 0); w.interval != DefaultReloadInterval {
+		t.Errorf("NewWatcher() interval = %v, want %v", w.interval, DefaultReloadInterval)
+	}
+}
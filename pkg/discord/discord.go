@@ -0,0 +1,159 @@
+package discord
+
+import (
+	"LogWatcher/pkg/stats"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	colorRed  = 0xB8383B
+	colorBlue = 0x5885A2
+	colorTie  = 0x99AAB5
+	// topPlayers is a number of players in each leaderboard of embed
+	topPlayers = 3
+	username   = "LogWatcher"
+	// placeholder replaces empty field values, Discord rejects embeds with them
+	placeholder = "-"
+)
+
+// Message is a body of Discord webhook request
+type Message struct {
+	Username string  `json:"username,omitempty"`
+	Embeds   []Embed `json:"embeds"`
+}
+
+// Embed is a rich message block, see https://discord.com/developers/docs/resources/channel#embed-object
+type Embed struct {
+	Title       string  `json:"title"`
+	URL         string  `json:"url,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Color       int     `json:"color"`
+	Fields      []Field `json:"fields,omitempty"`
+	Footer      *Footer `json:"footer,omitempty"`
+	Timestamp   string  `json:"timestamp,omitempty"`
+}
+
+type Field struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+type Footer struct {
+	Text string `json:"text"`
+}
+
+// entry is a single line of leaderboard
+type entry struct {
+	name  string
+	value int
+}
+
+// MatchEnd builds message with given title announcing finished match with final score, duration,
+// leaderboards of players and link to logs.tf, players are named by their pickup names if they are known.
+// Failed upload is mentioned only if logsTF is set, i.e. log was meant to be uploaded to logs.tf
+func MatchEnd(
+	title string,
+	info stats.MongoMatchInfo,
+	logsTF bool,
+	collection stats.PlayerStatsCollection,
+	players []*stats.PickupPlayer,
+) Message {
+	color := colorTie
+	switch {
+	case info.Scores.Red > info.Scores.Blue:
+		color = colorRed
+	case info.Scores.Blue > info.Scores.Red:
+		color = colorBlue
+	}
+
+	embed := Embed{
+		Title:       title,
+		URL:         info.LogURL,
+		Description: fmt.Sprintf("**RED %d : %d BLU**", info.Scores.Red, info.Scores.Blue),
+		Color:       color,
+		Fields: []Field{
+			newField("Map", info.Map, true),
+			newField("Duration", formatDuration(info.Length), true),
+		},
+		Footer: &Footer{Text: info.Server},
+	}
+	if !info.EndedAt.IsZero() {
+		embed.Timestamp = info.EndedAt.UTC().Format(time.RFC3339)
+	}
+
+	names := make(map[string]string, len(players))
+	for _, p := range players {
+		if p.Name != "" {
+			names[p.SteamID] = p.Name
+		}
+	}
+	leaderboards := []struct {
+		title string
+		value func(s *stats.PlayerStats) int
+	}{
+		{title: "Top fraggers", value: func(s *stats.PlayerStats) int { return s.Kills }},
+		{title: "Top damage", value: func(s *stats.PlayerStats) int { return s.DamageDone }},
+		{title: "Top healers", value: func(s *stats.PlayerStats) int { return s.Healed }},
+	}
+	for _, board := range leaderboards {
+		if value := leaderboard(collection, names, board.value); value != "" {
+			embed.Fields = append(embed.Fields, newField(board.title, value, true))
+		}
+	}
+
+	switch {
+	case info.LogURL != "":
+		logs := fmt.Sprintf("[%s](%s)", strings.TrimPrefix(info.LogURL, "https://"), info.LogURL)
+		embed.Fields = append(embed.Fields, newField("logs.tf", logs, false))
+	case logsTF:
+		embed.Fields = append(embed.Fields, newField("logs.tf", "upload has failed", false))
+	}
+	return Message{Username: username, Embeds: []Embed{embed}}
+}
+
+// newField creates field of embed, empty value is replaced with placeholder
+func newField(name, value string, inline bool) Field {
+	if strings.TrimSpace(value) == "" {
+		value = placeholder
+	}
+	return Field{Name: name, Value: value, Inline: inline}
+}
+
+// leaderboard lists players with highest non-zero values, empty string means nobody has scored
+func leaderboard(collection stats.PlayerStatsCollection, names map[string]string, value func(s *stats.PlayerStats) int) string {
+	var entries []entry
+	for steamID, s := range collection {
+		v := value(s)
+		if v == 0 {
+			continue
+		}
+		name, ok := names[steamID.String()]
+		if !ok {
+			name = steamID.String()
+		}
+		entries = append(entries, entry{name: name, value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].value != entries[j].value {
+			return entries[i].value > entries[j].value
+		}
+		return entries[i].name < entries[j].name
+	})
+	if len(entries) > topPlayers {
+		entries = entries[:topPlayers]
+	}
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = fmt.Sprintf("%d. %s - %d", i+1, e.name, e.value)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatDuration formats seconds as minutes and seconds, e.g. 30:05
+func formatDuration(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
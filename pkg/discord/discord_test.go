@@ -0,0 +1,155 @@
+package discord_test
+
+import (
+	"LogWatcher/pkg/discord"
+	"LogWatcher/pkg/notify"
+	"LogWatcher/pkg/stats"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/leighmacdonald/steamid/steamid"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	jel     = steamid.SID64FromString("76561198439712695")
+	keyreal = steamid.SID64FromString("76561198821399014")
+	medic   = steamid.SID64FromString("76561198011558250")
+)
+
+func TestMatchEnd(t *testing.T) {
+	endedAt := time.Date(2021, 10, 1, 22, 8, 46, 0, time.UTC)
+	collection := stats.PlayerStatsCollection{
+		jel:     {Kills: 20, DamageDone: 8000},
+		keyreal: {Kills: 20, DamageDone: 9000},
+		medic:   {Kills: 1, DamageDone: 100, Healed: 15000},
+	}
+	players := []*stats.PickupPlayer{
+		{SteamID: jel.String(), Name: "jel"},
+		{SteamID: medic.String(), Name: "medic"},
+	}
+
+	tests := []struct {
+		name       string
+		title      string
+		info       stats.MongoMatchInfo
+		skipLogsTF bool
+		want       discord.Message
+	}{
+		{
+			name:  "red win",
+			title: "tf2pickup.test #5",
+			info: stats.MongoMatchInfo{
+				Server: "test#1", Domain: "test", PickupID: 5, Map: "cp_process_final",
+				Scores: stats.CurrentScores{Red: 5, Blue: 3}, EndedAt: endedAt, Length: 1805, LogURL: "https://logs.tf/1",
+			},
+			want: discord.Message{Username: "LogWatcher", Embeds: []discord.Embed{{
+				Title:       "tf2pickup.test #5",
+				URL:         "https://logs.tf/1",
+				Description: "**RED 5 : 3 BLU**",
+				Color:       0xB8383B,
+				Fields: []discord.Field{
+					{Name: "Map", Value: "cp_process_final", Inline: true},
+					{Name: "Duration", Value: "30:05", Inline: true},
+					{Name: "Top fraggers", Value: "1. 76561198821399014 - 20\n2. jel - 20\n3. medic - 1", Inline: true},
+					{Name: "Top damage", Value: "1. 76561198821399014 - 9000\n2. jel - 8000\n3. medic - 100", Inline: true},
+					{Name: "Top healers", Value: "1. medic - 15000", Inline: true},
+					{Name: "logs.tf", Value: "[logs.tf/1](https://logs.tf/1)"},
+				},
+				Footer:    &discord.Footer{Text: "test#1"},
+				Timestamp: "2021-10-01T22:08:46Z",
+			}}},
+		},
+		{
+			name:  "incomplete without pickup and log",
+			title: "Match on test#1 (incomplete)",
+			info: stats.MongoMatchInfo{
+				Server: "test#1", Domain: "test", Map: "cp_process_final", Length: 59, Incomplete: true,
+				Scores: stats.CurrentScores{Red: 1, Blue: 1},
+			},
+			want: discord.Message{Username: "LogWatcher", Embeds: []discord.Embed{{
+				Title:       "Match on test#1 (incomplete)",
+				Description: "**RED 1 : 1 BLU**",
+				Color:       0x99AAB5,
+				Fields: []discord.Field{
+					{Name: "Map", Value: "cp_process_final", Inline: true},
+					{Name: "Duration", Value: "0:59", Inline: true},
+					{Name: "logs.tf", Value: "upload has failed"},
+				},
+				Footer: &discord.Footer{Text: "test#1"},
+			}}},
+		},
+		{
+			name:       "without map and logs.tf upload",
+			title:      "Match on test#1 (incomplete)",
+			info:       stats.MongoMatchInfo{Server: "test#1", Domain: "test", Length: 59, Incomplete: true},
+			skipLogsTF: true,
+			want: discord.Message{Username: "LogWatcher", Embeds: []discord.Embed{{
+				Title:       "Match on test#1 (incomplete)",
+				Description: "**RED 0 : 0 BLU**",
+				Color:       0x99AAB5,
+				Fields: []discord.Field{
+					{Name: "Map", Value: "-", Inline: true},
+					{Name: "Duration", Value: "0:59", Inline: true},
+				},
+				Footer: &discord.Footer{Text: "test#1"},
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := collection
+			if tt.info.Incomplete {
+				c = nil
+			}
+			got := discord.MatchEnd(tt.title, tt.info, !tt.skipLogsTF, c, players)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MatchEnd() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMatchEnd_Delivery posts message through dispatcher to local stand-in of Discord webhook API
+func TestMatchEnd_Delivery(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	received := make(chan discord.Message, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message discord.Message
+		if r.Header.Get("Content-Type") != "application/json" || json.NewDecoder(r.Body).Decode(&message) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- message
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	d, err := notify.NewDispatcher(nil, srv.Client(), log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+
+	message := discord.MatchEnd("tf2pickup.test #5", stats.MongoMatchInfo{Server: "test#1", Domain: "test", PickupID: 5}, true, nil, nil)
+	body, _ := json.Marshal(message)
+	d.Post("discord", srv.URL+"/api/webhooks/1/token", notify.MatchEnd, body)
+
+	select {
+	case got := <-received:
+		if diff := cmp.Diff(message, got); diff != "" {
+			t.Errorf("Discord got message mismatch (-want +got):\n%s", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not delivered")
+	}
+}
@@ -0,0 +1,188 @@
+package events
+
+import (
+	"LogWatcher/pkg/stats"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/leighmacdonald/steamid/steamid"
+)
+
+// Type is a kind of match event
+type Type string
+
+const (
+	MatchStart  Type = "match_start"
+	MatchEnd    Type = "match_end"
+	RoundStart  Type = "round_start"
+	RoundWin    Type = "round_win"
+	ScoreChange Type = "score_change"
+	Kill        Type = "kill"
+)
+
+// DefaultBuffer is a number of events subscriber may lag behind before it is dropped
+const DefaultBuffer = 256
+
+var (
+	killLine     = regexp.MustCompile(`"(.*?)<\d+><(\[U:\d:\d{1,10}])><(Red|Blue)>" killed "(.*?)<\d+><(\[U:\d:\d{1,10}])><(Red|Blue)>" with "([^"]+)"`)
+	roundWinLine = regexp.MustCompile(`: World triggered "Round_Win" \(winner "(Red|Blue)"\)`)
+)
+
+// Event is a single thing happened on server, Data is one of *Data types below
+type Event struct {
+	Server string      `json:"server"`
+	Type   Type        `json:"type"`
+	Time   time.Time   `json:"time"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+// MatchStartData is data of MatchStart event
+type MatchStartData struct {
+	Map      string `json:"map"`
+	PickupID int    `json:"pickup_id"`
+}
+
+// MatchEndData is data of MatchEnd event
+type MatchEndData struct {
+	Map        string              `json:"map"`
+	PickupID   int                 `json:"pickup_id"`
+	Scores     stats.CurrentScores `json:"scores"`
+	Incomplete bool                `json:"incomplete"`
+	LogsURL    string              `json:"logs_url,omitempty"`
+}
+
+// RoundWinData is data of RoundWin event
+type RoundWinData struct {
+	Winner string `json:"winner"`
+}
+
+// ScoreChangeData is data of ScoreChange event, score is team's total score
+type ScoreChangeData struct {
+	Team  string `json:"team"`
+	Score int    `json:"score"`
+}
+
+// Player is a participant of kill
+type Player struct {
+	Name    string `json:"name"`
+	SteamID string `json:"steam_id"`
+	Team    string `json:"team"`
+}
+
+// KillData is data of Kill event
+type KillData struct {
+	Killer Player `json:"killer"`
+	Victim Player `json:"victim"`
+	Weapon string `json:"weapon"`
+}
+
+// ParseKill extracts kill from log line
+func ParseKill(line string) (*KillData, bool) {
+	m := killLine.FindStringSubmatch(line)
+	if m == nil {
+		return nil, false
+	}
+	return &KillData{
+		Killer: Player{Name: m[1], SteamID: steamid.SID3ToSID64(steamid.SID3(m[2])).String(), Team: m[3]},
+		Victim: Player{Name: m[4], SteamID: steamid.SID3ToSID64(steamid.SID3(m[5])).String(), Team: m[6]},
+		Weapon: m[7],
+	}, true
+}
+
+// ParseRoundWin extracts winner of round from log line
+func ParseRoundWin(line string) (*RoundWinData, bool) {
+	m := roundWinLine.FindStringSubmatch(line)
+	if m == nil {
+		return nil, false
+	}
+	return &RoundWinData{Winner: m[1]}, true
+}
+
+// Publisher is implemented by event buses accepting events of state machines
+type Publisher interface {
+	Publish(e Event)
+}
+
+// Filter selects events by server and type, empty sets match everything
+type Filter struct {
+	Servers map[string]bool
+	Types   map[Type]bool
+}
+
+func (f Filter) match(e Event) bool {
+	return (len(f.Servers) == 0 || f.Servers[e.Server]) && (len(f.Types) == 0 || f.Types[e.Type])
+}
+
+// Subscription receives events matching its filter from C, C is closed
+// once subscription is closed or dropped for being too slow
+type Subscription struct {
+	C      <-chan Event
+	c      chan Event
+	filter Filter
+	bus    *Bus
+}
+
+// Close unsubscribes from bus, it is safe to call several times
+func (s *Subscription) Close() {
+	s.bus.remove(s)
+}
+
+// Bus delivers published events to subscribers, it never blocks publishers:
+// subscriber which buffer is full is dropped
+type Bus struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[*Subscription]struct{})}
+}
+
+// Subscribe creates subscription with buffer of given size, zero size means DefaultBuffer
+func (b *Bus) Subscribe(filter Filter, buffer int) *Subscription {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	c := make(chan Event, buffer)
+	s := &Subscription{C: c, c: c, filter: filter, bus: b}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+func (b *Bus) Publish(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subscribers {
+		if !s.filter.match(e) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			delete(b.subscribers, s)
+			close(s.c)
+		}
+	}
+}
+
+// Subscribers returns number of active subscriptions
+func (b *Bus) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+func (b *Bus) remove(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[s]; ok {
+		delete(b.subscribers, s)
+		close(s.c)
+	}
+}
@@ -0,0 +1,174 @@
+package events_test
+
+import (
+	"LogWatcher/pkg/events"
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseKill(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   *events.KillData
+		wantOk bool
+	}{
+		{
+			name: "kill",
+			line: `L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" killed "KEYREAL<65><[U:1:861133286]><Red>" with "sniperrifle" (customkill "headshot")`,
+			want: &events.KillData{
+				Killer: events.Player{Name: "jel", SteamID: "76561198439712695", Team: "Blue"},
+				Victim: events.Player{Name: "KEYREAL", SteamID: "76561198821399014", Team: "Red"},
+				Weapon: "sniperrifle",
+			},
+			wantOk: true,
+		},
+		{
+			name: "not a kill",
+			line: `L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" say "gg"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := events.ParseKill(tt.line)
+			if ok != tt.wantOk {
+				t.Fatalf("ParseKill() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseKill() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRoundWin(t *testing.T) {
+	got, ok := events.ParseRoundWin(`L 10/01/2021 - 21:40:00: World triggered "Round_Win" (winner "Blue")`)
+	if !ok || got.Winner != "Blue" {
+		t.Errorf("ParseRoundWin() = %+v, %v, want Blue", got, ok)
+	}
+	if _, ok = events.ParseRoundWin(`L 10/01/2021 - 21:40:00: World triggered "Round_Start"`); ok {
+		t.Error("ParseRoundWin() matched round start")
+	}
+}
+
+func TestBus_Filter(t *testing.T) {
+	bus := events.NewBus()
+	all := bus.Subscribe(events.Filter{}, 0)
+	defer all.Close()
+	kills := bus.Subscribe(events.Filter{
+		Servers: map[string]bool{"test#1": true},
+		Types:   map[events.Type]bool{events.Kill: true},
+	}, 0)
+	defer kills.Close()
+
+	bus.Publish(events.Event{Server: "test#1", Type: events.Kill})
+	bus.Publish(events.Event{Server: "test#2", Type: events.Kill})
+	bus.Publish(events.Event{Server: "test#1", Type: events.RoundWin})
+
+	if got := len(all.C); got != 3 {
+		t.Errorf("unfiltered subscription got %d events, want 3", got)
+	}
+	if got := len(kills.C); got != 1 {
+		t.Fatalf("filtered subscription got %d events, want 1", got)
+	}
+	if e := <-kills.C; e.Server != "test#1" || e.Type != events.Kill || e.Time.IsZero() {
+		t.Errorf("filtered subscription got %+v", e)
+	}
+}
+
+func TestBus_DropsSlowSubscriber(t *testing.T) {
+	bus := events.NewBus()
+	slow := bus.Subscribe(events.Filter{}, 1)
+	bus.Publish(events.Event{Type: events.Kill})
+	bus.Publish(events.Event{Type: events.Kill})
+
+	if got := bus.Subscribers(); got != 0 {
+		t.Errorf("Subscribers() = %d, want slow subscriber dropped", got)
+	}
+	<-slow.C
+	if _, ok := <-slow.C; ok {
+		t.Error("channel of dropped subscriber is not closed")
+	}
+	slow.Close() // must not panic after drop
+}
+
+func TestHandler_SSE(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	bus := events.NewBus()
+	mux := http.NewServeMux()
+	handler := events.NewHandler(bus, "secret", log)
+	handler.Register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	defer handler.Close()
+
+	tests := []struct {
+		name       string
+		path       string
+		query      string
+		header     string
+		wantStatus int
+	}{
+		{name: "no token", path: events.StreamPath, query: "", wantStatus: http.StatusUnauthorized},
+		{name: "bad token", path: events.StreamPath, query: "?token=secrets", wantStatus: http.StatusUnauthorized},
+		{name: "unknown type", path: events.StreamPath, query: "?token=secret&type=explosion", wantStatus: http.StatusBadRequest},
+		{name: "header token", path: events.StreamPath, query: "?type=explosion", header: "Bearer secret", wantStatus: http.StatusBadRequest},
+		// query token is accepted only for websocket upgrade requests
+		{name: "query token without upgrade", path: events.WebSocketPath, query: "?token=secret", wantStatus: http.StatusUnauthorized},
+		{name: "header token without upgrade", path: events.WebSocketPath, header: "Bearer secret", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, srv.URL+tt.path+tt.query, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+
+	resp, err := http.Get(srv.URL + events.StreamPath + "?token=secret&server=test%231&type=kill,round_win")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %s", ct)
+	}
+	for bus.Subscribers() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	bus.Publish(events.Event{Server: "test#2", Type: events.Kill})
+	bus.Publish(events.Event{Server: "test#1", Type: events.ScoreChange})
+	bus.Publish(events.Event{Server: "test#1", Type: events.RoundWin, Data: &events.RoundWinData{Winner: "Red"}})
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 2 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if lines[0] != "event: round_win" || !strings.Contains(lines[1], `"data":{"winner":"Red"}`) {
+		t.Errorf("stream = %q, want only round win of test#1", lines)
+	}
+}
@@ -0,0 +1,198 @@
+package events
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StreamPath    = "/events"
+	WebSocketPath = "/events/ws"
+
+	heartbeatInterval = 15 * time.Second
+	writeTimeout      = 10 * time.Second
+)
+
+var knownTypes = map[Type]bool{
+	MatchStart: true, MatchEnd: true, RoundStart: true, RoundWin: true, ScoreChange: true, Kill: true,
+}
+
+// Handler streams events over Server-Sent Events on /events and WebSocket on /events/ws.
+// Stream is filtered with repeated or comma separated query parameters, e.g. ?server=ru%231&type=kill,round_win
+type Handler struct {
+	Bus   *Bus
+	Token string
+	Log   *logrus.Logger
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewHandler is a factory for Handler, empty token disables authorization
+func NewHandler(bus *Bus, token string, log *logrus.Logger) *Handler {
+	return &Handler{Bus: bus, Token: token, Log: log, done: make(chan struct{})}
+}
+
+// Close ends all open streams, http.Server.Shutdown doesn't wait for them otherwise
+func (h *Handler) Close() {
+	h.closeOnce.Do(func() { close(h.done) })
+}
+
+// Register mounts event stream endpoints to mux
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc(StreamPath, h.serveSSE)
+	mux.HandleFunc(WebSocketPath, h.serveWebSocket)
+}
+
+// authorize accepts token from Authorization header. Token query parameter is accepted only if allowQuery is set
+// for EventSource and WebSocket upgrade requests, browsers can't set their headers. Query parameter ends up
+// in access logs of proxies, so header is preferred
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, allowQuery bool) bool {
+	if h.Token == "" {
+		return true
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+h.Token)) == 1 ||
+		allowQuery && subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(h.Token)) == 1 {
+		return true
+	}
+	http.Error(w, "invalid token", http.StatusUnauthorized)
+	return false
+}
+
+// parseFilter reads server and type query parameters
+func parseFilter(r *http.Request) (Filter, error) {
+	var f Filter
+	for _, server := range splitValues(r.URL.Query()["server"]) {
+		if f.Servers == nil {
+			f.Servers = make(map[string]bool)
+		}
+		f.Servers[server] = true
+	}
+	for _, t := range splitValues(r.URL.Query()["type"]) {
+		if !knownTypes[Type(t)] {
+			return f, fmt.Errorf("unknown event type: %s", t)
+		}
+		if f.Types == nil {
+			f.Types = make(map[Type]bool)
+		}
+		f.Types[Type(t)] = true
+	}
+	return f, nil
+}
+
+func splitValues(values []string) []string {
+	var result []string
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+func (h *Handler) serveSSE(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r, true) {
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	filter, err := parseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub := h.Bus.Subscribe(filter, 0)
+	defer sub.Close()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.done:
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case e, ok := <-sub.C:
+			if !ok {
+				h.Log.WithField("address", r.RemoteAddr).Info("Dropped slow event stream subscriber")
+				return
+			}
+			data, _ := json.Marshal(e) // err is always nil for events
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+		}
+		flusher.Flush()
+	}
+}
+
+func (h *Handler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	if !h.authorize(w, r, websocket.IsWebSocketUpgrade(r)) {
+		return
+	}
+	filter, err := parseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// upgrader has already replied with error
+		h.Log.WithField("address", r.RemoteAddr).Debugf("Failed to upgrade to websocket: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	sub := h.Bus.Subscribe(filter, 0)
+	defer sub.Close()
+	closed := make(chan struct{})
+	go readLoop(conn, closed)
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-h.done:
+			writeClose(conn, websocket.CloseGoingAway, "going away")
+			return
+		case <-heartbeat.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout))
+		case e, ok := <-sub.C:
+			if !ok {
+				h.Log.WithField("address", r.RemoteAddr).Info("Dropped slow websocket subscriber")
+				writeClose(conn, websocket.ClosePolicyViolation, "too slow")
+				return
+			}
+			data, _ := json.Marshal(e) // err is always nil for events
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			err = conn.WriteMessage(websocket.TextMessage, data)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// maxClientFrame limits messages from client, subscribers are not expected to send anything but control frames
+const maxClientFrame = 4096
+
+// upgrader accepts any origin, subscribers are authorized by token instead
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// readLoop discards client messages until client goes away, closed is closed then.
+// Pings and closes are answered by handlers of conn while reading
+func readLoop(conn *websocket.Conn, closed chan<- struct{}) {
+	defer close(closed)
+	conn.SetReadLimit(maxClientFrame)
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+// writeClose sends close frame with code and reason, it is safe to call concurrently with other writes
+func writeClose(conn *websocket.Conn, code int, reason string) error {
+	return conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeTimeout))
+}
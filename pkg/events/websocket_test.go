@@ -0,0 +1,102 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHandler_WebSocket(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	bus := NewBus()
+	mux := http.NewServeMux()
+	handler := NewHandler(bus, "", log)
+	handler.Register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + WebSocketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("plain GET status = %d, want 400", resp.StatusCode)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+WebSocketPath+"?type=kill", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	for bus.Subscribers() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	bus.Publish(Event{Server: "test#1", Type: RoundWin})
+	bus.Publish(Event{Server: "test#1", Type: Kill})
+	var e Event
+	if err = conn.ReadJSON(&e); err != nil || e.Type != Kill {
+		t.Fatalf("message = %v, %v, want kill event", e, err)
+	}
+
+	pong := make(chan string, 1)
+	conn.SetPongHandler(func(data string) error {
+		pong <- data
+		return nil
+	})
+	read := make(chan error, 1)
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				read <- err
+				return
+			}
+		}
+	}()
+	conn.WriteControl(websocket.PingMessage, []byte("hey"), time.Now().Add(time.Second))
+	select {
+	case data := <-pong:
+		if data != "hey" {
+			t.Errorf("ping reply = %s, want hey", data)
+		}
+	case err = <-read:
+		t.Fatalf("ping reply error: %v", err)
+	}
+
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	var closeErr *websocket.CloseError
+	if err = <-read; !errors.As(err, &closeErr) || closeErr.Code != websocket.CloseNormalClosure {
+		t.Errorf("close reply = %v, want normal closure", err)
+	}
+	for bus.Subscribers() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHandler_WebSocketQueryToken(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	mux := http.NewServeMux()
+	NewHandler(NewBus(), "secret", log).Register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + WebSocketPath
+	if _, resp, err := websocket.DefaultDialer.Dial(url+"?token=bad", nil); err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Dial() with bad token error = %v, want 401", err)
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?token=secret", nil)
+	if err != nil {
+		t.Fatalf("Dial() with query token error = %v", err)
+	}
+	conn.Close()
+}
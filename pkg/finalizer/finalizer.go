@@ -0,0 +1,73 @@
+package finalizer
+
+import (
+	"LogWatcher/pkg/metrics"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWorkers = 4
+	// queueSize is a number of finished matches waiting for worker, Submit blocks when it is full,
+	// so finished matches are never dropped
+	queueSize = 64
+)
+
+// Submitter accepts finalization of finished match: uploads and persistence
+type Submitter interface {
+	Submit(job func())
+}
+
+// Pool finalizes finished matches in background, so state machines can process next match meanwhile
+type Pool struct {
+	mu     sync.RWMutex
+	closed bool
+	jobs   chan func()
+	wg     sync.WaitGroup
+}
+
+// NewPool starts pool with given number of workers
+func NewPool(workers int) *Pool {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	p := &Pool{jobs: make(chan func(), queueSize)}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// Submit queues job, it is run synchronously if pool is closed
+func (p *Pool) Submit(job func()) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		job()
+		return
+	}
+	metrics.FinalizationsPending.Add(1)
+	p.jobs <- job
+}
+
+// Close waits for submitted jobs to finish and stops workers
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for job := range p.jobs {
+		start := time.Now()
+		job()
+		metrics.FinalizationDuration.Observe(time.Since(start).Seconds())
+		metrics.FinalizationsPending.Add(-1)
+	}
+}
@@ -0,0 +1,43 @@
+package finalizer_test
+
+import (
+	"LogWatcher/pkg/finalizer"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool_Submit(t *testing.T) {
+	p := finalizer.NewPool(2)
+
+	// both jobs wait for each other, so they only finish if run concurrently
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	var finished int32
+	for i := 0; i < 2; i++ {
+		p.Submit(func() {
+			started <- struct{}{}
+			<-release
+			atomic.AddInt32(&finished, 1)
+		})
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("Submit() jobs aren't run concurrently")
+		}
+	}
+	close(release)
+
+	p.Close()
+	if got := atomic.LoadInt32(&finished); got != 2 {
+		t.Errorf("Close() returned with %d finished jobs, want 2", got)
+	}
+
+	ran := false
+	p.Submit(func() { ran = true })
+	if !ran {
+		t.Error("Submit() after Close() didn't run job synchronously")
+	}
+}
@@ -0,0 +1,89 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker_Register(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("test error") }
+	hanging := func(context.Context) error {
+		time.Sleep(time.Second) // ignores ctx
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		readiness  []Check
+		wantStatus int
+		wantChecks map[string]string
+	}{
+		{
+			name:       "alive",
+			path:       HealthzPath,
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"live": StatusOK},
+		},
+		{
+			name:       "ready",
+			path:       ReadyzPath,
+			readiness:  []Check{ok, ok},
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"check0": StatusOK, "check1": StatusOK},
+		},
+		{
+			name:       "failed dependency",
+			path:       ReadyzPath,
+			readiness:  []Check{ok, failing},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"check0": StatusOK, "check1": StatusFail},
+		},
+		{
+			name:       "timed out dependency",
+			path:       ReadyzPath,
+			readiness:  []Check{hanging},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"check0": StatusFail},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChecker(50 * time.Millisecond)
+			c.AddLiveness("live", ok)
+			for i, check := range tt.readiness {
+				c.AddReadiness("check"+string(rune('0'+i)), check)
+			}
+			mux := http.NewServeMux()
+			c.Register(mux)
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			var report Report
+			if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+				t.Fatal(err)
+			}
+			if len(report.Checks) != len(tt.wantChecks) {
+				t.Fatalf("checks = %v, want %v", report.Checks, tt.wantChecks)
+			}
+			for name, status := range tt.wantChecks {
+				result := report.Checks[name]
+				if result.Status != status {
+					t.Errorf("check %s = %+v, want status %v", name, result, status)
+				}
+				if status == StatusFail && result.Error == "" {
+					t.Errorf("check %s has no error", name)
+				}
+			}
+		})
+	}
+}
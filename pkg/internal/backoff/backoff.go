@@ -0,0 +1,17 @@
+package backoff
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Delay returns exponential delay of attempt with jitter in range [d/2, d],
+// where d is base doubled for every attempt after the first one and capped by max
+func Delay(base, max time.Duration, attempts int) time.Duration {
+	d := max
+	if exp := math.Pow(2, float64(attempts-1)); exp < float64(max/base) {
+		d = base * time.Duration(exp)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
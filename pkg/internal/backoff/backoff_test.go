@@ -0,0 +1,28 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "first attempt", attempts: 1, want: time.Second},
+		{name: "doubled", attempts: 3, want: 4 * time.Second},
+		{name: "capped", attempts: 10, want: time.Minute},
+		{name: "overflow", attempts: 1000, want: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if got := Delay(time.Second, time.Minute, tt.attempts); got < tt.want/2 || got > tt.want {
+					t.Fatalf("Delay() = %v, want in [%v, %v]", got, tt.want/2, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package fileutil
+
+import "os"
+
+// TmpExtension is appended to path of file while it is being written
+const TmpExtension = ".tmp"
+
+// WriteFile writes content atomically: it is synced to temporary file which is renamed to path,
+// so crash never leaves half written file. Temporary file is removed if anything fails
+func WriteFile(path string, content []byte) (err error) {
+	tmp := path + TmpExtension
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}()
+	if _, err = f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
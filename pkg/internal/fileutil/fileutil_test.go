@@ -0,0 +1,32 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.log")
+	if err := WriteFile(path, []byte("log line\n")); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if content, err := os.ReadFile(path); err != nil || string(content) != "log line\n" {
+		t.Errorf("WriteFile() stored %q, err = %v", content, err)
+	}
+
+	// rename fails as path is a directory, temporary file must not be left behind
+	if err := os.Mkdir(filepath.Join(dir, "busy"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "busy", "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(filepath.Join(dir, "busy"), []byte("log line\n")); err == nil {
+		t.Error("WriteFile() over non-empty directory error = nil")
+	}
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "*"+TmpExtension)); len(tmp) != 0 {
+		t.Errorf("WriteFile() left temporary files %v", tmp)
+	}
+}
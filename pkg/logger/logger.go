@@ -15,3 +15,13 @@ func NewLogger(level string) (*logrus.Logger, error) {
 	l.SetFormatter(&logrus.TextFormatter{})
 	return l, nil
 }
+
+// SetLevel changes level of already running logger
+func SetLevel(l *logrus.Logger, level string) error {
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.SetLevel(lvl)
+	return nil
+}
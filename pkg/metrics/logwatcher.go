@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Router metrics
+var (
+	Packets = NewCounterVec("logwatcher_packets_total",
+		"Log packets routed to servers.", "server")
+	PacketBytes = NewCounterVec("logwatcher_packet_bytes_total",
+		"Size of log packets routed to servers.", "server")
+	UnknownSourcePackets = NewCounterVec("logwatcher_packets_unknown_source_total",
+		"Packets dropped because their source address is not configured.")
+	UnauthenticatedPackets = NewCounterVec("logwatcher_packets_unauthenticated_total",
+		"Packets dropped due to failed secret check.", "reason")
+	MalformedPackets = NewCounterVec("logwatcher_packets_malformed_total",
+		"Packets dropped because they couldn't be decoded, bad log line means log line regexp miss.", "reason")
+)
+
+// Per-server queue metrics
+var (
+	QueueLength = NewGaugeVec("logwatcher_queue_length",
+		"Lines waiting in server's queue including spilled ones.", "server")
+	QueueSpilled = NewCounterVec("logwatcher_queue_spilled_total",
+		"Lines spilled to disk because server's queue was full.", "server")
+	QueueDropped = NewCounterVec("logwatcher_queue_dropped_total",
+		"Lines dropped from server's queue by reason: overflow, spill_full, spill_error or closed.", "server", "reason")
+)
+
+// State machine metrics
+var (
+	ServerState = NewGaugeVec("logwatcher_server_state",
+		"Current state of server's state machine, 1 for current state and 0 for others.", "server", "state")
+	StateTransitions = NewCounterVec("logwatcher_state_transitions_total",
+		"State changes of server's state machine.", "server", "from", "to")
+	MatchesCompleted = NewCounterVec("logwatcher_matches_completed_total",
+		"Matches finished on server.", "server", "incomplete")
+	PickupLookups = NewCounterVec("logwatcher_pickup_lookups_total",
+		"Lookups of pickup game for started match by result: found, not_found or error.", "server", "result")
+	FinalizationsPending = NewGaugeVec("logwatcher_finalizations_pending",
+		"Finished matches waiting for or being uploaded and saved.")
+	FinalizationDuration = NewHistogramVec("logwatcher_finalization_duration_seconds",
+		"Time spent on uploading and saving finished match.", DefaultBuckets)
+)
+
+// External services metrics
+var (
+	RequestDuration = NewHistogramVec("logwatcher_request_duration_seconds",
+		"Latency of requests to logs.tf and tf2pickup API.", DefaultBuckets, "operation")
+	RequestFailures = NewCounterVec("logwatcher_request_failures_total",
+		"Failed requests to logs.tf and tf2pickup API by response status, error means no response.", "operation", "status")
+	MongoDuration = NewHistogramVec("logwatcher_mongo_duration_seconds",
+		"Latency of MongoDB writes.", DefaultBuckets, "operation")
+	MongoFailures = NewCounterVec("logwatcher_mongo_failures_total",
+		"Failed MongoDB writes.", "operation")
+	WebhookDeliveries = NewCounterVec("logwatcher_webhook_deliveries_total",
+		"Webhook notifications by result: delivered, retried, failed or dropped.", "webhook", "result")
+)
+
+// init registers LogWatcher metrics in default Prometheus registry served on /metrics
+func init() {
+	prometheus.MustRegister(
+		Packets, PacketBytes, UnknownSourcePackets, UnauthenticatedPackets, MalformedPackets,
+		QueueLength, QueueSpilled, QueueDropped,
+		ServerState, StateTransitions, MatchesCompleted, PickupLookups, FinalizationsPending, FinalizationDuration,
+		RequestDuration, RequestFailures, MongoDuration, MongoFailures, WebhookDeliveries,
+	)
+}
+
+// ObserveRequest records latency of HTTP request started at start and counts it as failed
+// if it has returned error or non 2xx response
+func ObserveRequest(operation string, start time.Time, res *http.Response, err error) {
+	RequestDuration.Observe(time.Since(start).Seconds(), operation)
+	switch {
+	case err != nil:
+		RequestFailures.Inc(operation, "error")
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		RequestFailures.Inc(operation, strconv.Itoa(res.StatusCode))
+	}
+}
+
+// ObserveMongo records latency of MongoDB write started at start and counts it as failed if err is set
+func ObserveMongo(operation string, start time.Time, err error) {
+	MongoDuration.Observe(time.Since(start).Seconds(), operation)
+	if err != nil {
+		MongoFailures.Inc(operation)
+	}
+}
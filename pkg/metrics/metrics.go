@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// DefaultBuckets are histogram buckets in seconds suitable for network requests
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+// deletePartial removes all series of collector which have value of label matching
+func deletePartial(c prometheus.Collector, remove func(values prometheus.Labels) bool, label, value string) {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+	var matched []prometheus.Labels
+	for m := range ch {
+		var metric dto.Metric
+		if err := m.Write(&metric); err != nil {
+			continue
+		}
+		labels := make(prometheus.Labels, len(metric.GetLabel()))
+		for _, pair := range metric.GetLabel() {
+			labels[pair.GetName()] = pair.GetValue()
+		}
+		if labels[label] == value {
+			matched = append(matched, labels)
+		}
+	}
+	for _, labels := range matched {
+		remove(labels)
+	}
+}
+
+// CounterVec is a monotonically increasing value partitioned by labels
+type CounterVec struct {
+	*prometheus.CounterVec
+}
+
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)}
+}
+
+func (c *CounterVec) Inc(values ...string) {
+	c.WithLabelValues(values...).Inc()
+}
+
+func (c *CounterVec) Add(delta float64, values ...string) {
+	c.WithLabelValues(values...).Add(delta)
+}
+
+// Delete removes series of label values, e.g. when server is removed
+func (c *CounterVec) Delete(values ...string) {
+	c.DeleteLabelValues(values...)
+}
+
+// DeletePartial removes all series which have value of label matching
+func (c *CounterVec) DeletePartial(label, value string) {
+	deletePartial(c.CounterVec, c.CounterVec.Delete, label, value)
+}
+
+// GaugeVec is a value which can go up and down partitioned by labels
+type GaugeVec struct {
+	*prometheus.GaugeVec
+}
+
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)}
+}
+
+func (g *GaugeVec) Set(value float64, values ...string) {
+	g.WithLabelValues(values...).Set(value)
+}
+
+func (g *GaugeVec) Add(delta float64, values ...string) {
+	g.WithLabelValues(values...).Add(delta)
+}
+
+// Delete removes series of label values, e.g. when server is removed
+func (g *GaugeVec) Delete(values ...string) {
+	g.DeleteLabelValues(values...)
+}
+
+// DeletePartial removes all series which have value of label matching
+func (g *GaugeVec) DeletePartial(label, value string) {
+	deletePartial(g.GaugeVec, g.GaugeVec.Delete, label, value)
+}
+
+// HistogramVec counts observations in buckets partitioned by labels
+type HistogramVec struct {
+	*prometheus.HistogramVec
+}
+
+// NewHistogramVec creates histogram with sorted upper bounds of buckets, +Inf bucket is implicit
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	return &HistogramVec{prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)}
+}
+
+func (h *HistogramVec) Observe(value float64, values ...string) {
+	h.WithLabelValues(values...).Observe(value)
+}
+
+// Delete removes series of label values, e.g. when server is removed
+func (h *HistogramVec) Delete(values ...string) {
+	h.DeleteLabelValues(values...)
+}
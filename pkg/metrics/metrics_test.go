@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestMetrics_Gather(t *testing.T) {
+	counter := NewCounterVec("test_packets_total", "Packets.", "server")
+	counter.Inc("test#1")
+	counter.Add(2, "test#1")
+	counter.Inc(`bad"name`)
+	gauge := NewGaugeVec("test_state", "State.", "server", "state")
+	gauge.Set(1, "test#1", "game")
+	gauge.Set(0, "test#1", "pregame")
+	gauge.Set(1, "test#2", "game")
+	gauge.Delete("test#1", "pregame")
+	gauge.DeletePartial("server", "test#2")
+	histogram := NewHistogramVec("test_duration_seconds", "Duration.", []float64{0.1, 1}, "operation")
+	histogram.Observe(0.1, "upload")
+	histogram.Observe(0.5, "upload")
+	histogram.Observe(3, "upload")
+	empty := NewCounterVec("test_unknown_total", "Unknown.")
+	empty.Inc()
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(counter, gauge, histogram, empty)
+
+	want := `# HELP test_packets_total Packets.
+# TYPE test_packets_total counter
+test_packets_total{server="bad\"name"} 1
+test_packets_total{server="test#1"} 3
+# HELP test_state State.
+# TYPE test_state gauge
+test_state{server="test#1",state="game"} 1
+# HELP test_duration_seconds Duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{operation="upload",le="0.1"} 1
+test_duration_seconds_bucket{operation="upload",le="1"} 2
+test_duration_seconds_bucket{operation="upload",le="+Inf"} 3
+test_duration_seconds_sum{operation="upload"} 3.6
+test_duration_seconds_count{operation="upload"} 3
+# HELP test_unknown_total Unknown.
+# TYPE test_unknown_total counter
+test_unknown_total 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want)); err != nil {
+		t.Errorf("Gather() %v", err)
+	}
+}
+
+func TestObserveRequest(t *testing.T) {
+	start := time.Now()
+	ObserveRequest("test", start, httptest.NewRecorder().Result(), nil)
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(503)
+	ObserveRequest("test", start, rec.Result(), nil)
+	ObserveRequest("test", start, nil, errors.New("test error"))
+
+	var duration dto.Metric
+	if err := RequestDuration.WithLabelValues("test").(prometheus.Metric).Write(&duration); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if got := duration.GetHistogram().GetSampleCount(); got != 3 {
+		t.Errorf("ObserveRequest() observed %d requests, want 3", got)
+	}
+	if got := testutil.ToFloat64(RequestFailures.WithLabelValues("test", "503")); got != 1 {
+		t.Errorf("ObserveRequest() 503 failures = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(RequestFailures.WithLabelValues("test", "error")); got != 1 {
+		t.Errorf("ObserveRequest() error failures = %v, want 1", got)
+	}
+}
@@ -0,0 +1,285 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/outbox.Enqueuer -o ./pkg/mocks/enqueuer_mock.go
+
+import (
+	mm_outbox "LogWatcher/pkg/outbox"
+	"bytes"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// EnqueuerMock implements outbox.Enqueuer
+type EnqueuerMock struct {
+	t minimock.Tester
+
+	funcEnqueue          func(entry mm_outbox.Entry, log bytes.Buffer) (err error)
+	inspectFuncEnqueue   func(entry mm_outbox.Entry, log bytes.Buffer)
+	afterEnqueueCounter  uint64
+	beforeEnqueueCounter uint64
+	EnqueueMock          mEnqueuerMockEnqueue
+}
+
+// NewEnqueuerMock returns a mock for outbox.Enqueuer
+func NewEnqueuerMock(t minimock.Tester) *EnqueuerMock {
+	m := &EnqueuerMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.EnqueueMock = mEnqueuerMockEnqueue{mock: m}
+	m.EnqueueMock.callArgs = []*EnqueuerMockEnqueueParams{}
+
+	return m
+}
+
+type mEnqueuerMockEnqueue struct {
+	mock               *EnqueuerMock
+	defaultExpectation *EnqueuerMockEnqueueExpectation
+	expectations       []*EnqueuerMockEnqueueExpectation
+
+	callArgs []*EnqueuerMockEnqueueParams
+	mutex    sync.RWMutex
+}
+
+// EnqueuerMockEnqueueExpectation specifies expectation struct of the Enqueuer.Enqueue
+type EnqueuerMockEnqueueExpectation struct {
+	mock    *EnqueuerMock
+	params  *EnqueuerMockEnqueueParams
+	results *EnqueuerMockEnqueueResults
+	Counter uint64
+}
+
+// EnqueuerMockEnqueueParams contains parameters of the Enqueuer.Enqueue
+type EnqueuerMockEnqueueParams struct {
+	entry mm_outbox.Entry
+	log   bytes.Buffer
+}
+
+// EnqueuerMockEnqueueResults contains results of the Enqueuer.Enqueue
+type EnqueuerMockEnqueueResults struct {
+	err error
+}
+
+// Expect sets up expected params for Enqueuer.Enqueue
+func (mmEnqueue *mEnqueuerMockEnqueue) Expect(entry mm_outbox.Entry, log bytes.Buffer) *mEnqueuerMockEnqueue {
+	if mmEnqueue.mock.funcEnqueue != nil {
+		mmEnqueue.mock.t.Fatalf("EnqueuerMock.Enqueue mock is already set by Set")
+	}
+
+	if mmEnqueue.defaultExpectation == nil {
+		mmEnqueue.defaultExpectation = &EnqueuerMockEnqueueExpectation{}
+	}
+
+	mmEnqueue.defaultExpectation.params = &EnqueuerMockEnqueueParams{entry, log}
+	for _, e := range mmEnqueue.expectations {
+		if minimock.Equal(e.params, mmEnqueue.defaultExpectation.params) {
+			mmEnqueue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEnqueue.defaultExpectation.params)
+		}
+	}
+
+	return mmEnqueue
+}
+
+// Inspect accepts an inspector function that has same arguments as the Enqueuer.Enqueue
+func (mmEnqueue *mEnqueuerMockEnqueue) Inspect(f func(entry mm_outbox.Entry, log bytes.Buffer)) *mEnqueuerMockEnqueue {
+	if mmEnqueue.mock.inspectFuncEnqueue != nil {
+		mmEnqueue.mock.t.Fatalf("Inspect function is already set for EnqueuerMock.Enqueue")
+	}
+
+	mmEnqueue.mock.inspectFuncEnqueue = f
+
+	return mmEnqueue
+}
+
+// Return sets up results that will be returned by Enqueuer.Enqueue
+func (mmEnqueue *mEnqueuerMockEnqueue) Return(err error) *EnqueuerMock {
+	if mmEnqueue.mock.funcEnqueue != nil {
+		mmEnqueue.mock.t.Fatalf("EnqueuerMock.Enqueue mock is already set by Set")
+	}
+
+	if mmEnqueue.defaultExpectation == nil {
+		mmEnqueue.defaultExpectation = &EnqueuerMockEnqueueExpectation{mock: mmEnqueue.mock}
+	}
+	mmEnqueue.defaultExpectation.results = &EnqueuerMockEnqueueResults{err}
+	return mmEnqueue.mock
+}
+
+//Set uses given function f to mock the Enqueuer.Enqueue method
+func (mmEnqueue *mEnqueuerMockEnqueue) Set(f func(entry mm_outbox.Entry, log bytes.Buffer) (err error)) *EnqueuerMock {
+	if mmEnqueue.defaultExpectation != nil {
+		mmEnqueue.mock.t.Fatalf("Default expectation is already set for the Enqueuer.Enqueue method")
+	}
+
+	if len(mmEnqueue.expectations) > 0 {
+		mmEnqueue.mock.t.Fatalf("Some expectations are already set for the Enqueuer.Enqueue method")
+	}
+
+	mmEnqueue.mock.funcEnqueue = f
+	return mmEnqueue.mock
+}
+
+// When sets expectation for the Enqueuer.Enqueue which will trigger the result defined by the following
+// Then helper
+func (mmEnqueue *mEnqueuerMockEnqueue) When(entry mm_outbox.Entry, log bytes.Buffer) *EnqueuerMockEnqueueExpectation {
+	if mmEnqueue.mock.funcEnqueue != nil {
+		mmEnqueue.mock.t.Fatalf("EnqueuerMock.Enqueue mock is already set by Set")
+	}
+
+	expectation := &EnqueuerMockEnqueueExpectation{
+		mock:   mmEnqueue.mock,
+		params: &EnqueuerMockEnqueueParams{entry, log},
+	}
+	mmEnqueue.expectations = append(mmEnqueue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Enqueuer.Enqueue return parameters for the expectation previously defined by the When method
+func (e *EnqueuerMockEnqueueExpectation) Then(err error) *EnqueuerMock {
+	e.results = &EnqueuerMockEnqueueResults{err}
+	return e.mock
+}
+
+// Enqueue implements outbox.Enqueuer
+func (mmEnqueue *EnqueuerMock) Enqueue(entry mm_outbox.Entry, log bytes.Buffer) (err error) {
+	mm_atomic.AddUint64(&mmEnqueue.beforeEnqueueCounter, 1)
+	defer mm_atomic.AddUint64(&mmEnqueue.afterEnqueueCounter, 1)
+
+	if mmEnqueue.inspectFuncEnqueue != nil {
+		mmEnqueue.inspectFuncEnqueue(entry, log)
+	}
+
+	mm_params := &EnqueuerMockEnqueueParams{entry, log}
+
+	// Record call args
+	mmEnqueue.EnqueueMock.mutex.Lock()
+	mmEnqueue.EnqueueMock.callArgs = append(mmEnqueue.EnqueueMock.callArgs, mm_params)
+	mmEnqueue.EnqueueMock.mutex.Unlock()
+
+	for _, e := range mmEnqueue.EnqueueMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEnqueue.EnqueueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEnqueue.EnqueueMock.defaultExpectation.Counter, 1)
+		mm_want := mmEnqueue.EnqueueMock.defaultExpectation.params
+		mm_got := EnqueuerMockEnqueueParams{entry, log}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEnqueue.t.Errorf("EnqueuerMock.Enqueue got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEnqueue.EnqueueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEnqueue.t.Fatal("No results are set for the EnqueuerMock.Enqueue")
+		}
+		return (*mm_results).err
+	}
+	if mmEnqueue.funcEnqueue != nil {
+		return mmEnqueue.funcEnqueue(entry, log)
+	}
+	mmEnqueue.t.Fatalf("Unexpected call to EnqueuerMock.Enqueue. %v %v", entry, log)
+	return
+}
+
+// EnqueueAfterCounter returns a count of finished EnqueuerMock.Enqueue invocations
+func (mmEnqueue *EnqueuerMock) EnqueueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnqueue.afterEnqueueCounter)
+}
+
+// EnqueueBeforeCounter returns a count of EnqueuerMock.Enqueue invocations
+func (mmEnqueue *EnqueuerMock) EnqueueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnqueue.beforeEnqueueCounter)
+}
+
+// Calls returns a list of arguments used in each call to EnqueuerMock.Enqueue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEnqueue *mEnqueuerMockEnqueue) Calls() []*EnqueuerMockEnqueueParams {
+	mmEnqueue.mutex.RLock()
+
+	argCopy := make([]*EnqueuerMockEnqueueParams, len(mmEnqueue.callArgs))
+	copy(argCopy, mmEnqueue.callArgs)
+
+	mmEnqueue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEnqueueDone returns true if the count of the Enqueue invocations corresponds
+// the number of defined expectations
+func (m *EnqueuerMock) MinimockEnqueueDone() bool {
+	for _, e := range m.EnqueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EnqueueMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEnqueueCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEnqueue != nil && mm_atomic.LoadUint64(&m.afterEnqueueCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEnqueueInspect logs each unmet expectation
+func (m *EnqueuerMock) MinimockEnqueueInspect() {
+	for _, e := range m.EnqueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EnqueuerMock.Enqueue with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EnqueueMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEnqueueCounter) < 1 {
+		if m.EnqueueMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to EnqueuerMock.Enqueue")
+		} else {
+			m.t.Errorf("Expected call to EnqueuerMock.Enqueue with params: %#v", *m.EnqueueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEnqueue != nil && mm_atomic.LoadUint64(&m.afterEnqueueCounter) < 1 {
+		m.t.Error("Expected call to EnqueuerMock.Enqueue")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EnqueuerMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockEnqueueInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EnqueuerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EnqueuerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEnqueueDone()
+}
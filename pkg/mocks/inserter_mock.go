@@ -5,6 +5,7 @@ package mocks
 //go:generate minimock -i LogWatcher/pkg/mongo.Inserter -o ./pkg/mocks/inserter_mock.go
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -16,11 +17,17 @@ import (
 type InserterMock struct {
 	t minimock.Tester
 
-	funcInsertGameStats          func(documents []interface{}) (err error)
-	inspectFuncInsertGameStats   func(documents []interface{})
+	funcInsertGameStats          func(ctx context.Context, documents []interface{}) (err error)
+	inspectFuncInsertGameStats   func(ctx context.Context, documents []interface{})
 	afterInsertGameStatsCounter  uint64
 	beforeInsertGameStatsCounter uint64
 	InsertGameStatsMock          mInserterMockInsertGameStats
+
+	funcInsertMatch          func(ctx context.Context, document interface{}) (err error)
+	inspectFuncInsertMatch   func(ctx context.Context, document interface{})
+	afterInsertMatchCounter  uint64
+	beforeInsertMatchCounter uint64
+	InsertMatchMock          mInserterMockInsertMatch
 }
 
 // NewInserterMock returns a mock for mongo.Inserter
@@ -33,6 +40,9 @@ func NewInserterMock(t minimock.Tester) *InserterMock {
 	m.InsertGameStatsMock = mInserterMockInsertGameStats{mock: m}
 	m.InsertGameStatsMock.callArgs = []*InserterMockInsertGameStatsParams{}
 
+	m.InsertMatchMock = mInserterMockInsertMatch{mock: m}
+	m.InsertMatchMock.callArgs = []*InserterMockInsertMatchParams{}
+
 	return m
 }
 
@@ -55,6 +65,7 @@ type InserterMockInsertGameStatsExpectation struct {
 
 // InserterMockInsertGameStatsParams contains parameters of the Inserter.InsertGameStats
 type InserterMockInsertGameStatsParams struct {
+	ctx       context.Context
 	documents []interface{}
 }
 
@@ -64,7 +75,7 @@ type InserterMockInsertGameStatsResults struct {
 }
 
 // Expect sets up expected params for Inserter.InsertGameStats
-func (mmInsertGameStats *mInserterMockInsertGameStats) Expect(documents []interface{}) *mInserterMockInsertGameStats {
+func (mmInsertGameStats *mInserterMockInsertGameStats) Expect(ctx context.Context, documents []interface{}) *mInserterMockInsertGameStats {
 	if mmInsertGameStats.mock.funcInsertGameStats != nil {
 		mmInsertGameStats.mock.t.Fatalf("InserterMock.InsertGameStats mock is already set by Set")
 	}
@@ -73,7 +84,7 @@ func (mmInsertGameStats *mInserterMockInsertGameStats) Expect(documents []interf
 		mmInsertGameStats.defaultExpectation = &InserterMockInsertGameStatsExpectation{}
 	}
 
-	mmInsertGameStats.defaultExpectation.params = &InserterMockInsertGameStatsParams{documents}
+	mmInsertGameStats.defaultExpectation.params = &InserterMockInsertGameStatsParams{ctx, documents}
 	for _, e := range mmInsertGameStats.expectations {
 		if minimock.Equal(e.params, mmInsertGameStats.defaultExpectation.params) {
 			mmInsertGameStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInsertGameStats.defaultExpectation.params)
@@ -84,7 +95,7 @@ func (mmInsertGameStats *mInserterMockInsertGameStats) Expect(documents []interf
 }
 
 // Inspect accepts an inspector function that has same arguments as the Inserter.InsertGameStats
-func (mmInsertGameStats *mInserterMockInsertGameStats) Inspect(f func(documents []interface{})) *mInserterMockInsertGameStats {
+func (mmInsertGameStats *mInserterMockInsertGameStats) Inspect(f func(ctx context.Context, documents []interface{})) *mInserterMockInsertGameStats {
 	if mmInsertGameStats.mock.inspectFuncInsertGameStats != nil {
 		mmInsertGameStats.mock.t.Fatalf("Inspect function is already set for InserterMock.InsertGameStats")
 	}
@@ -108,7 +119,7 @@ func (mmInsertGameStats *mInserterMockInsertGameStats) Return(err error) *Insert
 }
 
 //Set uses given function f to mock the Inserter.InsertGameStats method
-func (mmInsertGameStats *mInserterMockInsertGameStats) Set(f func(documents []interface{}) (err error)) *InserterMock {
+func (mmInsertGameStats *mInserterMockInsertGameStats) Set(f func(ctx context.Context, documents []interface{}) (err error)) *InserterMock {
 	if mmInsertGameStats.defaultExpectation != nil {
 		mmInsertGameStats.mock.t.Fatalf("Default expectation is already set for the Inserter.InsertGameStats method")
 	}
@@ -123,14 +134,14 @@ func (mmInsertGameStats *mInserterMockInsertGameStats) Set(f func(documents []in
 
 // When sets expectation for the Inserter.InsertGameStats which will trigger the result defined by the following
 // Then helper
-func (mmInsertGameStats *mInserterMockInsertGameStats) When(documents []interface{}) *InserterMockInsertGameStatsExpectation {
+func (mmInsertGameStats *mInserterMockInsertGameStats) When(ctx context.Context, documents []interface{}) *InserterMockInsertGameStatsExpectation {
 	if mmInsertGameStats.mock.funcInsertGameStats != nil {
 		mmInsertGameStats.mock.t.Fatalf("InserterMock.InsertGameStats mock is already set by Set")
 	}
 
 	expectation := &InserterMockInsertGameStatsExpectation{
 		mock:   mmInsertGameStats.mock,
-		params: &InserterMockInsertGameStatsParams{documents},
+		params: &InserterMockInsertGameStatsParams{ctx, documents},
 	}
 	mmInsertGameStats.expectations = append(mmInsertGameStats.expectations, expectation)
 	return expectation
@@ -143,15 +154,15 @@ func (e *InserterMockInsertGameStatsExpectation) Then(err error) *InserterMock {
 }
 
 // InsertGameStats implements mongo.Inserter
-func (mmInsertGameStats *InserterMock) InsertGameStats(documents []interface{}) (err error) {
+func (mmInsertGameStats *InserterMock) InsertGameStats(ctx context.Context, documents []interface{}) (err error) {
 	mm_atomic.AddUint64(&mmInsertGameStats.beforeInsertGameStatsCounter, 1)
 	defer mm_atomic.AddUint64(&mmInsertGameStats.afterInsertGameStatsCounter, 1)
 
 	if mmInsertGameStats.inspectFuncInsertGameStats != nil {
-		mmInsertGameStats.inspectFuncInsertGameStats(documents)
+		mmInsertGameStats.inspectFuncInsertGameStats(ctx, documents)
 	}
 
-	mm_params := &InserterMockInsertGameStatsParams{documents}
+	mm_params := &InserterMockInsertGameStatsParams{ctx, documents}
 
 	// Record call args
 	mmInsertGameStats.InsertGameStatsMock.mutex.Lock()
@@ -168,7 +179,7 @@ func (mmInsertGameStats *InserterMock) InsertGameStats(documents []interface{})
 	if mmInsertGameStats.InsertGameStatsMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmInsertGameStats.InsertGameStatsMock.defaultExpectation.Counter, 1)
 		mm_want := mmInsertGameStats.InsertGameStatsMock.defaultExpectation.params
-		mm_got := InserterMockInsertGameStatsParams{documents}
+		mm_got := InserterMockInsertGameStatsParams{ctx, documents}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmInsertGameStats.t.Errorf("InserterMock.InsertGameStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -180,9 +191,9 @@ func (mmInsertGameStats *InserterMock) InsertGameStats(documents []interface{})
 		return (*mm_results).err
 	}
 	if mmInsertGameStats.funcInsertGameStats != nil {
-		return mmInsertGameStats.funcInsertGameStats(documents)
+		return mmInsertGameStats.funcInsertGameStats(ctx, documents)
 	}
-	mmInsertGameStats.t.Fatalf("Unexpected call to InserterMock.InsertGameStats. %v", documents)
+	mmInsertGameStats.t.Fatalf("Unexpected call to InserterMock.InsertGameStats. %v %v", ctx, documents)
 	return
 }
 
@@ -251,10 +262,228 @@ func (m *InserterMock) MinimockInsertGameStatsInspect() {
 	}
 }
 
+type mInserterMockInsertMatch struct {
+	mock               *InserterMock
+	defaultExpectation *InserterMockInsertMatchExpectation
+	expectations       []*InserterMockInsertMatchExpectation
+
+	callArgs []*InserterMockInsertMatchParams
+	mutex    sync.RWMutex
+}
+
+// InserterMockInsertMatchExpectation specifies expectation struct of the Inserter.InsertMatch
+type InserterMockInsertMatchExpectation struct {
+	mock    *InserterMock
+	params  *InserterMockInsertMatchParams
+	results *InserterMockInsertMatchResults
+	Counter uint64
+}
+
+// InserterMockInsertMatchParams contains parameters of the Inserter.InsertMatch
+type InserterMockInsertMatchParams struct {
+	ctx      context.Context
+	document interface{}
+}
+
+// InserterMockInsertMatchResults contains results of the Inserter.InsertMatch
+type InserterMockInsertMatchResults struct {
+	err error
+}
+
+// Expect sets up expected params for Inserter.InsertMatch
+func (mmInsertMatch *mInserterMockInsertMatch) Expect(ctx context.Context, document interface{}) *mInserterMockInsertMatch {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("InserterMock.InsertMatch mock is already set by Set")
+	}
+
+	if mmInsertMatch.defaultExpectation == nil {
+		mmInsertMatch.defaultExpectation = &InserterMockInsertMatchExpectation{}
+	}
+
+	mmInsertMatch.defaultExpectation.params = &InserterMockInsertMatchParams{ctx, document}
+	for _, e := range mmInsertMatch.expectations {
+		if minimock.Equal(e.params, mmInsertMatch.defaultExpectation.params) {
+			mmInsertMatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInsertMatch.defaultExpectation.params)
+		}
+	}
+
+	return mmInsertMatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Inserter.InsertMatch
+func (mmInsertMatch *mInserterMockInsertMatch) Inspect(f func(ctx context.Context, document interface{})) *mInserterMockInsertMatch {
+	if mmInsertMatch.mock.inspectFuncInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("Inspect function is already set for InserterMock.InsertMatch")
+	}
+
+	mmInsertMatch.mock.inspectFuncInsertMatch = f
+
+	return mmInsertMatch
+}
+
+// Return sets up results that will be returned by Inserter.InsertMatch
+func (mmInsertMatch *mInserterMockInsertMatch) Return(err error) *InserterMock {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("InserterMock.InsertMatch mock is already set by Set")
+	}
+
+	if mmInsertMatch.defaultExpectation == nil {
+		mmInsertMatch.defaultExpectation = &InserterMockInsertMatchExpectation{mock: mmInsertMatch.mock}
+	}
+	mmInsertMatch.defaultExpectation.results = &InserterMockInsertMatchResults{err}
+	return mmInsertMatch.mock
+}
+
+//Set uses given function f to mock the Inserter.InsertMatch method
+func (mmInsertMatch *mInserterMockInsertMatch) Set(f func(ctx context.Context, document interface{}) (err error)) *InserterMock {
+	if mmInsertMatch.defaultExpectation != nil {
+		mmInsertMatch.mock.t.Fatalf("Default expectation is already set for the Inserter.InsertMatch method")
+	}
+
+	if len(mmInsertMatch.expectations) > 0 {
+		mmInsertMatch.mock.t.Fatalf("Some expectations are already set for the Inserter.InsertMatch method")
+	}
+
+	mmInsertMatch.mock.funcInsertMatch = f
+	return mmInsertMatch.mock
+}
+
+// When sets expectation for the Inserter.InsertMatch which will trigger the result defined by the following
+// Then helper
+func (mmInsertMatch *mInserterMockInsertMatch) When(ctx context.Context, document interface{}) *InserterMockInsertMatchExpectation {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("InserterMock.InsertMatch mock is already set by Set")
+	}
+
+	expectation := &InserterMockInsertMatchExpectation{
+		mock:   mmInsertMatch.mock,
+		params: &InserterMockInsertMatchParams{ctx, document},
+	}
+	mmInsertMatch.expectations = append(mmInsertMatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Inserter.InsertMatch return parameters for the expectation previously defined by the When method
+func (e *InserterMockInsertMatchExpectation) Then(err error) *InserterMock {
+	e.results = &InserterMockInsertMatchResults{err}
+	return e.mock
+}
+
+// InsertMatch implements mongo.Inserter
+func (mmInsertMatch *InserterMock) InsertMatch(ctx context.Context, document interface{}) (err error) {
+	mm_atomic.AddUint64(&mmInsertMatch.beforeInsertMatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmInsertMatch.afterInsertMatchCounter, 1)
+
+	if mmInsertMatch.inspectFuncInsertMatch != nil {
+		mmInsertMatch.inspectFuncInsertMatch(ctx, document)
+	}
+
+	mm_params := &InserterMockInsertMatchParams{ctx, document}
+
+	// Record call args
+	mmInsertMatch.InsertMatchMock.mutex.Lock()
+	mmInsertMatch.InsertMatchMock.callArgs = append(mmInsertMatch.InsertMatchMock.callArgs, mm_params)
+	mmInsertMatch.InsertMatchMock.mutex.Unlock()
+
+	for _, e := range mmInsertMatch.InsertMatchMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInsertMatch.InsertMatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInsertMatch.InsertMatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmInsertMatch.InsertMatchMock.defaultExpectation.params
+		mm_got := InserterMockInsertMatchParams{ctx, document}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInsertMatch.t.Errorf("InserterMock.InsertMatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInsertMatch.InsertMatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInsertMatch.t.Fatal("No results are set for the InserterMock.InsertMatch")
+		}
+		return (*mm_results).err
+	}
+	if mmInsertMatch.funcInsertMatch != nil {
+		return mmInsertMatch.funcInsertMatch(ctx, document)
+	}
+	mmInsertMatch.t.Fatalf("Unexpected call to InserterMock.InsertMatch. %v %v", ctx, document)
+	return
+}
+
+// InsertMatchAfterCounter returns a count of finished InserterMock.InsertMatch invocations
+func (mmInsertMatch *InserterMock) InsertMatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertMatch.afterInsertMatchCounter)
+}
+
+// InsertMatchBeforeCounter returns a count of InserterMock.InsertMatch invocations
+func (mmInsertMatch *InserterMock) InsertMatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertMatch.beforeInsertMatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to InserterMock.InsertMatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInsertMatch *mInserterMockInsertMatch) Calls() []*InserterMockInsertMatchParams {
+	mmInsertMatch.mutex.RLock()
+
+	argCopy := make([]*InserterMockInsertMatchParams, len(mmInsertMatch.callArgs))
+	copy(argCopy, mmInsertMatch.callArgs)
+
+	mmInsertMatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInsertMatchDone returns true if the count of the InsertMatch invocations corresponds
+// the number of defined expectations
+func (m *InserterMock) MinimockInsertMatchDone() bool {
+	for _, e := range m.InsertMatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertMatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertMatch != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockInsertMatchInspect logs each unmet expectation
+func (m *InserterMock) MinimockInsertMatchInspect() {
+	for _, e := range m.InsertMatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to InserterMock.InsertMatch with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertMatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		if m.InsertMatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to InserterMock.InsertMatch")
+		} else {
+			m.t.Errorf("Expected call to InserterMock.InsertMatch with params: %#v", *m.InsertMatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertMatch != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		m.t.Error("Expected call to InserterMock.InsertMatch")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *InserterMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockInsertGameStatsInspect()
+
+		m.MinimockInsertMatchInspect()
 		m.t.FailNow()
 	}
 }
@@ -278,5 +507,6 @@ func (m *InserterMock) MinimockWait(timeout mm_time.Duration) {
 func (m *InserterMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockInsertGameStatsDone()
+		m.MinimockInsertGameStatsDone() &&
+		m.MinimockInsertMatchDone()
 }
@@ -6,8 +6,8 @@ package mocks
 
 import (
 	mm_requests "LogWatcher/pkg/requests"
-	"LogWatcher/pkg/stats"
 	"bytes"
+	"context"
 	"io"
 	"sync"
 	mm_atomic "sync/atomic"
@@ -20,26 +20,14 @@ import (
 type LogUploaderMock struct {
 	t minimock.Tester
 
-	funcFindMatchingPickup          func(domain string, Map string) (pp1 *mm_requests.Pickup, err error)
-	inspectFuncFindMatchingPickup   func(domain string, Map string)
-	afterFindMatchingPickupCounter  uint64
-	beforeFindMatchingPickupCounter uint64
-	FindMatchingPickupMock          mLogUploaderMockFindMatchingPickup
-
-	funcMakeMultipartMap          func(matcher stats.Matcher, buf bytes.Buffer) (m1 map[string]io.Reader)
-	inspectFuncMakeMultipartMap   func(matcher stats.Matcher, buf bytes.Buffer)
+	funcMakeMultipartMap          func(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader)
+	inspectFuncMakeMultipartMap   func(title string, gameMap string, buf bytes.Buffer)
 	afterMakeMultipartMapCounter  uint64
 	beforeMakeMultipartMapCounter uint64
 	MakeMultipartMapMock          mLogUploaderMockMakeMultipartMap
 
-	funcResolvePlayers          func(domain string, players []*stats.PickupPlayer) (err error)
-	inspectFuncResolvePlayers   func(domain string, players []*stats.PickupPlayer)
-	afterResolvePlayersCounter  uint64
-	beforeResolvePlayersCounter uint64
-	ResolvePlayersMock          mLogUploaderMockResolvePlayers
-
-	funcUploadLogFile          func(payload map[string]io.Reader) (err error)
-	inspectFuncUploadLogFile   func(payload map[string]io.Reader)
+	funcUploadLogFile          func(ctx context.Context, payload map[string]io.Reader) (up1 *mm_requests.UploadResult, err error)
+	inspectFuncUploadLogFile   func(ctx context.Context, payload map[string]io.Reader)
 	afterUploadLogFileCounter  uint64
 	beforeUploadLogFileCounter uint64
 	UploadLogFileMock          mLogUploaderMockUploadLogFile
@@ -52,238 +40,15 @@ func NewLogUploaderMock(t minimock.Tester) *LogUploaderMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.FindMatchingPickupMock = mLogUploaderMockFindMatchingPickup{mock: m}
-	m.FindMatchingPickupMock.callArgs = []*LogUploaderMockFindMatchingPickupParams{}
-
 	m.MakeMultipartMapMock = mLogUploaderMockMakeMultipartMap{mock: m}
 	m.MakeMultipartMapMock.callArgs = []*LogUploaderMockMakeMultipartMapParams{}
 
-	m.ResolvePlayersMock = mLogUploaderMockResolvePlayers{mock: m}
-	m.ResolvePlayersMock.callArgs = []*LogUploaderMockResolvePlayersParams{}
-
 	m.UploadLogFileMock = mLogUploaderMockUploadLogFile{mock: m}
 	m.UploadLogFileMock.callArgs = []*LogUploaderMockUploadLogFileParams{}
 
 	return m
 }
 
-type mLogUploaderMockFindMatchingPickup struct {
-	mock               *LogUploaderMock
-	defaultExpectation *LogUploaderMockFindMatchingPickupExpectation
-	expectations       []*LogUploaderMockFindMatchingPickupExpectation
-
-	callArgs []*LogUploaderMockFindMatchingPickupParams
-	mutex    sync.RWMutex
-}
-
-// LogUploaderMockFindMatchingPickupExpectation specifies expectation struct of the LogUploader.FindMatchingPickup
-type LogUploaderMockFindMatchingPickupExpectation struct {
-	mock    *LogUploaderMock
-	params  *LogUploaderMockFindMatchingPickupParams
-	results *LogUploaderMockFindMatchingPickupResults
-	Counter uint64
-}
-
-// LogUploaderMockFindMatchingPickupParams contains parameters of the LogUploader.FindMatchingPickup
-type LogUploaderMockFindMatchingPickupParams struct {
-	domain string
-	Map    string
-}
-
-// LogUploaderMockFindMatchingPickupResults contains results of the LogUploader.FindMatchingPickup
-type LogUploaderMockFindMatchingPickupResults struct {
-	pp1 *mm_requests.Pickup
-	err error
-}
-
-// Expect sets up expected params for LogUploader.FindMatchingPickup
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) Expect(domain string, Map string) *mLogUploaderMockFindMatchingPickup {
-	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
-		mmFindMatchingPickup.mock.t.Fatalf("LogUploaderMock.FindMatchingPickup mock is already set by Set")
-	}
-
-	if mmFindMatchingPickup.defaultExpectation == nil {
-		mmFindMatchingPickup.defaultExpectation = &LogUploaderMockFindMatchingPickupExpectation{}
-	}
-
-	mmFindMatchingPickup.defaultExpectation.params = &LogUploaderMockFindMatchingPickupParams{domain, Map}
-	for _, e := range mmFindMatchingPickup.expectations {
-		if minimock.Equal(e.params, mmFindMatchingPickup.defaultExpectation.params) {
-			mmFindMatchingPickup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindMatchingPickup.defaultExpectation.params)
-		}
-	}
-
-	return mmFindMatchingPickup
-}
-
-// Inspect accepts an inspector function that has same arguments as the LogUploader.FindMatchingPickup
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) Inspect(f func(domain string, Map string)) *mLogUploaderMockFindMatchingPickup {
-	if mmFindMatchingPickup.mock.inspectFuncFindMatchingPickup != nil {
-		mmFindMatchingPickup.mock.t.Fatalf("Inspect function is already set for LogUploaderMock.FindMatchingPickup")
-	}
-
-	mmFindMatchingPickup.mock.inspectFuncFindMatchingPickup = f
-
-	return mmFindMatchingPickup
-}
-
-// Return sets up results that will be returned by LogUploader.FindMatchingPickup
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) Return(pp1 *mm_requests.Pickup, err error) *LogUploaderMock {
-	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
-		mmFindMatchingPickup.mock.t.Fatalf("LogUploaderMock.FindMatchingPickup mock is already set by Set")
-	}
-
-	if mmFindMatchingPickup.defaultExpectation == nil {
-		mmFindMatchingPickup.defaultExpectation = &LogUploaderMockFindMatchingPickupExpectation{mock: mmFindMatchingPickup.mock}
-	}
-	mmFindMatchingPickup.defaultExpectation.results = &LogUploaderMockFindMatchingPickupResults{pp1, err}
-	return mmFindMatchingPickup.mock
-}
-
-//Set uses given function f to mock the LogUploader.FindMatchingPickup method
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) Set(f func(domain string, Map string) (pp1 *mm_requests.Pickup, err error)) *LogUploaderMock {
-	if mmFindMatchingPickup.defaultExpectation != nil {
-		mmFindMatchingPickup.mock.t.Fatalf("Default expectation is already set for the LogUploader.FindMatchingPickup method")
-	}
-
-	if len(mmFindMatchingPickup.expectations) > 0 {
-		mmFindMatchingPickup.mock.t.Fatalf("Some expectations are already set for the LogUploader.FindMatchingPickup method")
-	}
-
-	mmFindMatchingPickup.mock.funcFindMatchingPickup = f
-	return mmFindMatchingPickup.mock
-}
-
-// When sets expectation for the LogUploader.FindMatchingPickup which will trigger the result defined by the following
-// Then helper
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) When(domain string, Map string) *LogUploaderMockFindMatchingPickupExpectation {
-	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
-		mmFindMatchingPickup.mock.t.Fatalf("LogUploaderMock.FindMatchingPickup mock is already set by Set")
-	}
-
-	expectation := &LogUploaderMockFindMatchingPickupExpectation{
-		mock:   mmFindMatchingPickup.mock,
-		params: &LogUploaderMockFindMatchingPickupParams{domain, Map},
-	}
-	mmFindMatchingPickup.expectations = append(mmFindMatchingPickup.expectations, expectation)
-	return expectation
-}
-
-// Then sets up LogUploader.FindMatchingPickup return parameters for the expectation previously defined by the When method
-func (e *LogUploaderMockFindMatchingPickupExpectation) Then(pp1 *mm_requests.Pickup, err error) *LogUploaderMock {
-	e.results = &LogUploaderMockFindMatchingPickupResults{pp1, err}
-	return e.mock
-}
-
-// FindMatchingPickup implements requests.LogUploader
-func (mmFindMatchingPickup *LogUploaderMock) FindMatchingPickup(domain string, Map string) (pp1 *mm_requests.Pickup, err error) {
-	mm_atomic.AddUint64(&mmFindMatchingPickup.beforeFindMatchingPickupCounter, 1)
-	defer mm_atomic.AddUint64(&mmFindMatchingPickup.afterFindMatchingPickupCounter, 1)
-
-	if mmFindMatchingPickup.inspectFuncFindMatchingPickup != nil {
-		mmFindMatchingPickup.inspectFuncFindMatchingPickup(domain, Map)
-	}
-
-	mm_params := &LogUploaderMockFindMatchingPickupParams{domain, Map}
-
-	// Record call args
-	mmFindMatchingPickup.FindMatchingPickupMock.mutex.Lock()
-	mmFindMatchingPickup.FindMatchingPickupMock.callArgs = append(mmFindMatchingPickup.FindMatchingPickupMock.callArgs, mm_params)
-	mmFindMatchingPickup.FindMatchingPickupMock.mutex.Unlock()
-
-	for _, e := range mmFindMatchingPickup.FindMatchingPickupMock.expectations {
-		if minimock.Equal(e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pp1, e.results.err
-		}
-	}
-
-	if mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.Counter, 1)
-		mm_want := mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.params
-		mm_got := LogUploaderMockFindMatchingPickupParams{domain, Map}
-		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmFindMatchingPickup.t.Errorf("LogUploaderMock.FindMatchingPickup got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.results
-		if mm_results == nil {
-			mmFindMatchingPickup.t.Fatal("No results are set for the LogUploaderMock.FindMatchingPickup")
-		}
-		return (*mm_results).pp1, (*mm_results).err
-	}
-	if mmFindMatchingPickup.funcFindMatchingPickup != nil {
-		return mmFindMatchingPickup.funcFindMatchingPickup(domain, Map)
-	}
-	mmFindMatchingPickup.t.Fatalf("Unexpected call to LogUploaderMock.FindMatchingPickup. %v %v", domain, Map)
-	return
-}
-
-// FindMatchingPickupAfterCounter returns a count of finished LogUploaderMock.FindMatchingPickup invocations
-func (mmFindMatchingPickup *LogUploaderMock) FindMatchingPickupAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindMatchingPickup.afterFindMatchingPickupCounter)
-}
-
-// FindMatchingPickupBeforeCounter returns a count of LogUploaderMock.FindMatchingPickup invocations
-func (mmFindMatchingPickup *LogUploaderMock) FindMatchingPickupBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFindMatchingPickup.beforeFindMatchingPickupCounter)
-}
-
-// Calls returns a list of arguments used in each call to LogUploaderMock.FindMatchingPickup.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmFindMatchingPickup *mLogUploaderMockFindMatchingPickup) Calls() []*LogUploaderMockFindMatchingPickupParams {
-	mmFindMatchingPickup.mutex.RLock()
-
-	argCopy := make([]*LogUploaderMockFindMatchingPickupParams, len(mmFindMatchingPickup.callArgs))
-	copy(argCopy, mmFindMatchingPickup.callArgs)
-
-	mmFindMatchingPickup.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockFindMatchingPickupDone returns true if the count of the FindMatchingPickup invocations corresponds
-// the number of defined expectations
-func (m *LogUploaderMock) MinimockFindMatchingPickupDone() bool {
-	for _, e := range m.FindMatchingPickupMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FindMatchingPickupMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFindMatchingPickup != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockFindMatchingPickupInspect logs each unmet expectation
-func (m *LogUploaderMock) MinimockFindMatchingPickupInspect() {
-	for _, e := range m.FindMatchingPickupMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to LogUploaderMock.FindMatchingPickup with params: %#v", *e.params)
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FindMatchingPickupMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
-		if m.FindMatchingPickupMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to LogUploaderMock.FindMatchingPickup")
-		} else {
-			m.t.Errorf("Expected call to LogUploaderMock.FindMatchingPickup with params: %#v", *m.FindMatchingPickupMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFindMatchingPickup != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
-		m.t.Error("Expected call to LogUploaderMock.FindMatchingPickup")
-	}
-}
-
 type mLogUploaderMockMakeMultipartMap struct {
 	mock               *LogUploaderMock
 	defaultExpectation *LogUploaderMockMakeMultipartMapExpectation
@@ -303,7 +68,8 @@ type LogUploaderMockMakeMultipartMapExpectation struct {
 
 // LogUploaderMockMakeMultipartMapParams contains parameters of the LogUploader.MakeMultipartMap
 type LogUploaderMockMakeMultipartMapParams struct {
-	matcher stats.Matcher
+	title   string
+	gameMap string
 	buf     bytes.Buffer
 }
 
@@ -313,7 +79,7 @@ type LogUploaderMockMakeMultipartMapResults struct {
 }
 
 // Expect sets up expected params for LogUploader.MakeMultipartMap
-func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Expect(matcher stats.Matcher, buf bytes.Buffer) *mLogUploaderMockMakeMultipartMap {
+func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Expect(title string, gameMap string, buf bytes.Buffer) *mLogUploaderMockMakeMultipartMap {
 	if mmMakeMultipartMap.mock.funcMakeMultipartMap != nil {
 		mmMakeMultipartMap.mock.t.Fatalf("LogUploaderMock.MakeMultipartMap mock is already set by Set")
 	}
@@ -322,7 +88,7 @@ func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Expect(matcher stats
 		mmMakeMultipartMap.defaultExpectation = &LogUploaderMockMakeMultipartMapExpectation{}
 	}
 
-	mmMakeMultipartMap.defaultExpectation.params = &LogUploaderMockMakeMultipartMapParams{matcher, buf}
+	mmMakeMultipartMap.defaultExpectation.params = &LogUploaderMockMakeMultipartMapParams{title, gameMap, buf}
 	for _, e := range mmMakeMultipartMap.expectations {
 		if minimock.Equal(e.params, mmMakeMultipartMap.defaultExpectation.params) {
 			mmMakeMultipartMap.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeMultipartMap.defaultExpectation.params)
@@ -333,7 +99,7 @@ func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Expect(matcher stats
 }
 
 // Inspect accepts an inspector function that has same arguments as the LogUploader.MakeMultipartMap
-func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Inspect(f func(matcher stats.Matcher, buf bytes.Buffer)) *mLogUploaderMockMakeMultipartMap {
+func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Inspect(f func(title string, gameMap string, buf bytes.Buffer)) *mLogUploaderMockMakeMultipartMap {
 	if mmMakeMultipartMap.mock.inspectFuncMakeMultipartMap != nil {
 		mmMakeMultipartMap.mock.t.Fatalf("Inspect function is already set for LogUploaderMock.MakeMultipartMap")
 	}
@@ -357,7 +123,7 @@ func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Return(m1 map[string
 }
 
 //Set uses given function f to mock the LogUploader.MakeMultipartMap method
-func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Set(f func(matcher stats.Matcher, buf bytes.Buffer) (m1 map[string]io.Reader)) *LogUploaderMock {
+func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Set(f func(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader)) *LogUploaderMock {
 	if mmMakeMultipartMap.defaultExpectation != nil {
 		mmMakeMultipartMap.mock.t.Fatalf("Default expectation is already set for the LogUploader.MakeMultipartMap method")
 	}
@@ -372,14 +138,14 @@ func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) Set(f func(matcher s
 
 // When sets expectation for the LogUploader.MakeMultipartMap which will trigger the result defined by the following
 // Then helper
-func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) When(matcher stats.Matcher, buf bytes.Buffer) *LogUploaderMockMakeMultipartMapExpectation {
+func (mmMakeMultipartMap *mLogUploaderMockMakeMultipartMap) When(title string, gameMap string, buf bytes.Buffer) *LogUploaderMockMakeMultipartMapExpectation {
 	if mmMakeMultipartMap.mock.funcMakeMultipartMap != nil {
 		mmMakeMultipartMap.mock.t.Fatalf("LogUploaderMock.MakeMultipartMap mock is already set by Set")
 	}
 
 	expectation := &LogUploaderMockMakeMultipartMapExpectation{
 		mock:   mmMakeMultipartMap.mock,
-		params: &LogUploaderMockMakeMultipartMapParams{matcher, buf},
+		params: &LogUploaderMockMakeMultipartMapParams{title, gameMap, buf},
 	}
 	mmMakeMultipartMap.expectations = append(mmMakeMultipartMap.expectations, expectation)
 	return expectation
@@ -392,15 +158,15 @@ func (e *LogUploaderMockMakeMultipartMapExpectation) Then(m1 map[string]io.Reade
 }
 
 // MakeMultipartMap implements requests.LogUploader
-func (mmMakeMultipartMap *LogUploaderMock) MakeMultipartMap(matcher stats.Matcher, buf bytes.Buffer) (m1 map[string]io.Reader) {
+func (mmMakeMultipartMap *LogUploaderMock) MakeMultipartMap(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader) {
 	mm_atomic.AddUint64(&mmMakeMultipartMap.beforeMakeMultipartMapCounter, 1)
 	defer mm_atomic.AddUint64(&mmMakeMultipartMap.afterMakeMultipartMapCounter, 1)
 
 	if mmMakeMultipartMap.inspectFuncMakeMultipartMap != nil {
-		mmMakeMultipartMap.inspectFuncMakeMultipartMap(matcher, buf)
+		mmMakeMultipartMap.inspectFuncMakeMultipartMap(title, gameMap, buf)
 	}
 
-	mm_params := &LogUploaderMockMakeMultipartMapParams{matcher, buf}
+	mm_params := &LogUploaderMockMakeMultipartMapParams{title, gameMap, buf}
 
 	// Record call args
 	mmMakeMultipartMap.MakeMultipartMapMock.mutex.Lock()
@@ -417,7 +183,7 @@ func (mmMakeMultipartMap *LogUploaderMock) MakeMultipartMap(matcher stats.Matche
 	if mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation.Counter, 1)
 		mm_want := mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation.params
-		mm_got := LogUploaderMockMakeMultipartMapParams{matcher, buf}
+		mm_got := LogUploaderMockMakeMultipartMapParams{title, gameMap, buf}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmMakeMultipartMap.t.Errorf("LogUploaderMock.MakeMultipartMap got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -429,9 +195,9 @@ func (mmMakeMultipartMap *LogUploaderMock) MakeMultipartMap(matcher stats.Matche
 		return (*mm_results).m1
 	}
 	if mmMakeMultipartMap.funcMakeMultipartMap != nil {
-		return mmMakeMultipartMap.funcMakeMultipartMap(matcher, buf)
+		return mmMakeMultipartMap.funcMakeMultipartMap(title, gameMap, buf)
 	}
-	mmMakeMultipartMap.t.Fatalf("Unexpected call to LogUploaderMock.MakeMultipartMap. %v %v", matcher, buf)
+	mmMakeMultipartMap.t.Fatalf("Unexpected call to LogUploaderMock.MakeMultipartMap. %v %v %v", title, gameMap, buf)
 	return
 }
 
@@ -500,222 +266,6 @@ func (m *LogUploaderMock) MinimockMakeMultipartMapInspect() {
 	}
 }
 
-type mLogUploaderMockResolvePlayers struct {
-	mock               *LogUploaderMock
-	defaultExpectation *LogUploaderMockResolvePlayersExpectation
-	expectations       []*LogUploaderMockResolvePlayersExpectation
-
-	callArgs []*LogUploaderMockResolvePlayersParams
-	mutex    sync.RWMutex
-}
-
-// LogUploaderMockResolvePlayersExpectation specifies expectation struct of the LogUploader.ResolvePlayers
-type LogUploaderMockResolvePlayersExpectation struct {
-	mock    *LogUploaderMock
-	params  *LogUploaderMockResolvePlayersParams
-	results *LogUploaderMockResolvePlayersResults
-	Counter uint64
-}
-
-// LogUploaderMockResolvePlayersParams contains parameters of the LogUploader.ResolvePlayers
-type LogUploaderMockResolvePlayersParams struct {
-	domain  string
-	players []*stats.PickupPlayer
-}
-
-// LogUploaderMockResolvePlayersResults contains results of the LogUploader.ResolvePlayers
-type LogUploaderMockResolvePlayersResults struct {
-	err error
-}
-
-// Expect sets up expected params for LogUploader.ResolvePlayers
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) Expect(domain string, players []*stats.PickupPlayer) *mLogUploaderMockResolvePlayers {
-	if mmResolvePlayers.mock.funcResolvePlayers != nil {
-		mmResolvePlayers.mock.t.Fatalf("LogUploaderMock.ResolvePlayers mock is already set by Set")
-	}
-
-	if mmResolvePlayers.defaultExpectation == nil {
-		mmResolvePlayers.defaultExpectation = &LogUploaderMockResolvePlayersExpectation{}
-	}
-
-	mmResolvePlayers.defaultExpectation.params = &LogUploaderMockResolvePlayersParams{domain, players}
-	for _, e := range mmResolvePlayers.expectations {
-		if minimock.Equal(e.params, mmResolvePlayers.defaultExpectation.params) {
-			mmResolvePlayers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolvePlayers.defaultExpectation.params)
-		}
-	}
-
-	return mmResolvePlayers
-}
-
-// Inspect accepts an inspector function that has same arguments as the LogUploader.ResolvePlayers
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) Inspect(f func(domain string, players []*stats.PickupPlayer)) *mLogUploaderMockResolvePlayers {
-	if mmResolvePlayers.mock.inspectFuncResolvePlayers != nil {
-		mmResolvePlayers.mock.t.Fatalf("Inspect function is already set for LogUploaderMock.ResolvePlayers")
-	}
-
-	mmResolvePlayers.mock.inspectFuncResolvePlayers = f
-
-	return mmResolvePlayers
-}
-
-// Return sets up results that will be returned by LogUploader.ResolvePlayers
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) Return(err error) *LogUploaderMock {
-	if mmResolvePlayers.mock.funcResolvePlayers != nil {
-		mmResolvePlayers.mock.t.Fatalf("LogUploaderMock.ResolvePlayers mock is already set by Set")
-	}
-
-	if mmResolvePlayers.defaultExpectation == nil {
-		mmResolvePlayers.defaultExpectation = &LogUploaderMockResolvePlayersExpectation{mock: mmResolvePlayers.mock}
-	}
-	mmResolvePlayers.defaultExpectation.results = &LogUploaderMockResolvePlayersResults{err}
-	return mmResolvePlayers.mock
-}
-
-//Set uses given function f to mock the LogUploader.ResolvePlayers method
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) Set(f func(domain string, players []*stats.PickupPlayer) (err error)) *LogUploaderMock {
-	if mmResolvePlayers.defaultExpectation != nil {
-		mmResolvePlayers.mock.t.Fatalf("Default expectation is already set for the LogUploader.ResolvePlayers method")
-	}
-
-	if len(mmResolvePlayers.expectations) > 0 {
-		mmResolvePlayers.mock.t.Fatalf("Some expectations are already set for the LogUploader.ResolvePlayers method")
-	}
-
-	mmResolvePlayers.mock.funcResolvePlayers = f
-	return mmResolvePlayers.mock
-}
-
-// When sets expectation for the LogUploader.ResolvePlayers which will trigger the result defined by the following
-// Then helper
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) When(domain string, players []*stats.PickupPlayer) *LogUploaderMockResolvePlayersExpectation {
-	if mmResolvePlayers.mock.funcResolvePlayers != nil {
-		mmResolvePlayers.mock.t.Fatalf("LogUploaderMock.ResolvePlayers mock is already set by Set")
-	}
-
-	expectation := &LogUploaderMockResolvePlayersExpectation{
-		mock:   mmResolvePlayers.mock,
-		params: &LogUploaderMockResolvePlayersParams{domain, players},
-	}
-	mmResolvePlayers.expectations = append(mmResolvePlayers.expectations, expectation)
-	return expectation
-}
-
-// Then sets up LogUploader.ResolvePlayers return parameters for the expectation previously defined by the When method
-func (e *LogUploaderMockResolvePlayersExpectation) Then(err error) *LogUploaderMock {
-	e.results = &LogUploaderMockResolvePlayersResults{err}
-	return e.mock
-}
-
-// ResolvePlayers implements requests.LogUploader
-func (mmResolvePlayers *LogUploaderMock) ResolvePlayers(domain string, players []*stats.PickupPlayer) (err error) {
-	mm_atomic.AddUint64(&mmResolvePlayers.beforeResolvePlayersCounter, 1)
-	defer mm_atomic.AddUint64(&mmResolvePlayers.afterResolvePlayersCounter, 1)
-
-	if mmResolvePlayers.inspectFuncResolvePlayers != nil {
-		mmResolvePlayers.inspectFuncResolvePlayers(domain, players)
-	}
-
-	mm_params := &LogUploaderMockResolvePlayersParams{domain, players}
-
-	// Record call args
-	mmResolvePlayers.ResolvePlayersMock.mutex.Lock()
-	mmResolvePlayers.ResolvePlayersMock.callArgs = append(mmResolvePlayers.ResolvePlayersMock.callArgs, mm_params)
-	mmResolvePlayers.ResolvePlayersMock.mutex.Unlock()
-
-	for _, e := range mmResolvePlayers.ResolvePlayersMock.expectations {
-		if minimock.Equal(e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
-		}
-	}
-
-	if mmResolvePlayers.ResolvePlayersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmResolvePlayers.ResolvePlayersMock.defaultExpectation.Counter, 1)
-		mm_want := mmResolvePlayers.ResolvePlayersMock.defaultExpectation.params
-		mm_got := LogUploaderMockResolvePlayersParams{domain, players}
-		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmResolvePlayers.t.Errorf("LogUploaderMock.ResolvePlayers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmResolvePlayers.ResolvePlayersMock.defaultExpectation.results
-		if mm_results == nil {
-			mmResolvePlayers.t.Fatal("No results are set for the LogUploaderMock.ResolvePlayers")
-		}
-		return (*mm_results).err
-	}
-	if mmResolvePlayers.funcResolvePlayers != nil {
-		return mmResolvePlayers.funcResolvePlayers(domain, players)
-	}
-	mmResolvePlayers.t.Fatalf("Unexpected call to LogUploaderMock.ResolvePlayers. %v %v", domain, players)
-	return
-}
-
-// ResolvePlayersAfterCounter returns a count of finished LogUploaderMock.ResolvePlayers invocations
-func (mmResolvePlayers *LogUploaderMock) ResolvePlayersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmResolvePlayers.afterResolvePlayersCounter)
-}
-
-// ResolvePlayersBeforeCounter returns a count of LogUploaderMock.ResolvePlayers invocations
-func (mmResolvePlayers *LogUploaderMock) ResolvePlayersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmResolvePlayers.beforeResolvePlayersCounter)
-}
-
-// Calls returns a list of arguments used in each call to LogUploaderMock.ResolvePlayers.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmResolvePlayers *mLogUploaderMockResolvePlayers) Calls() []*LogUploaderMockResolvePlayersParams {
-	mmResolvePlayers.mutex.RLock()
-
-	argCopy := make([]*LogUploaderMockResolvePlayersParams, len(mmResolvePlayers.callArgs))
-	copy(argCopy, mmResolvePlayers.callArgs)
-
-	mmResolvePlayers.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockResolvePlayersDone returns true if the count of the ResolvePlayers invocations corresponds
-// the number of defined expectations
-func (m *LogUploaderMock) MinimockResolvePlayersDone() bool {
-	for _, e := range m.ResolvePlayersMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ResolvePlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcResolvePlayers != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockResolvePlayersInspect logs each unmet expectation
-func (m *LogUploaderMock) MinimockResolvePlayersInspect() {
-	for _, e := range m.ResolvePlayersMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to LogUploaderMock.ResolvePlayers with params: %#v", *e.params)
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ResolvePlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
-		if m.ResolvePlayersMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to LogUploaderMock.ResolvePlayers")
-		} else {
-			m.t.Errorf("Expected call to LogUploaderMock.ResolvePlayers with params: %#v", *m.ResolvePlayersMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcResolvePlayers != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
-		m.t.Error("Expected call to LogUploaderMock.ResolvePlayers")
-	}
-}
-
 type mLogUploaderMockUploadLogFile struct {
 	mock               *LogUploaderMock
 	defaultExpectation *LogUploaderMockUploadLogFileExpectation
@@ -735,16 +285,18 @@ type LogUploaderMockUploadLogFileExpectation struct {
 
 // LogUploaderMockUploadLogFileParams contains parameters of the LogUploader.UploadLogFile
 type LogUploaderMockUploadLogFileParams struct {
+	ctx     context.Context
 	payload map[string]io.Reader
 }
 
 // LogUploaderMockUploadLogFileResults contains results of the LogUploader.UploadLogFile
 type LogUploaderMockUploadLogFileResults struct {
+	up1 *mm_requests.UploadResult
 	err error
 }
 
 // Expect sets up expected params for LogUploader.UploadLogFile
-func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Expect(payload map[string]io.Reader) *mLogUploaderMockUploadLogFile {
+func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Expect(ctx context.Context, payload map[string]io.Reader) *mLogUploaderMockUploadLogFile {
 	if mmUploadLogFile.mock.funcUploadLogFile != nil {
 		mmUploadLogFile.mock.t.Fatalf("LogUploaderMock.UploadLogFile mock is already set by Set")
 	}
@@ -753,7 +305,7 @@ func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Expect(payload map[string]
 		mmUploadLogFile.defaultExpectation = &LogUploaderMockUploadLogFileExpectation{}
 	}
 
-	mmUploadLogFile.defaultExpectation.params = &LogUploaderMockUploadLogFileParams{payload}
+	mmUploadLogFile.defaultExpectation.params = &LogUploaderMockUploadLogFileParams{ctx, payload}
 	for _, e := range mmUploadLogFile.expectations {
 		if minimock.Equal(e.params, mmUploadLogFile.defaultExpectation.params) {
 			mmUploadLogFile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadLogFile.defaultExpectation.params)
@@ -764,7 +316,7 @@ func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Expect(payload map[string]
 }
 
 // Inspect accepts an inspector function that has same arguments as the LogUploader.UploadLogFile
-func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Inspect(f func(payload map[string]io.Reader)) *mLogUploaderMockUploadLogFile {
+func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Inspect(f func(ctx context.Context, payload map[string]io.Reader)) *mLogUploaderMockUploadLogFile {
 	if mmUploadLogFile.mock.inspectFuncUploadLogFile != nil {
 		mmUploadLogFile.mock.t.Fatalf("Inspect function is already set for LogUploaderMock.UploadLogFile")
 	}
@@ -775,7 +327,7 @@ func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Inspect(f func(payload map
 }
 
 // Return sets up results that will be returned by LogUploader.UploadLogFile
-func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Return(err error) *LogUploaderMock {
+func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Return(up1 *mm_requests.UploadResult, err error) *LogUploaderMock {
 	if mmUploadLogFile.mock.funcUploadLogFile != nil {
 		mmUploadLogFile.mock.t.Fatalf("LogUploaderMock.UploadLogFile mock is already set by Set")
 	}
@@ -783,12 +335,12 @@ func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Return(err error) *LogUplo
 	if mmUploadLogFile.defaultExpectation == nil {
 		mmUploadLogFile.defaultExpectation = &LogUploaderMockUploadLogFileExpectation{mock: mmUploadLogFile.mock}
 	}
-	mmUploadLogFile.defaultExpectation.results = &LogUploaderMockUploadLogFileResults{err}
+	mmUploadLogFile.defaultExpectation.results = &LogUploaderMockUploadLogFileResults{up1, err}
 	return mmUploadLogFile.mock
 }
 
 //Set uses given function f to mock the LogUploader.UploadLogFile method
-func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Set(f func(payload map[string]io.Reader) (err error)) *LogUploaderMock {
+func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Set(f func(ctx context.Context, payload map[string]io.Reader) (up1 *mm_requests.UploadResult, err error)) *LogUploaderMock {
 	if mmUploadLogFile.defaultExpectation != nil {
 		mmUploadLogFile.mock.t.Fatalf("Default expectation is already set for the LogUploader.UploadLogFile method")
 	}
@@ -803,35 +355,35 @@ func (mmUploadLogFile *mLogUploaderMockUploadLogFile) Set(f func(payload map[str
 
 // When sets expectation for the LogUploader.UploadLogFile which will trigger the result defined by the following
 // Then helper
-func (mmUploadLogFile *mLogUploaderMockUploadLogFile) When(payload map[string]io.Reader) *LogUploaderMockUploadLogFileExpectation {
+func (mmUploadLogFile *mLogUploaderMockUploadLogFile) When(ctx context.Context, payload map[string]io.Reader) *LogUploaderMockUploadLogFileExpectation {
 	if mmUploadLogFile.mock.funcUploadLogFile != nil {
 		mmUploadLogFile.mock.t.Fatalf("LogUploaderMock.UploadLogFile mock is already set by Set")
 	}
 
 	expectation := &LogUploaderMockUploadLogFileExpectation{
 		mock:   mmUploadLogFile.mock,
-		params: &LogUploaderMockUploadLogFileParams{payload},
+		params: &LogUploaderMockUploadLogFileParams{ctx, payload},
 	}
 	mmUploadLogFile.expectations = append(mmUploadLogFile.expectations, expectation)
 	return expectation
 }
 
 // Then sets up LogUploader.UploadLogFile return parameters for the expectation previously defined by the When method
-func (e *LogUploaderMockUploadLogFileExpectation) Then(err error) *LogUploaderMock {
-	e.results = &LogUploaderMockUploadLogFileResults{err}
+func (e *LogUploaderMockUploadLogFileExpectation) Then(up1 *mm_requests.UploadResult, err error) *LogUploaderMock {
+	e.results = &LogUploaderMockUploadLogFileResults{up1, err}
 	return e.mock
 }
 
 // UploadLogFile implements requests.LogUploader
-func (mmUploadLogFile *LogUploaderMock) UploadLogFile(payload map[string]io.Reader) (err error) {
+func (mmUploadLogFile *LogUploaderMock) UploadLogFile(ctx context.Context, payload map[string]io.Reader) (up1 *mm_requests.UploadResult, err error) {
 	mm_atomic.AddUint64(&mmUploadLogFile.beforeUploadLogFileCounter, 1)
 	defer mm_atomic.AddUint64(&mmUploadLogFile.afterUploadLogFileCounter, 1)
 
 	if mmUploadLogFile.inspectFuncUploadLogFile != nil {
-		mmUploadLogFile.inspectFuncUploadLogFile(payload)
+		mmUploadLogFile.inspectFuncUploadLogFile(ctx, payload)
 	}
 
-	mm_params := &LogUploaderMockUploadLogFileParams{payload}
+	mm_params := &LogUploaderMockUploadLogFileParams{ctx, payload}
 
 	// Record call args
 	mmUploadLogFile.UploadLogFileMock.mutex.Lock()
@@ -841,14 +393,14 @@ func (mmUploadLogFile *LogUploaderMock) UploadLogFile(payload map[string]io.Read
 	for _, e := range mmUploadLogFile.UploadLogFileMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.up1, e.results.err
 		}
 	}
 
 	if mmUploadLogFile.UploadLogFileMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmUploadLogFile.UploadLogFileMock.defaultExpectation.Counter, 1)
 		mm_want := mmUploadLogFile.UploadLogFileMock.defaultExpectation.params
-		mm_got := LogUploaderMockUploadLogFileParams{payload}
+		mm_got := LogUploaderMockUploadLogFileParams{ctx, payload}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUploadLogFile.t.Errorf("LogUploaderMock.UploadLogFile got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -857,12 +409,12 @@ func (mmUploadLogFile *LogUploaderMock) UploadLogFile(payload map[string]io.Read
 		if mm_results == nil {
 			mmUploadLogFile.t.Fatal("No results are set for the LogUploaderMock.UploadLogFile")
 		}
-		return (*mm_results).err
+		return (*mm_results).up1, (*mm_results).err
 	}
 	if mmUploadLogFile.funcUploadLogFile != nil {
-		return mmUploadLogFile.funcUploadLogFile(payload)
+		return mmUploadLogFile.funcUploadLogFile(ctx, payload)
 	}
-	mmUploadLogFile.t.Fatalf("Unexpected call to LogUploaderMock.UploadLogFile. %v", payload)
+	mmUploadLogFile.t.Fatalf("Unexpected call to LogUploaderMock.UploadLogFile. %v %v", ctx, payload)
 	return
 }
 
@@ -934,12 +486,8 @@ func (m *LogUploaderMock) MinimockUploadLogFileInspect() {
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LogUploaderMock) MinimockFinish() {
 	if !m.minimockDone() {
-		m.MinimockFindMatchingPickupInspect()
-
 		m.MinimockMakeMultipartMapInspect()
 
-		m.MinimockResolvePlayersInspect()
-
 		m.MinimockUploadLogFileInspect()
 		m.t.FailNow()
 	}
@@ -964,8 +512,6 @@ func (m *LogUploaderMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LogUploaderMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockFindMatchingPickupDone() &&
 		m.MinimockMakeMultipartMapDone() &&
-		m.MinimockResolvePlayersDone() &&
 		m.MinimockUploadLogFileDone()
 }
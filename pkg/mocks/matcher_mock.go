@@ -8,6 +8,7 @@ import (
 	mm_stats "LogWatcher/pkg/stats"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -17,24 +18,60 @@ import (
 type MatcherMock struct {
 	t minimock.Tester
 
+	funcAddRound          func(msg string)
+	inspectFuncAddRound   func(msg string)
+	afterAddRoundCounter  uint64
+	beforeAddRoundCounter uint64
+	AddRoundMock          mMatcherMockAddRound
+
 	funcDomain          func() (s1 string)
 	inspectFuncDomain   func()
 	afterDomainCounter  uint64
 	beforeDomainCounter uint64
 	DomainMock          mMatcherMockDomain
 
+	funcEndedAt          func() (t1 time.Time)
+	inspectFuncEndedAt   func()
+	afterEndedAtCounter  uint64
+	beforeEndedAtCounter uint64
+	EndedAtMock          mMatcherMockEndedAt
+
 	funcFlush          func()
 	inspectFuncFlush   func()
 	afterFlushCounter  uint64
 	beforeFlushCounter uint64
 	FlushMock          mMatcherMockFlush
 
+	funcIncomplete          func() (b1 bool)
+	inspectFuncIncomplete   func()
+	afterIncompleteCounter  uint64
+	beforeIncompleteCounter uint64
+	IncompleteMock          mMatcherMockIncomplete
+
+	funcLaunchedAt          func() (t1 time.Time)
+	inspectFuncLaunchedAt   func()
+	afterLaunchedAtCounter  uint64
+	beforeLaunchedAtCounter uint64
+	LaunchedAtMock          mMatcherMockLaunchedAt
+
 	funcLengthSeconds          func() (i1 int)
 	inspectFuncLengthSeconds   func()
 	afterLengthSecondsCounter  uint64
 	beforeLengthSecondsCounter uint64
 	LengthSecondsMock          mMatcherMockLengthSeconds
 
+	funcLogID          func() (i1 int)
+	inspectFuncLogID   func()
+	afterLogIDCounter  uint64
+	beforeLogIDCounter uint64
+	LogIDMock          mMatcherMockLogID
+
+	funcLogURL          func() (s1 string)
+	inspectFuncLogURL   func()
+	afterLogURLCounter  uint64
+	beforeLogURLCounter uint64
+	LogURLMock          mMatcherMockLogURL
+
 	funcMap          func() (s1 string)
 	inspectFuncMap   func()
 	afterMapCounter  uint64
@@ -59,18 +96,48 @@ type MatcherMock struct {
 	beforePlayerStatsCounter uint64
 	PlayerStatsMock          mMatcherMockPlayerStats
 
+	funcRounds          func() (ra1 []mm_stats.Round)
+	inspectFuncRounds   func()
+	afterRoundsCounter  uint64
+	beforeRoundsCounter uint64
+	RoundsMock          mMatcherMockRounds
+
+	funcScore          func() (c1 mm_stats.CurrentScores)
+	inspectFuncScore   func()
+	afterScoreCounter  uint64
+	beforeScoreCounter uint64
+	ScoreMock          mMatcherMockScore
+
+	funcServerID          func() (i1 int)
+	inspectFuncServerID   func()
+	afterServerIDCounter  uint64
+	beforeServerIDCounter uint64
+	ServerIDMock          mMatcherMockServerID
+
 	funcSetBlueScore          func(score int)
 	inspectFuncSetBlueScore   func(score int)
 	afterSetBlueScoreCounter  uint64
 	beforeSetBlueScoreCounter uint64
 	SetBlueScoreMock          mMatcherMockSetBlueScore
 
+	funcSetIncomplete          func(incomplete bool)
+	inspectFuncSetIncomplete   func(incomplete bool)
+	afterSetIncompleteCounter  uint64
+	beforeSetIncompleteCounter uint64
+	SetIncompleteMock          mMatcherMockSetIncomplete
+
 	funcSetLength          func(msg string)
 	inspectFuncSetLength   func(msg string)
 	afterSetLengthCounter  uint64
 	beforeSetLengthCounter uint64
 	SetLengthMock          mMatcherMockSetLength
 
+	funcSetLog          func(id int, url string)
+	inspectFuncSetLog   func(id int, url string)
+	afterSetLogCounter  uint64
+	beforeSetLogCounter uint64
+	SetLogMock          mMatcherMockSetLog
+
 	funcSetMap          func(m string)
 	inspectFuncSetMap   func(m string)
 	afterSetMapCounter  uint64
@@ -101,6 +168,12 @@ type MatcherMock struct {
 	beforeSetRedScoreCounter uint64
 	SetRedScoreMock          mMatcherMockSetRedScore
 
+	funcSetRoundLength          func(msg string)
+	inspectFuncSetRoundLength   func(msg string)
+	afterSetRoundLengthCounter  uint64
+	beforeSetRoundLengthCounter uint64
+	SetRoundLengthMock          mMatcherMockSetRoundLength
+
 	funcSetStartTime          func(msg string)
 	inspectFuncSetStartTime   func(msg string)
 	afterSetStartTimeCounter  uint64
@@ -127,12 +200,25 @@ func NewMatcherMock(t minimock.Tester) *MatcherMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddRoundMock = mMatcherMockAddRound{mock: m}
+	m.AddRoundMock.callArgs = []*MatcherMockAddRoundParams{}
+
 	m.DomainMock = mMatcherMockDomain{mock: m}
 
+	m.EndedAtMock = mMatcherMockEndedAt{mock: m}
+
 	m.FlushMock = mMatcherMockFlush{mock: m}
 
+	m.IncompleteMock = mMatcherMockIncomplete{mock: m}
+
+	m.LaunchedAtMock = mMatcherMockLaunchedAt{mock: m}
+
 	m.LengthSecondsMock = mMatcherMockLengthSeconds{mock: m}
 
+	m.LogIDMock = mMatcherMockLogID{mock: m}
+
+	m.LogURLMock = mMatcherMockLogURL{mock: m}
+
 	m.MapMock = mMatcherMockMap{mock: m}
 
 	m.PickupIDMock = mMatcherMockPickupID{mock: m}
@@ -141,12 +227,24 @@ func NewMatcherMock(t minimock.Tester) *MatcherMock {
 
 	m.PlayerStatsMock = mMatcherMockPlayerStats{mock: m}
 
+	m.RoundsMock = mMatcherMockRounds{mock: m}
+
+	m.ScoreMock = mMatcherMockScore{mock: m}
+
+	m.ServerIDMock = mMatcherMockServerID{mock: m}
+
 	m.SetBlueScoreMock = mMatcherMockSetBlueScore{mock: m}
 	m.SetBlueScoreMock.callArgs = []*MatcherMockSetBlueScoreParams{}
 
+	m.SetIncompleteMock = mMatcherMockSetIncomplete{mock: m}
+	m.SetIncompleteMock.callArgs = []*MatcherMockSetIncompleteParams{}
+
 	m.SetLengthMock = mMatcherMockSetLength{mock: m}
 	m.SetLengthMock.callArgs = []*MatcherMockSetLengthParams{}
 
+	m.SetLogMock = mMatcherMockSetLog{mock: m}
+	m.SetLogMock.callArgs = []*MatcherMockSetLogParams{}
+
 	m.SetMapMock = mMatcherMockSetMap{mock: m}
 	m.SetMapMock.callArgs = []*MatcherMockSetMapParams{}
 
@@ -162,6 +260,9 @@ func NewMatcherMock(t minimock.Tester) *MatcherMock {
 	m.SetRedScoreMock = mMatcherMockSetRedScore{mock: m}
 	m.SetRedScoreMock.callArgs = []*MatcherMockSetRedScoreParams{}
 
+	m.SetRoundLengthMock = mMatcherMockSetRoundLength{mock: m}
+	m.SetRoundLengthMock.callArgs = []*MatcherMockSetRoundLengthParams{}
+
 	m.SetStartTimeMock = mMatcherMockSetStartTime{mock: m}
 	m.SetStartTimeMock.callArgs = []*MatcherMockSetStartTimeParams{}
 
@@ -173,6 +274,193 @@ func NewMatcherMock(t minimock.Tester) *MatcherMock {
 	return m
 }
 
+type mMatcherMockAddRound struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockAddRoundExpectation
+	expectations       []*MatcherMockAddRoundExpectation
+
+	callArgs []*MatcherMockAddRoundParams
+	mutex    sync.RWMutex
+}
+
+// MatcherMockAddRoundExpectation specifies expectation struct of the Matcher.AddRound
+type MatcherMockAddRoundExpectation struct {
+	mock   *MatcherMock
+	params *MatcherMockAddRoundParams
+
+	Counter uint64
+}
+
+// MatcherMockAddRoundParams contains parameters of the Matcher.AddRound
+type MatcherMockAddRoundParams struct {
+	msg string
+}
+
+// Expect sets up expected params for Matcher.AddRound
+func (mmAddRound *mMatcherMockAddRound) Expect(msg string) *mMatcherMockAddRound {
+	if mmAddRound.mock.funcAddRound != nil {
+		mmAddRound.mock.t.Fatalf("MatcherMock.AddRound mock is already set by Set")
+	}
+
+	if mmAddRound.defaultExpectation == nil {
+		mmAddRound.defaultExpectation = &MatcherMockAddRoundExpectation{}
+	}
+
+	mmAddRound.defaultExpectation.params = &MatcherMockAddRoundParams{msg}
+	for _, e := range mmAddRound.expectations {
+		if minimock.Equal(e.params, mmAddRound.defaultExpectation.params) {
+			mmAddRound.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRound.defaultExpectation.params)
+		}
+	}
+
+	return mmAddRound
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.AddRound
+func (mmAddRound *mMatcherMockAddRound) Inspect(f func(msg string)) *mMatcherMockAddRound {
+	if mmAddRound.mock.inspectFuncAddRound != nil {
+		mmAddRound.mock.t.Fatalf("Inspect function is already set for MatcherMock.AddRound")
+	}
+
+	mmAddRound.mock.inspectFuncAddRound = f
+
+	return mmAddRound
+}
+
+// Return sets up results that will be returned by Matcher.AddRound
+func (mmAddRound *mMatcherMockAddRound) Return() *MatcherMock {
+	if mmAddRound.mock.funcAddRound != nil {
+		mmAddRound.mock.t.Fatalf("MatcherMock.AddRound mock is already set by Set")
+	}
+
+	if mmAddRound.defaultExpectation == nil {
+		mmAddRound.defaultExpectation = &MatcherMockAddRoundExpectation{mock: mmAddRound.mock}
+	}
+
+	return mmAddRound.mock
+}
+
+//Set uses given function f to mock the Matcher.AddRound method
+func (mmAddRound *mMatcherMockAddRound) Set(f func(msg string)) *MatcherMock {
+	if mmAddRound.defaultExpectation != nil {
+		mmAddRound.mock.t.Fatalf("Default expectation is already set for the Matcher.AddRound method")
+	}
+
+	if len(mmAddRound.expectations) > 0 {
+		mmAddRound.mock.t.Fatalf("Some expectations are already set for the Matcher.AddRound method")
+	}
+
+	mmAddRound.mock.funcAddRound = f
+	return mmAddRound.mock
+}
+
+// AddRound implements stats.Matcher
+func (mmAddRound *MatcherMock) AddRound(msg string) {
+	mm_atomic.AddUint64(&mmAddRound.beforeAddRoundCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRound.afterAddRoundCounter, 1)
+
+	if mmAddRound.inspectFuncAddRound != nil {
+		mmAddRound.inspectFuncAddRound(msg)
+	}
+
+	mm_params := &MatcherMockAddRoundParams{msg}
+
+	// Record call args
+	mmAddRound.AddRoundMock.mutex.Lock()
+	mmAddRound.AddRoundMock.callArgs = append(mmAddRound.AddRoundMock.callArgs, mm_params)
+	mmAddRound.AddRoundMock.mutex.Unlock()
+
+	for _, e := range mmAddRound.AddRoundMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmAddRound.AddRoundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRound.AddRoundMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRound.AddRoundMock.defaultExpectation.params
+		mm_got := MatcherMockAddRoundParams{msg}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddRound.t.Errorf("MatcherMock.AddRound got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmAddRound.funcAddRound != nil {
+		mmAddRound.funcAddRound(msg)
+		return
+	}
+	mmAddRound.t.Fatalf("Unexpected call to MatcherMock.AddRound. %v", msg)
+
+}
+
+// AddRoundAfterCounter returns a count of finished MatcherMock.AddRound invocations
+func (mmAddRound *MatcherMock) AddRoundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRound.afterAddRoundCounter)
+}
+
+// AddRoundBeforeCounter returns a count of MatcherMock.AddRound invocations
+func (mmAddRound *MatcherMock) AddRoundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRound.beforeAddRoundCounter)
+}
+
+// Calls returns a list of arguments used in each call to MatcherMock.AddRound.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddRound *mMatcherMockAddRound) Calls() []*MatcherMockAddRoundParams {
+	mmAddRound.mutex.RLock()
+
+	argCopy := make([]*MatcherMockAddRoundParams, len(mmAddRound.callArgs))
+	copy(argCopy, mmAddRound.callArgs)
+
+	mmAddRound.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddRoundDone returns true if the count of the AddRound invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockAddRoundDone() bool {
+	for _, e := range m.AddRoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRoundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddRoundCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRound != nil && mm_atomic.LoadUint64(&m.afterAddRoundCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddRoundInspect logs each unmet expectation
+func (m *MatcherMock) MinimockAddRoundInspect() {
+	for _, e := range m.AddRoundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MatcherMock.AddRound with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddRoundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddRoundCounter) < 1 {
+		if m.AddRoundMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MatcherMock.AddRound")
+		} else {
+			m.t.Errorf("Expected call to MatcherMock.AddRound with params: %#v", *m.AddRoundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddRound != nil && mm_atomic.LoadUint64(&m.afterAddRoundCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.AddRound")
+	}
+}
+
 type mMatcherMockDomain struct {
 	mock               *MatcherMock
 	defaultExpectation *MatcherMockDomainExpectation
@@ -316,6 +604,149 @@ func (m *MatcherMock) MinimockDomainInspect() {
 	}
 }
 
+type mMatcherMockEndedAt struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockEndedAtExpectation
+	expectations       []*MatcherMockEndedAtExpectation
+}
+
+// MatcherMockEndedAtExpectation specifies expectation struct of the Matcher.EndedAt
+type MatcherMockEndedAtExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockEndedAtResults
+	Counter uint64
+}
+
+// MatcherMockEndedAtResults contains results of the Matcher.EndedAt
+type MatcherMockEndedAtResults struct {
+	t1 time.Time
+}
+
+// Expect sets up expected params for Matcher.EndedAt
+func (mmEndedAt *mMatcherMockEndedAt) Expect() *mMatcherMockEndedAt {
+	if mmEndedAt.mock.funcEndedAt != nil {
+		mmEndedAt.mock.t.Fatalf("MatcherMock.EndedAt mock is already set by Set")
+	}
+
+	if mmEndedAt.defaultExpectation == nil {
+		mmEndedAt.defaultExpectation = &MatcherMockEndedAtExpectation{}
+	}
+
+	return mmEndedAt
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.EndedAt
+func (mmEndedAt *mMatcherMockEndedAt) Inspect(f func()) *mMatcherMockEndedAt {
+	if mmEndedAt.mock.inspectFuncEndedAt != nil {
+		mmEndedAt.mock.t.Fatalf("Inspect function is already set for MatcherMock.EndedAt")
+	}
+
+	mmEndedAt.mock.inspectFuncEndedAt = f
+
+	return mmEndedAt
+}
+
+// Return sets up results that will be returned by Matcher.EndedAt
+func (mmEndedAt *mMatcherMockEndedAt) Return(t1 time.Time) *MatcherMock {
+	if mmEndedAt.mock.funcEndedAt != nil {
+		mmEndedAt.mock.t.Fatalf("MatcherMock.EndedAt mock is already set by Set")
+	}
+
+	if mmEndedAt.defaultExpectation == nil {
+		mmEndedAt.defaultExpectation = &MatcherMockEndedAtExpectation{mock: mmEndedAt.mock}
+	}
+	mmEndedAt.defaultExpectation.results = &MatcherMockEndedAtResults{t1}
+	return mmEndedAt.mock
+}
+
+//Set uses given function f to mock the Matcher.EndedAt method
+func (mmEndedAt *mMatcherMockEndedAt) Set(f func() (t1 time.Time)) *MatcherMock {
+	if mmEndedAt.defaultExpectation != nil {
+		mmEndedAt.mock.t.Fatalf("Default expectation is already set for the Matcher.EndedAt method")
+	}
+
+	if len(mmEndedAt.expectations) > 0 {
+		mmEndedAt.mock.t.Fatalf("Some expectations are already set for the Matcher.EndedAt method")
+	}
+
+	mmEndedAt.mock.funcEndedAt = f
+	return mmEndedAt.mock
+}
+
+// EndedAt implements stats.Matcher
+func (mmEndedAt *MatcherMock) EndedAt() (t1 time.Time) {
+	mm_atomic.AddUint64(&mmEndedAt.beforeEndedAtCounter, 1)
+	defer mm_atomic.AddUint64(&mmEndedAt.afterEndedAtCounter, 1)
+
+	if mmEndedAt.inspectFuncEndedAt != nil {
+		mmEndedAt.inspectFuncEndedAt()
+	}
+
+	if mmEndedAt.EndedAtMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEndedAt.EndedAtMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmEndedAt.EndedAtMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEndedAt.t.Fatal("No results are set for the MatcherMock.EndedAt")
+		}
+		return (*mm_results).t1
+	}
+	if mmEndedAt.funcEndedAt != nil {
+		return mmEndedAt.funcEndedAt()
+	}
+	mmEndedAt.t.Fatalf("Unexpected call to MatcherMock.EndedAt.")
+	return
+}
+
+// EndedAtAfterCounter returns a count of finished MatcherMock.EndedAt invocations
+func (mmEndedAt *MatcherMock) EndedAtAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndedAt.afterEndedAtCounter)
+}
+
+// EndedAtBeforeCounter returns a count of MatcherMock.EndedAt invocations
+func (mmEndedAt *MatcherMock) EndedAtBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEndedAt.beforeEndedAtCounter)
+}
+
+// MinimockEndedAtDone returns true if the count of the EndedAt invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockEndedAtDone() bool {
+	for _, e := range m.EndedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EndedAtMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEndedAtCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEndedAt != nil && mm_atomic.LoadUint64(&m.afterEndedAtCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEndedAtInspect logs each unmet expectation
+func (m *MatcherMock) MinimockEndedAtInspect() {
+	for _, e := range m.EndedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.EndedAt")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EndedAtMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEndedAtCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.EndedAt")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEndedAt != nil && mm_atomic.LoadUint64(&m.afterEndedAtCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.EndedAt")
+	}
+}
+
 type mMatcherMockFlush struct {
 	mock               *MatcherMock
 	defaultExpectation *MatcherMockFlushExpectation
@@ -451,718 +882,1719 @@ func (m *MatcherMock) MinimockFlushInspect() {
 	}
 }
 
-type mMatcherMockLengthSeconds struct {
+type mMatcherMockIncomplete struct {
 	mock               *MatcherMock
-	defaultExpectation *MatcherMockLengthSecondsExpectation
-	expectations       []*MatcherMockLengthSecondsExpectation
+	defaultExpectation *MatcherMockIncompleteExpectation
+	expectations       []*MatcherMockIncompleteExpectation
 }
 
-// MatcherMockLengthSecondsExpectation specifies expectation struct of the Matcher.LengthSeconds
-type MatcherMockLengthSecondsExpectation struct {
+// MatcherMockIncompleteExpectation specifies expectation struct of the Matcher.Incomplete
+type MatcherMockIncompleteExpectation struct {
 	mock *MatcherMock
 
-	results *MatcherMockLengthSecondsResults
+	results *MatcherMockIncompleteResults
 	Counter uint64
 }
 
-// MatcherMockLengthSecondsResults contains results of the Matcher.LengthSeconds
-type MatcherMockLengthSecondsResults struct {
-	i1 int
+// MatcherMockIncompleteResults contains results of the Matcher.Incomplete
+type MatcherMockIncompleteResults struct {
+	b1 bool
 }
 
-// Expect sets up expected params for Matcher.LengthSeconds
-func (mmLengthSeconds *mMatcherMockLengthSeconds) Expect() *mMatcherMockLengthSeconds {
-	if mmLengthSeconds.mock.funcLengthSeconds != nil {
-		mmLengthSeconds.mock.t.Fatalf("MatcherMock.LengthSeconds mock is already set by Set")
+// Expect sets up expected params for Matcher.Incomplete
+func (mmIncomplete *mMatcherMockIncomplete) Expect() *mMatcherMockIncomplete {
+	if mmIncomplete.mock.funcIncomplete != nil {
+		mmIncomplete.mock.t.Fatalf("MatcherMock.Incomplete mock is already set by Set")
 	}
 
-	if mmLengthSeconds.defaultExpectation == nil {
-		mmLengthSeconds.defaultExpectation = &MatcherMockLengthSecondsExpectation{}
+	if mmIncomplete.defaultExpectation == nil {
+		mmIncomplete.defaultExpectation = &MatcherMockIncompleteExpectation{}
 	}
 
-	return mmLengthSeconds
+	return mmIncomplete
 }
 
-// Inspect accepts an inspector function that has same arguments as the Matcher.LengthSeconds
-func (mmLengthSeconds *mMatcherMockLengthSeconds) Inspect(f func()) *mMatcherMockLengthSeconds {
-	if mmLengthSeconds.mock.inspectFuncLengthSeconds != nil {
-		mmLengthSeconds.mock.t.Fatalf("Inspect function is already set for MatcherMock.LengthSeconds")
+// Inspect accepts an inspector function that has same arguments as the Matcher.Incomplete
+func (mmIncomplete *mMatcherMockIncomplete) Inspect(f func()) *mMatcherMockIncomplete {
+	if mmIncomplete.mock.inspectFuncIncomplete != nil {
+		mmIncomplete.mock.t.Fatalf("Inspect function is already set for MatcherMock.Incomplete")
 	}
 
-	mmLengthSeconds.mock.inspectFuncLengthSeconds = f
+	mmIncomplete.mock.inspectFuncIncomplete = f
 
-	return mmLengthSeconds
+	return mmIncomplete
 }
 
-// Return sets up results that will be returned by Matcher.LengthSeconds
-func (mmLengthSeconds *mMatcherMockLengthSeconds) Return(i1 int) *MatcherMock {
-	if mmLengthSeconds.mock.funcLengthSeconds != nil {
-		mmLengthSeconds.mock.t.Fatalf("MatcherMock.LengthSeconds mock is already set by Set")
+// Return sets up results that will be returned by Matcher.Incomplete
+func (mmIncomplete *mMatcherMockIncomplete) Return(b1 bool) *MatcherMock {
+	if mmIncomplete.mock.funcIncomplete != nil {
+		mmIncomplete.mock.t.Fatalf("MatcherMock.Incomplete mock is already set by Set")
 	}
 
-	if mmLengthSeconds.defaultExpectation == nil {
-		mmLengthSeconds.defaultExpectation = &MatcherMockLengthSecondsExpectation{mock: mmLengthSeconds.mock}
+	if mmIncomplete.defaultExpectation == nil {
+		mmIncomplete.defaultExpectation = &MatcherMockIncompleteExpectation{mock: mmIncomplete.mock}
 	}
-	mmLengthSeconds.defaultExpectation.results = &MatcherMockLengthSecondsResults{i1}
-	return mmLengthSeconds.mock
+	mmIncomplete.defaultExpectation.results = &MatcherMockIncompleteResults{b1}
+	return mmIncomplete.mock
 }
 
-//Set uses given function f to mock the Matcher.LengthSeconds method
-func (mmLengthSeconds *mMatcherMockLengthSeconds) Set(f func() (i1 int)) *MatcherMock {
-	if mmLengthSeconds.defaultExpectation != nil {
-		mmLengthSeconds.mock.t.Fatalf("Default expectation is already set for the Matcher.LengthSeconds method")
+//Set uses given function f to mock the Matcher.Incomplete method
+func (mmIncomplete *mMatcherMockIncomplete) Set(f func() (b1 bool)) *MatcherMock {
+	if mmIncomplete.defaultExpectation != nil {
+		mmIncomplete.mock.t.Fatalf("Default expectation is already set for the Matcher.Incomplete method")
 	}
 
-	if len(mmLengthSeconds.expectations) > 0 {
-		mmLengthSeconds.mock.t.Fatalf("Some expectations are already set for the Matcher.LengthSeconds method")
+	if len(mmIncomplete.expectations) > 0 {
+		mmIncomplete.mock.t.Fatalf("Some expectations are already set for the Matcher.Incomplete method")
 	}
 
-	mmLengthSeconds.mock.funcLengthSeconds = f
-	return mmLengthSeconds.mock
+	mmIncomplete.mock.funcIncomplete = f
+	return mmIncomplete.mock
 }
 
-// LengthSeconds implements stats.Matcher
-func (mmLengthSeconds *MatcherMock) LengthSeconds() (i1 int) {
-	mm_atomic.AddUint64(&mmLengthSeconds.beforeLengthSecondsCounter, 1)
-	defer mm_atomic.AddUint64(&mmLengthSeconds.afterLengthSecondsCounter, 1)
+// Incomplete implements stats.Matcher
+func (mmIncomplete *MatcherMock) Incomplete() (b1 bool) {
+	mm_atomic.AddUint64(&mmIncomplete.beforeIncompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncomplete.afterIncompleteCounter, 1)
 
-	if mmLengthSeconds.inspectFuncLengthSeconds != nil {
-		mmLengthSeconds.inspectFuncLengthSeconds()
+	if mmIncomplete.inspectFuncIncomplete != nil {
+		mmIncomplete.inspectFuncIncomplete()
 	}
 
-	if mmLengthSeconds.LengthSecondsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLengthSeconds.LengthSecondsMock.defaultExpectation.Counter, 1)
+	if mmIncomplete.IncompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncomplete.IncompleteMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmLengthSeconds.LengthSecondsMock.defaultExpectation.results
+		mm_results := mmIncomplete.IncompleteMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLengthSeconds.t.Fatal("No results are set for the MatcherMock.LengthSeconds")
+			mmIncomplete.t.Fatal("No results are set for the MatcherMock.Incomplete")
 		}
-		return (*mm_results).i1
+		return (*mm_results).b1
 	}
-	if mmLengthSeconds.funcLengthSeconds != nil {
-		return mmLengthSeconds.funcLengthSeconds()
+	if mmIncomplete.funcIncomplete != nil {
+		return mmIncomplete.funcIncomplete()
 	}
-	mmLengthSeconds.t.Fatalf("Unexpected call to MatcherMock.LengthSeconds.")
+	mmIncomplete.t.Fatalf("Unexpected call to MatcherMock.Incomplete.")
 	return
 }
 
-// LengthSecondsAfterCounter returns a count of finished MatcherMock.LengthSeconds invocations
-func (mmLengthSeconds *MatcherMock) LengthSecondsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLengthSeconds.afterLengthSecondsCounter)
+// IncompleteAfterCounter returns a count of finished MatcherMock.Incomplete invocations
+func (mmIncomplete *MatcherMock) IncompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncomplete.afterIncompleteCounter)
 }
 
-// LengthSecondsBeforeCounter returns a count of MatcherMock.LengthSeconds invocations
-func (mmLengthSeconds *MatcherMock) LengthSecondsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLengthSeconds.beforeLengthSecondsCounter)
+// IncompleteBeforeCounter returns a count of MatcherMock.Incomplete invocations
+func (mmIncomplete *MatcherMock) IncompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncomplete.beforeIncompleteCounter)
 }
 
-// MinimockLengthSecondsDone returns true if the count of the LengthSeconds invocations corresponds
+// MinimockIncompleteDone returns true if the count of the Incomplete invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockLengthSecondsDone() bool {
-	for _, e := range m.LengthSecondsMock.expectations {
+func (m *MatcherMock) MinimockIncompleteDone() bool {
+	for _, e := range m.IncompleteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LengthSecondsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
+	if m.IncompleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIncompleteCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLengthSeconds != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
+	if m.funcIncomplete != nil && mm_atomic.LoadUint64(&m.afterIncompleteCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockLengthSecondsInspect logs each unmet expectation
-func (m *MatcherMock) MinimockLengthSecondsInspect() {
-	for _, e := range m.LengthSecondsMock.expectations {
+// MinimockIncompleteInspect logs each unmet expectation
+func (m *MatcherMock) MinimockIncompleteInspect() {
+	for _, e := range m.IncompleteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to MatcherMock.LengthSeconds")
+			m.t.Error("Expected call to MatcherMock.Incomplete")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LengthSecondsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.LengthSeconds")
+	if m.IncompleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIncompleteCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Incomplete")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLengthSeconds != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.LengthSeconds")
+	if m.funcIncomplete != nil && mm_atomic.LoadUint64(&m.afterIncompleteCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Incomplete")
 	}
 }
 
-type mMatcherMockMap struct {
+type mMatcherMockLaunchedAt struct {
 	mock               *MatcherMock
-	defaultExpectation *MatcherMockMapExpectation
-	expectations       []*MatcherMockMapExpectation
+	defaultExpectation *MatcherMockLaunchedAtExpectation
+	expectations       []*MatcherMockLaunchedAtExpectation
 }
 
-// MatcherMockMapExpectation specifies expectation struct of the Matcher.Map
-type MatcherMockMapExpectation struct {
+// MatcherMockLaunchedAtExpectation specifies expectation struct of the Matcher.LaunchedAt
+type MatcherMockLaunchedAtExpectation struct {
 	mock *MatcherMock
 
-	results *MatcherMockMapResults
+	results *MatcherMockLaunchedAtResults
 	Counter uint64
 }
 
-// MatcherMockMapResults contains results of the Matcher.Map
-type MatcherMockMapResults struct {
-	s1 string
+// MatcherMockLaunchedAtResults contains results of the Matcher.LaunchedAt
+type MatcherMockLaunchedAtResults struct {
+	t1 time.Time
 }
 
-// Expect sets up expected params for Matcher.Map
-func (mmMap *mMatcherMockMap) Expect() *mMatcherMockMap {
-	if mmMap.mock.funcMap != nil {
-		mmMap.mock.t.Fatalf("MatcherMock.Map mock is already set by Set")
+// Expect sets up expected params for Matcher.LaunchedAt
+func (mmLaunchedAt *mMatcherMockLaunchedAt) Expect() *mMatcherMockLaunchedAt {
+	if mmLaunchedAt.mock.funcLaunchedAt != nil {
+		mmLaunchedAt.mock.t.Fatalf("MatcherMock.LaunchedAt mock is already set by Set")
 	}
 
-	if mmMap.defaultExpectation == nil {
-		mmMap.defaultExpectation = &MatcherMockMapExpectation{}
+	if mmLaunchedAt.defaultExpectation == nil {
+		mmLaunchedAt.defaultExpectation = &MatcherMockLaunchedAtExpectation{}
 	}
 
-	return mmMap
+	return mmLaunchedAt
 }
 
-// Inspect accepts an inspector function that has same arguments as the Matcher.Map
-func (mmMap *mMatcherMockMap) Inspect(f func()) *mMatcherMockMap {
-	if mmMap.mock.inspectFuncMap != nil {
-		mmMap.mock.t.Fatalf("Inspect function is already set for MatcherMock.Map")
+// Inspect accepts an inspector function that has same arguments as the Matcher.LaunchedAt
+func (mmLaunchedAt *mMatcherMockLaunchedAt) Inspect(f func()) *mMatcherMockLaunchedAt {
+	if mmLaunchedAt.mock.inspectFuncLaunchedAt != nil {
+		mmLaunchedAt.mock.t.Fatalf("Inspect function is already set for MatcherMock.LaunchedAt")
 	}
 
-	mmMap.mock.inspectFuncMap = f
+	mmLaunchedAt.mock.inspectFuncLaunchedAt = f
 
-	return mmMap
+	return mmLaunchedAt
 }
 
-// Return sets up results that will be returned by Matcher.Map
-func (mmMap *mMatcherMockMap) Return(s1 string) *MatcherMock {
-	if mmMap.mock.funcMap != nil {
-		mmMap.mock.t.Fatalf("MatcherMock.Map mock is already set by Set")
+// Return sets up results that will be returned by Matcher.LaunchedAt
+func (mmLaunchedAt *mMatcherMockLaunchedAt) Return(t1 time.Time) *MatcherMock {
+	if mmLaunchedAt.mock.funcLaunchedAt != nil {
+		mmLaunchedAt.mock.t.Fatalf("MatcherMock.LaunchedAt mock is already set by Set")
 	}
 
-	if mmMap.defaultExpectation == nil {
-		mmMap.defaultExpectation = &MatcherMockMapExpectation{mock: mmMap.mock}
+	if mmLaunchedAt.defaultExpectation == nil {
+		mmLaunchedAt.defaultExpectation = &MatcherMockLaunchedAtExpectation{mock: mmLaunchedAt.mock}
 	}
-	mmMap.defaultExpectation.results = &MatcherMockMapResults{s1}
-	return mmMap.mock
+	mmLaunchedAt.defaultExpectation.results = &MatcherMockLaunchedAtResults{t1}
+	return mmLaunchedAt.mock
 }
 
-//Set uses given function f to mock the Matcher.Map method
-func (mmMap *mMatcherMockMap) Set(f func() (s1 string)) *MatcherMock {
-	if mmMap.defaultExpectation != nil {
-		mmMap.mock.t.Fatalf("Default expectation is already set for the Matcher.Map method")
+//Set uses given function f to mock the Matcher.LaunchedAt method
+func (mmLaunchedAt *mMatcherMockLaunchedAt) Set(f func() (t1 time.Time)) *MatcherMock {
+	if mmLaunchedAt.defaultExpectation != nil {
+		mmLaunchedAt.mock.t.Fatalf("Default expectation is already set for the Matcher.LaunchedAt method")
 	}
 
-	if len(mmMap.expectations) > 0 {
-		mmMap.mock.t.Fatalf("Some expectations are already set for the Matcher.Map method")
+	if len(mmLaunchedAt.expectations) > 0 {
+		mmLaunchedAt.mock.t.Fatalf("Some expectations are already set for the Matcher.LaunchedAt method")
 	}
 
-	mmMap.mock.funcMap = f
-	return mmMap.mock
+	mmLaunchedAt.mock.funcLaunchedAt = f
+	return mmLaunchedAt.mock
 }
 
-// Map implements stats.Matcher
-func (mmMap *MatcherMock) Map() (s1 string) {
-	mm_atomic.AddUint64(&mmMap.beforeMapCounter, 1)
-	defer mm_atomic.AddUint64(&mmMap.afterMapCounter, 1)
+// LaunchedAt implements stats.Matcher
+func (mmLaunchedAt *MatcherMock) LaunchedAt() (t1 time.Time) {
+	mm_atomic.AddUint64(&mmLaunchedAt.beforeLaunchedAtCounter, 1)
+	defer mm_atomic.AddUint64(&mmLaunchedAt.afterLaunchedAtCounter, 1)
 
-	if mmMap.inspectFuncMap != nil {
-		mmMap.inspectFuncMap()
+	if mmLaunchedAt.inspectFuncLaunchedAt != nil {
+		mmLaunchedAt.inspectFuncLaunchedAt()
 	}
 
-	if mmMap.MapMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMap.MapMock.defaultExpectation.Counter, 1)
+	if mmLaunchedAt.LaunchedAtMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLaunchedAt.LaunchedAtMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmMap.MapMock.defaultExpectation.results
+		mm_results := mmLaunchedAt.LaunchedAtMock.defaultExpectation.results
 		if mm_results == nil {
-			mmMap.t.Fatal("No results are set for the MatcherMock.Map")
+			mmLaunchedAt.t.Fatal("No results are set for the MatcherMock.LaunchedAt")
 		}
-		return (*mm_results).s1
+		return (*mm_results).t1
 	}
-	if mmMap.funcMap != nil {
-		return mmMap.funcMap()
+	if mmLaunchedAt.funcLaunchedAt != nil {
+		return mmLaunchedAt.funcLaunchedAt()
 	}
-	mmMap.t.Fatalf("Unexpected call to MatcherMock.Map.")
+	mmLaunchedAt.t.Fatalf("Unexpected call to MatcherMock.LaunchedAt.")
 	return
 }
 
-// MapAfterCounter returns a count of finished MatcherMock.Map invocations
-func (mmMap *MatcherMock) MapAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMap.afterMapCounter)
+// LaunchedAtAfterCounter returns a count of finished MatcherMock.LaunchedAt invocations
+func (mmLaunchedAt *MatcherMock) LaunchedAtAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLaunchedAt.afterLaunchedAtCounter)
 }
 
-// MapBeforeCounter returns a count of MatcherMock.Map invocations
-func (mmMap *MatcherMock) MapBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMap.beforeMapCounter)
+// LaunchedAtBeforeCounter returns a count of MatcherMock.LaunchedAt invocations
+func (mmLaunchedAt *MatcherMock) LaunchedAtBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLaunchedAt.beforeLaunchedAtCounter)
 }
 
-// MinimockMapDone returns true if the count of the Map invocations corresponds
+// MinimockLaunchedAtDone returns true if the count of the LaunchedAt invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockMapDone() bool {
-	for _, e := range m.MapMock.expectations {
+func (m *MatcherMock) MinimockLaunchedAtDone() bool {
+	for _, e := range m.LaunchedAtMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+	if m.LaunchedAtMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLaunchedAtCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMap != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+	if m.funcLaunchedAt != nil && mm_atomic.LoadUint64(&m.afterLaunchedAtCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockMapInspect logs each unmet expectation
-func (m *MatcherMock) MinimockMapInspect() {
-	for _, e := range m.MapMock.expectations {
+// MinimockLaunchedAtInspect logs each unmet expectation
+func (m *MatcherMock) MinimockLaunchedAtInspect() {
+	for _, e := range m.LaunchedAtMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to MatcherMock.Map")
+			m.t.Error("Expected call to MatcherMock.LaunchedAt")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.Map")
+	if m.LaunchedAtMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLaunchedAtCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LaunchedAt")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMap != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.Map")
+	if m.funcLaunchedAt != nil && mm_atomic.LoadUint64(&m.afterLaunchedAtCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LaunchedAt")
 	}
 }
 
-type mMatcherMockPickupID struct {
+type mMatcherMockLengthSeconds struct {
 	mock               *MatcherMock
-	defaultExpectation *MatcherMockPickupIDExpectation
-	expectations       []*MatcherMockPickupIDExpectation
+	defaultExpectation *MatcherMockLengthSecondsExpectation
+	expectations       []*MatcherMockLengthSecondsExpectation
 }
 
-// MatcherMockPickupIDExpectation specifies expectation struct of the Matcher.PickupID
-type MatcherMockPickupIDExpectation struct {
+// MatcherMockLengthSecondsExpectation specifies expectation struct of the Matcher.LengthSeconds
+type MatcherMockLengthSecondsExpectation struct {
 	mock *MatcherMock
 
-	results *MatcherMockPickupIDResults
+	results *MatcherMockLengthSecondsResults
 	Counter uint64
 }
 
-// MatcherMockPickupIDResults contains results of the Matcher.PickupID
-type MatcherMockPickupIDResults struct {
+// MatcherMockLengthSecondsResults contains results of the Matcher.LengthSeconds
+type MatcherMockLengthSecondsResults struct {
 	i1 int
 }
 
-// Expect sets up expected params for Matcher.PickupID
-func (mmPickupID *mMatcherMockPickupID) Expect() *mMatcherMockPickupID {
-	if mmPickupID.mock.funcPickupID != nil {
-		mmPickupID.mock.t.Fatalf("MatcherMock.PickupID mock is already set by Set")
+// Expect sets up expected params for Matcher.LengthSeconds
+func (mmLengthSeconds *mMatcherMockLengthSeconds) Expect() *mMatcherMockLengthSeconds {
+	if mmLengthSeconds.mock.funcLengthSeconds != nil {
+		mmLengthSeconds.mock.t.Fatalf("MatcherMock.LengthSeconds mock is already set by Set")
 	}
 
-	if mmPickupID.defaultExpectation == nil {
-		mmPickupID.defaultExpectation = &MatcherMockPickupIDExpectation{}
+	if mmLengthSeconds.defaultExpectation == nil {
+		mmLengthSeconds.defaultExpectation = &MatcherMockLengthSecondsExpectation{}
 	}
 
-	return mmPickupID
+	return mmLengthSeconds
 }
 
-// Inspect accepts an inspector function that has same arguments as the Matcher.PickupID
-func (mmPickupID *mMatcherMockPickupID) Inspect(f func()) *mMatcherMockPickupID {
-	if mmPickupID.mock.inspectFuncPickupID != nil {
-		mmPickupID.mock.t.Fatalf("Inspect function is already set for MatcherMock.PickupID")
+// Inspect accepts an inspector function that has same arguments as the Matcher.LengthSeconds
+func (mmLengthSeconds *mMatcherMockLengthSeconds) Inspect(f func()) *mMatcherMockLengthSeconds {
+	if mmLengthSeconds.mock.inspectFuncLengthSeconds != nil {
+		mmLengthSeconds.mock.t.Fatalf("Inspect function is already set for MatcherMock.LengthSeconds")
 	}
 
-	mmPickupID.mock.inspectFuncPickupID = f
+	mmLengthSeconds.mock.inspectFuncLengthSeconds = f
 
-	return mmPickupID
+	return mmLengthSeconds
 }
 
-// Return sets up results that will be returned by Matcher.PickupID
-func (mmPickupID *mMatcherMockPickupID) Return(i1 int) *MatcherMock {
-	if mmPickupID.mock.funcPickupID != nil {
-		mmPickupID.mock.t.Fatalf("MatcherMock.PickupID mock is already set by Set")
+// Return sets up results that will be returned by Matcher.LengthSeconds
+func (mmLengthSeconds *mMatcherMockLengthSeconds) Return(i1 int) *MatcherMock {
+	if mmLengthSeconds.mock.funcLengthSeconds != nil {
+		mmLengthSeconds.mock.t.Fatalf("MatcherMock.LengthSeconds mock is already set by Set")
 	}
 
-	if mmPickupID.defaultExpectation == nil {
-		mmPickupID.defaultExpectation = &MatcherMockPickupIDExpectation{mock: mmPickupID.mock}
+	if mmLengthSeconds.defaultExpectation == nil {
+		mmLengthSeconds.defaultExpectation = &MatcherMockLengthSecondsExpectation{mock: mmLengthSeconds.mock}
 	}
-	mmPickupID.defaultExpectation.results = &MatcherMockPickupIDResults{i1}
-	return mmPickupID.mock
+	mmLengthSeconds.defaultExpectation.results = &MatcherMockLengthSecondsResults{i1}
+	return mmLengthSeconds.mock
 }
 
-//Set uses given function f to mock the Matcher.PickupID method
-func (mmPickupID *mMatcherMockPickupID) Set(f func() (i1 int)) *MatcherMock {
-	if mmPickupID.defaultExpectation != nil {
-		mmPickupID.mock.t.Fatalf("Default expectation is already set for the Matcher.PickupID method")
+//Set uses given function f to mock the Matcher.LengthSeconds method
+func (mmLengthSeconds *mMatcherMockLengthSeconds) Set(f func() (i1 int)) *MatcherMock {
+	if mmLengthSeconds.defaultExpectation != nil {
+		mmLengthSeconds.mock.t.Fatalf("Default expectation is already set for the Matcher.LengthSeconds method")
 	}
 
-	if len(mmPickupID.expectations) > 0 {
-		mmPickupID.mock.t.Fatalf("Some expectations are already set for the Matcher.PickupID method")
+	if len(mmLengthSeconds.expectations) > 0 {
+		mmLengthSeconds.mock.t.Fatalf("Some expectations are already set for the Matcher.LengthSeconds method")
 	}
 
-	mmPickupID.mock.funcPickupID = f
-	return mmPickupID.mock
+	mmLengthSeconds.mock.funcLengthSeconds = f
+	return mmLengthSeconds.mock
 }
 
-// PickupID implements stats.Matcher
-func (mmPickupID *MatcherMock) PickupID() (i1 int) {
-	mm_atomic.AddUint64(&mmPickupID.beforePickupIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmPickupID.afterPickupIDCounter, 1)
+// LengthSeconds implements stats.Matcher
+func (mmLengthSeconds *MatcherMock) LengthSeconds() (i1 int) {
+	mm_atomic.AddUint64(&mmLengthSeconds.beforeLengthSecondsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLengthSeconds.afterLengthSecondsCounter, 1)
 
-	if mmPickupID.inspectFuncPickupID != nil {
-		mmPickupID.inspectFuncPickupID()
+	if mmLengthSeconds.inspectFuncLengthSeconds != nil {
+		mmLengthSeconds.inspectFuncLengthSeconds()
 	}
 
-	if mmPickupID.PickupIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPickupID.PickupIDMock.defaultExpectation.Counter, 1)
+	if mmLengthSeconds.LengthSecondsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLengthSeconds.LengthSecondsMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmPickupID.PickupIDMock.defaultExpectation.results
+		mm_results := mmLengthSeconds.LengthSecondsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmPickupID.t.Fatal("No results are set for the MatcherMock.PickupID")
+			mmLengthSeconds.t.Fatal("No results are set for the MatcherMock.LengthSeconds")
 		}
 		return (*mm_results).i1
 	}
-	if mmPickupID.funcPickupID != nil {
-		return mmPickupID.funcPickupID()
+	if mmLengthSeconds.funcLengthSeconds != nil {
+		return mmLengthSeconds.funcLengthSeconds()
 	}
-	mmPickupID.t.Fatalf("Unexpected call to MatcherMock.PickupID.")
+	mmLengthSeconds.t.Fatalf("Unexpected call to MatcherMock.LengthSeconds.")
 	return
 }
 
-// PickupIDAfterCounter returns a count of finished MatcherMock.PickupID invocations
-func (mmPickupID *MatcherMock) PickupIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPickupID.afterPickupIDCounter)
+// LengthSecondsAfterCounter returns a count of finished MatcherMock.LengthSeconds invocations
+func (mmLengthSeconds *MatcherMock) LengthSecondsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLengthSeconds.afterLengthSecondsCounter)
 }
 
-// PickupIDBeforeCounter returns a count of MatcherMock.PickupID invocations
-func (mmPickupID *MatcherMock) PickupIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPickupID.beforePickupIDCounter)
+// LengthSecondsBeforeCounter returns a count of MatcherMock.LengthSeconds invocations
+func (mmLengthSeconds *MatcherMock) LengthSecondsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLengthSeconds.beforeLengthSecondsCounter)
 }
 
-// MinimockPickupIDDone returns true if the count of the PickupID invocations corresponds
+// MinimockLengthSecondsDone returns true if the count of the LengthSeconds invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockPickupIDDone() bool {
-	for _, e := range m.PickupIDMock.expectations {
+func (m *MatcherMock) MinimockLengthSecondsDone() bool {
+	for _, e := range m.LengthSecondsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PickupIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+	if m.LengthSecondsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPickupID != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+	if m.funcLengthSeconds != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockPickupIDInspect logs each unmet expectation
-func (m *MatcherMock) MinimockPickupIDInspect() {
-	for _, e := range m.PickupIDMock.expectations {
+// MinimockLengthSecondsInspect logs each unmet expectation
+func (m *MatcherMock) MinimockLengthSecondsInspect() {
+	for _, e := range m.LengthSecondsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to MatcherMock.PickupID")
+			m.t.Error("Expected call to MatcherMock.LengthSeconds")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PickupIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PickupID")
+	if m.LengthSecondsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LengthSeconds")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPickupID != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PickupID")
+	if m.funcLengthSeconds != nil && mm_atomic.LoadUint64(&m.afterLengthSecondsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LengthSeconds")
 	}
 }
 
-type mMatcherMockPickupPlayers struct {
+type mMatcherMockLogID struct {
 	mock               *MatcherMock
-	defaultExpectation *MatcherMockPickupPlayersExpectation
-	expectations       []*MatcherMockPickupPlayersExpectation
+	defaultExpectation *MatcherMockLogIDExpectation
+	expectations       []*MatcherMockLogIDExpectation
 }
 
-// MatcherMockPickupPlayersExpectation specifies expectation struct of the Matcher.PickupPlayers
-type MatcherMockPickupPlayersExpectation struct {
+// MatcherMockLogIDExpectation specifies expectation struct of the Matcher.LogID
+type MatcherMockLogIDExpectation struct {
 	mock *MatcherMock
 
-	results *MatcherMockPickupPlayersResults
+	results *MatcherMockLogIDResults
 	Counter uint64
 }
 
-// MatcherMockPickupPlayersResults contains results of the Matcher.PickupPlayers
-type MatcherMockPickupPlayersResults struct {
-	ppa1 []*mm_stats.PickupPlayer
+// MatcherMockLogIDResults contains results of the Matcher.LogID
+type MatcherMockLogIDResults struct {
+	i1 int
 }
 
-// Expect sets up expected params for Matcher.PickupPlayers
-func (mmPickupPlayers *mMatcherMockPickupPlayers) Expect() *mMatcherMockPickupPlayers {
-	if mmPickupPlayers.mock.funcPickupPlayers != nil {
-		mmPickupPlayers.mock.t.Fatalf("MatcherMock.PickupPlayers mock is already set by Set")
+// Expect sets up expected params for Matcher.LogID
+func (mmLogID *mMatcherMockLogID) Expect() *mMatcherMockLogID {
+	if mmLogID.mock.funcLogID != nil {
+		mmLogID.mock.t.Fatalf("MatcherMock.LogID mock is already set by Set")
 	}
 
-	if mmPickupPlayers.defaultExpectation == nil {
-		mmPickupPlayers.defaultExpectation = &MatcherMockPickupPlayersExpectation{}
+	if mmLogID.defaultExpectation == nil {
+		mmLogID.defaultExpectation = &MatcherMockLogIDExpectation{}
 	}
 
-	return mmPickupPlayers
+	return mmLogID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Matcher.PickupPlayers
-func (mmPickupPlayers *mMatcherMockPickupPlayers) Inspect(f func()) *mMatcherMockPickupPlayers {
-	if mmPickupPlayers.mock.inspectFuncPickupPlayers != nil {
-		mmPickupPlayers.mock.t.Fatalf("Inspect function is already set for MatcherMock.PickupPlayers")
+// Inspect accepts an inspector function that has same arguments as the Matcher.LogID
+func (mmLogID *mMatcherMockLogID) Inspect(f func()) *mMatcherMockLogID {
+	if mmLogID.mock.inspectFuncLogID != nil {
+		mmLogID.mock.t.Fatalf("Inspect function is already set for MatcherMock.LogID")
 	}
 
-	mmPickupPlayers.mock.inspectFuncPickupPlayers = f
+	mmLogID.mock.inspectFuncLogID = f
 
-	return mmPickupPlayers
+	return mmLogID
 }
 
-// Return sets up results that will be returned by Matcher.PickupPlayers
-func (mmPickupPlayers *mMatcherMockPickupPlayers) Return(ppa1 []*mm_stats.PickupPlayer) *MatcherMock {
-	if mmPickupPlayers.mock.funcPickupPlayers != nil {
-		mmPickupPlayers.mock.t.Fatalf("MatcherMock.PickupPlayers mock is already set by Set")
+// Return sets up results that will be returned by Matcher.LogID
+func (mmLogID *mMatcherMockLogID) Return(i1 int) *MatcherMock {
+	if mmLogID.mock.funcLogID != nil {
+		mmLogID.mock.t.Fatalf("MatcherMock.LogID mock is already set by Set")
+	}
+
+	if mmLogID.defaultExpectation == nil {
+		mmLogID.defaultExpectation = &MatcherMockLogIDExpectation{mock: mmLogID.mock}
+	}
+	mmLogID.defaultExpectation.results = &MatcherMockLogIDResults{i1}
+	return mmLogID.mock
+}
+
+//Set uses given function f to mock the Matcher.LogID method
+func (mmLogID *mMatcherMockLogID) Set(f func() (i1 int)) *MatcherMock {
+	if mmLogID.defaultExpectation != nil {
+		mmLogID.mock.t.Fatalf("Default expectation is already set for the Matcher.LogID method")
+	}
+
+	if len(mmLogID.expectations) > 0 {
+		mmLogID.mock.t.Fatalf("Some expectations are already set for the Matcher.LogID method")
+	}
+
+	mmLogID.mock.funcLogID = f
+	return mmLogID.mock
+}
+
+// LogID implements stats.Matcher
+func (mmLogID *MatcherMock) LogID() (i1 int) {
+	mm_atomic.AddUint64(&mmLogID.beforeLogIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogID.afterLogIDCounter, 1)
+
+	if mmLogID.inspectFuncLogID != nil {
+		mmLogID.inspectFuncLogID()
+	}
+
+	if mmLogID.LogIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogID.LogIDMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmLogID.LogIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogID.t.Fatal("No results are set for the MatcherMock.LogID")
+		}
+		return (*mm_results).i1
+	}
+	if mmLogID.funcLogID != nil {
+		return mmLogID.funcLogID()
+	}
+	mmLogID.t.Fatalf("Unexpected call to MatcherMock.LogID.")
+	return
+}
+
+// LogIDAfterCounter returns a count of finished MatcherMock.LogID invocations
+func (mmLogID *MatcherMock) LogIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogID.afterLogIDCounter)
+}
+
+// LogIDBeforeCounter returns a count of MatcherMock.LogID invocations
+func (mmLogID *MatcherMock) LogIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogID.beforeLogIDCounter)
+}
+
+// MinimockLogIDDone returns true if the count of the LogID invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockLogIDDone() bool {
+	for _, e := range m.LogIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLogIDCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogID != nil && mm_atomic.LoadUint64(&m.afterLogIDCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLogIDInspect logs each unmet expectation
+func (m *MatcherMock) MinimockLogIDInspect() {
+	for _, e := range m.LogIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.LogID")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLogIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LogID")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogID != nil && mm_atomic.LoadUint64(&m.afterLogIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LogID")
+	}
+}
+
+type mMatcherMockLogURL struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockLogURLExpectation
+	expectations       []*MatcherMockLogURLExpectation
+}
+
+// MatcherMockLogURLExpectation specifies expectation struct of the Matcher.LogURL
+type MatcherMockLogURLExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockLogURLResults
+	Counter uint64
+}
+
+// MatcherMockLogURLResults contains results of the Matcher.LogURL
+type MatcherMockLogURLResults struct {
+	s1 string
+}
+
+// Expect sets up expected params for Matcher.LogURL
+func (mmLogURL *mMatcherMockLogURL) Expect() *mMatcherMockLogURL {
+	if mmLogURL.mock.funcLogURL != nil {
+		mmLogURL.mock.t.Fatalf("MatcherMock.LogURL mock is already set by Set")
+	}
+
+	if mmLogURL.defaultExpectation == nil {
+		mmLogURL.defaultExpectation = &MatcherMockLogURLExpectation{}
+	}
+
+	return mmLogURL
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.LogURL
+func (mmLogURL *mMatcherMockLogURL) Inspect(f func()) *mMatcherMockLogURL {
+	if mmLogURL.mock.inspectFuncLogURL != nil {
+		mmLogURL.mock.t.Fatalf("Inspect function is already set for MatcherMock.LogURL")
+	}
+
+	mmLogURL.mock.inspectFuncLogURL = f
+
+	return mmLogURL
+}
+
+// Return sets up results that will be returned by Matcher.LogURL
+func (mmLogURL *mMatcherMockLogURL) Return(s1 string) *MatcherMock {
+	if mmLogURL.mock.funcLogURL != nil {
+		mmLogURL.mock.t.Fatalf("MatcherMock.LogURL mock is already set by Set")
+	}
+
+	if mmLogURL.defaultExpectation == nil {
+		mmLogURL.defaultExpectation = &MatcherMockLogURLExpectation{mock: mmLogURL.mock}
+	}
+	mmLogURL.defaultExpectation.results = &MatcherMockLogURLResults{s1}
+	return mmLogURL.mock
+}
+
+//Set uses given function f to mock the Matcher.LogURL method
+func (mmLogURL *mMatcherMockLogURL) Set(f func() (s1 string)) *MatcherMock {
+	if mmLogURL.defaultExpectation != nil {
+		mmLogURL.mock.t.Fatalf("Default expectation is already set for the Matcher.LogURL method")
+	}
+
+	if len(mmLogURL.expectations) > 0 {
+		mmLogURL.mock.t.Fatalf("Some expectations are already set for the Matcher.LogURL method")
+	}
+
+	mmLogURL.mock.funcLogURL = f
+	return mmLogURL.mock
+}
+
+// LogURL implements stats.Matcher
+func (mmLogURL *MatcherMock) LogURL() (s1 string) {
+	mm_atomic.AddUint64(&mmLogURL.beforeLogURLCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogURL.afterLogURLCounter, 1)
+
+	if mmLogURL.inspectFuncLogURL != nil {
+		mmLogURL.inspectFuncLogURL()
+	}
+
+	if mmLogURL.LogURLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogURL.LogURLMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmLogURL.LogURLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogURL.t.Fatal("No results are set for the MatcherMock.LogURL")
+		}
+		return (*mm_results).s1
+	}
+	if mmLogURL.funcLogURL != nil {
+		return mmLogURL.funcLogURL()
+	}
+	mmLogURL.t.Fatalf("Unexpected call to MatcherMock.LogURL.")
+	return
+}
+
+// LogURLAfterCounter returns a count of finished MatcherMock.LogURL invocations
+func (mmLogURL *MatcherMock) LogURLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogURL.afterLogURLCounter)
+}
+
+// LogURLBeforeCounter returns a count of MatcherMock.LogURL invocations
+func (mmLogURL *MatcherMock) LogURLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogURL.beforeLogURLCounter)
+}
+
+// MinimockLogURLDone returns true if the count of the LogURL invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockLogURLDone() bool {
+	for _, e := range m.LogURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLogURLCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogURL != nil && mm_atomic.LoadUint64(&m.afterLogURLCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLogURLInspect logs each unmet expectation
+func (m *MatcherMock) MinimockLogURLInspect() {
+	for _, e := range m.LogURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.LogURL")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLogURLCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LogURL")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogURL != nil && mm_atomic.LoadUint64(&m.afterLogURLCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.LogURL")
+	}
+}
+
+type mMatcherMockMap struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockMapExpectation
+	expectations       []*MatcherMockMapExpectation
+}
+
+// MatcherMockMapExpectation specifies expectation struct of the Matcher.Map
+type MatcherMockMapExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockMapResults
+	Counter uint64
+}
+
+// MatcherMockMapResults contains results of the Matcher.Map
+type MatcherMockMapResults struct {
+	s1 string
+}
+
+// Expect sets up expected params for Matcher.Map
+func (mmMap *mMatcherMockMap) Expect() *mMatcherMockMap {
+	if mmMap.mock.funcMap != nil {
+		mmMap.mock.t.Fatalf("MatcherMock.Map mock is already set by Set")
+	}
+
+	if mmMap.defaultExpectation == nil {
+		mmMap.defaultExpectation = &MatcherMockMapExpectation{}
+	}
+
+	return mmMap
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.Map
+func (mmMap *mMatcherMockMap) Inspect(f func()) *mMatcherMockMap {
+	if mmMap.mock.inspectFuncMap != nil {
+		mmMap.mock.t.Fatalf("Inspect function is already set for MatcherMock.Map")
+	}
+
+	mmMap.mock.inspectFuncMap = f
+
+	return mmMap
+}
+
+// Return sets up results that will be returned by Matcher.Map
+func (mmMap *mMatcherMockMap) Return(s1 string) *MatcherMock {
+	if mmMap.mock.funcMap != nil {
+		mmMap.mock.t.Fatalf("MatcherMock.Map mock is already set by Set")
+	}
+
+	if mmMap.defaultExpectation == nil {
+		mmMap.defaultExpectation = &MatcherMockMapExpectation{mock: mmMap.mock}
+	}
+	mmMap.defaultExpectation.results = &MatcherMockMapResults{s1}
+	return mmMap.mock
+}
+
+//Set uses given function f to mock the Matcher.Map method
+func (mmMap *mMatcherMockMap) Set(f func() (s1 string)) *MatcherMock {
+	if mmMap.defaultExpectation != nil {
+		mmMap.mock.t.Fatalf("Default expectation is already set for the Matcher.Map method")
+	}
+
+	if len(mmMap.expectations) > 0 {
+		mmMap.mock.t.Fatalf("Some expectations are already set for the Matcher.Map method")
+	}
+
+	mmMap.mock.funcMap = f
+	return mmMap.mock
+}
+
+// Map implements stats.Matcher
+func (mmMap *MatcherMock) Map() (s1 string) {
+	mm_atomic.AddUint64(&mmMap.beforeMapCounter, 1)
+	defer mm_atomic.AddUint64(&mmMap.afterMapCounter, 1)
+
+	if mmMap.inspectFuncMap != nil {
+		mmMap.inspectFuncMap()
+	}
+
+	if mmMap.MapMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMap.MapMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmMap.MapMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMap.t.Fatal("No results are set for the MatcherMock.Map")
+		}
+		return (*mm_results).s1
+	}
+	if mmMap.funcMap != nil {
+		return mmMap.funcMap()
+	}
+	mmMap.t.Fatalf("Unexpected call to MatcherMock.Map.")
+	return
+}
+
+// MapAfterCounter returns a count of finished MatcherMock.Map invocations
+func (mmMap *MatcherMock) MapAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMap.afterMapCounter)
+}
+
+// MapBeforeCounter returns a count of MatcherMock.Map invocations
+func (mmMap *MatcherMock) MapBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMap.beforeMapCounter)
+}
+
+// MinimockMapDone returns true if the count of the Map invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockMapDone() bool {
+	for _, e := range m.MapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMap != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMapInspect logs each unmet expectation
+func (m *MatcherMock) MinimockMapInspect() {
+	for _, e := range m.MapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.Map")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Map")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMap != nil && mm_atomic.LoadUint64(&m.afterMapCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Map")
+	}
+}
+
+type mMatcherMockPickupID struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockPickupIDExpectation
+	expectations       []*MatcherMockPickupIDExpectation
+}
+
+// MatcherMockPickupIDExpectation specifies expectation struct of the Matcher.PickupID
+type MatcherMockPickupIDExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockPickupIDResults
+	Counter uint64
+}
+
+// MatcherMockPickupIDResults contains results of the Matcher.PickupID
+type MatcherMockPickupIDResults struct {
+	i1 int
+}
+
+// Expect sets up expected params for Matcher.PickupID
+func (mmPickupID *mMatcherMockPickupID) Expect() *mMatcherMockPickupID {
+	if mmPickupID.mock.funcPickupID != nil {
+		mmPickupID.mock.t.Fatalf("MatcherMock.PickupID mock is already set by Set")
+	}
+
+	if mmPickupID.defaultExpectation == nil {
+		mmPickupID.defaultExpectation = &MatcherMockPickupIDExpectation{}
+	}
+
+	return mmPickupID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.PickupID
+func (mmPickupID *mMatcherMockPickupID) Inspect(f func()) *mMatcherMockPickupID {
+	if mmPickupID.mock.inspectFuncPickupID != nil {
+		mmPickupID.mock.t.Fatalf("Inspect function is already set for MatcherMock.PickupID")
+	}
+
+	mmPickupID.mock.inspectFuncPickupID = f
+
+	return mmPickupID
+}
+
+// Return sets up results that will be returned by Matcher.PickupID
+func (mmPickupID *mMatcherMockPickupID) Return(i1 int) *MatcherMock {
+	if mmPickupID.mock.funcPickupID != nil {
+		mmPickupID.mock.t.Fatalf("MatcherMock.PickupID mock is already set by Set")
+	}
+
+	if mmPickupID.defaultExpectation == nil {
+		mmPickupID.defaultExpectation = &MatcherMockPickupIDExpectation{mock: mmPickupID.mock}
+	}
+	mmPickupID.defaultExpectation.results = &MatcherMockPickupIDResults{i1}
+	return mmPickupID.mock
+}
+
+//Set uses given function f to mock the Matcher.PickupID method
+func (mmPickupID *mMatcherMockPickupID) Set(f func() (i1 int)) *MatcherMock {
+	if mmPickupID.defaultExpectation != nil {
+		mmPickupID.mock.t.Fatalf("Default expectation is already set for the Matcher.PickupID method")
+	}
+
+	if len(mmPickupID.expectations) > 0 {
+		mmPickupID.mock.t.Fatalf("Some expectations are already set for the Matcher.PickupID method")
+	}
+
+	mmPickupID.mock.funcPickupID = f
+	return mmPickupID.mock
+}
+
+// PickupID implements stats.Matcher
+func (mmPickupID *MatcherMock) PickupID() (i1 int) {
+	mm_atomic.AddUint64(&mmPickupID.beforePickupIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmPickupID.afterPickupIDCounter, 1)
+
+	if mmPickupID.inspectFuncPickupID != nil {
+		mmPickupID.inspectFuncPickupID()
+	}
+
+	if mmPickupID.PickupIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPickupID.PickupIDMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmPickupID.PickupIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPickupID.t.Fatal("No results are set for the MatcherMock.PickupID")
+		}
+		return (*mm_results).i1
+	}
+	if mmPickupID.funcPickupID != nil {
+		return mmPickupID.funcPickupID()
+	}
+	mmPickupID.t.Fatalf("Unexpected call to MatcherMock.PickupID.")
+	return
+}
+
+// PickupIDAfterCounter returns a count of finished MatcherMock.PickupID invocations
+func (mmPickupID *MatcherMock) PickupIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupID.afterPickupIDCounter)
+}
+
+// PickupIDBeforeCounter returns a count of MatcherMock.PickupID invocations
+func (mmPickupID *MatcherMock) PickupIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupID.beforePickupIDCounter)
+}
+
+// MinimockPickupIDDone returns true if the count of the PickupID invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockPickupIDDone() bool {
+	for _, e := range m.PickupIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PickupIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPickupID != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPickupIDInspect logs each unmet expectation
+func (m *MatcherMock) MinimockPickupIDInspect() {
+	for _, e := range m.PickupIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.PickupID")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PickupIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PickupID")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPickupID != nil && mm_atomic.LoadUint64(&m.afterPickupIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PickupID")
+	}
+}
+
+type mMatcherMockPickupPlayers struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockPickupPlayersExpectation
+	expectations       []*MatcherMockPickupPlayersExpectation
+}
+
+// MatcherMockPickupPlayersExpectation specifies expectation struct of the Matcher.PickupPlayers
+type MatcherMockPickupPlayersExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockPickupPlayersResults
+	Counter uint64
+}
+
+// MatcherMockPickupPlayersResults contains results of the Matcher.PickupPlayers
+type MatcherMockPickupPlayersResults struct {
+	ppa1 []*mm_stats.PickupPlayer
+}
+
+// Expect sets up expected params for Matcher.PickupPlayers
+func (mmPickupPlayers *mMatcherMockPickupPlayers) Expect() *mMatcherMockPickupPlayers {
+	if mmPickupPlayers.mock.funcPickupPlayers != nil {
+		mmPickupPlayers.mock.t.Fatalf("MatcherMock.PickupPlayers mock is already set by Set")
+	}
+
+	if mmPickupPlayers.defaultExpectation == nil {
+		mmPickupPlayers.defaultExpectation = &MatcherMockPickupPlayersExpectation{}
+	}
+
+	return mmPickupPlayers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.PickupPlayers
+func (mmPickupPlayers *mMatcherMockPickupPlayers) Inspect(f func()) *mMatcherMockPickupPlayers {
+	if mmPickupPlayers.mock.inspectFuncPickupPlayers != nil {
+		mmPickupPlayers.mock.t.Fatalf("Inspect function is already set for MatcherMock.PickupPlayers")
+	}
+
+	mmPickupPlayers.mock.inspectFuncPickupPlayers = f
+
+	return mmPickupPlayers
+}
+
+// Return sets up results that will be returned by Matcher.PickupPlayers
+func (mmPickupPlayers *mMatcherMockPickupPlayers) Return(ppa1 []*mm_stats.PickupPlayer) *MatcherMock {
+	if mmPickupPlayers.mock.funcPickupPlayers != nil {
+		mmPickupPlayers.mock.t.Fatalf("MatcherMock.PickupPlayers mock is already set by Set")
+	}
+
+	if mmPickupPlayers.defaultExpectation == nil {
+		mmPickupPlayers.defaultExpectation = &MatcherMockPickupPlayersExpectation{mock: mmPickupPlayers.mock}
+	}
+	mmPickupPlayers.defaultExpectation.results = &MatcherMockPickupPlayersResults{ppa1}
+	return mmPickupPlayers.mock
+}
+
+//Set uses given function f to mock the Matcher.PickupPlayers method
+func (mmPickupPlayers *mMatcherMockPickupPlayers) Set(f func() (ppa1 []*mm_stats.PickupPlayer)) *MatcherMock {
+	if mmPickupPlayers.defaultExpectation != nil {
+		mmPickupPlayers.mock.t.Fatalf("Default expectation is already set for the Matcher.PickupPlayers method")
+	}
+
+	if len(mmPickupPlayers.expectations) > 0 {
+		mmPickupPlayers.mock.t.Fatalf("Some expectations are already set for the Matcher.PickupPlayers method")
+	}
+
+	mmPickupPlayers.mock.funcPickupPlayers = f
+	return mmPickupPlayers.mock
+}
+
+// PickupPlayers implements stats.Matcher
+func (mmPickupPlayers *MatcherMock) PickupPlayers() (ppa1 []*mm_stats.PickupPlayer) {
+	mm_atomic.AddUint64(&mmPickupPlayers.beforePickupPlayersCounter, 1)
+	defer mm_atomic.AddUint64(&mmPickupPlayers.afterPickupPlayersCounter, 1)
+
+	if mmPickupPlayers.inspectFuncPickupPlayers != nil {
+		mmPickupPlayers.inspectFuncPickupPlayers()
+	}
+
+	if mmPickupPlayers.PickupPlayersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPickupPlayers.PickupPlayersMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmPickupPlayers.PickupPlayersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPickupPlayers.t.Fatal("No results are set for the MatcherMock.PickupPlayers")
+		}
+		return (*mm_results).ppa1
+	}
+	if mmPickupPlayers.funcPickupPlayers != nil {
+		return mmPickupPlayers.funcPickupPlayers()
+	}
+	mmPickupPlayers.t.Fatalf("Unexpected call to MatcherMock.PickupPlayers.")
+	return
+}
+
+// PickupPlayersAfterCounter returns a count of finished MatcherMock.PickupPlayers invocations
+func (mmPickupPlayers *MatcherMock) PickupPlayersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupPlayers.afterPickupPlayersCounter)
+}
+
+// PickupPlayersBeforeCounter returns a count of MatcherMock.PickupPlayers invocations
+func (mmPickupPlayers *MatcherMock) PickupPlayersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPickupPlayers.beforePickupPlayersCounter)
+}
+
+// MinimockPickupPlayersDone returns true if the count of the PickupPlayers invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockPickupPlayersDone() bool {
+	for _, e := range m.PickupPlayersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PickupPlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPickupPlayers != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPickupPlayersInspect logs each unmet expectation
+func (m *MatcherMock) MinimockPickupPlayersInspect() {
+	for _, e := range m.PickupPlayersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.PickupPlayers")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PickupPlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PickupPlayers")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPickupPlayers != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PickupPlayers")
+	}
+}
+
+type mMatcherMockPlayerStats struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockPlayerStatsExpectation
+	expectations       []*MatcherMockPlayerStatsExpectation
+}
+
+// MatcherMockPlayerStatsExpectation specifies expectation struct of the Matcher.PlayerStats
+type MatcherMockPlayerStatsExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockPlayerStatsResults
+	Counter uint64
+}
+
+// MatcherMockPlayerStatsResults contains results of the Matcher.PlayerStats
+type MatcherMockPlayerStatsResults struct {
+	p1 mm_stats.PlayerStatsCollection
+}
+
+// Expect sets up expected params for Matcher.PlayerStats
+func (mmPlayerStats *mMatcherMockPlayerStats) Expect() *mMatcherMockPlayerStats {
+	if mmPlayerStats.mock.funcPlayerStats != nil {
+		mmPlayerStats.mock.t.Fatalf("MatcherMock.PlayerStats mock is already set by Set")
+	}
+
+	if mmPlayerStats.defaultExpectation == nil {
+		mmPlayerStats.defaultExpectation = &MatcherMockPlayerStatsExpectation{}
+	}
+
+	return mmPlayerStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.PlayerStats
+func (mmPlayerStats *mMatcherMockPlayerStats) Inspect(f func()) *mMatcherMockPlayerStats {
+	if mmPlayerStats.mock.inspectFuncPlayerStats != nil {
+		mmPlayerStats.mock.t.Fatalf("Inspect function is already set for MatcherMock.PlayerStats")
+	}
+
+	mmPlayerStats.mock.inspectFuncPlayerStats = f
+
+	return mmPlayerStats
+}
+
+// Return sets up results that will be returned by Matcher.PlayerStats
+func (mmPlayerStats *mMatcherMockPlayerStats) Return(p1 mm_stats.PlayerStatsCollection) *MatcherMock {
+	if mmPlayerStats.mock.funcPlayerStats != nil {
+		mmPlayerStats.mock.t.Fatalf("MatcherMock.PlayerStats mock is already set by Set")
+	}
+
+	if mmPlayerStats.defaultExpectation == nil {
+		mmPlayerStats.defaultExpectation = &MatcherMockPlayerStatsExpectation{mock: mmPlayerStats.mock}
+	}
+	mmPlayerStats.defaultExpectation.results = &MatcherMockPlayerStatsResults{p1}
+	return mmPlayerStats.mock
+}
+
+//Set uses given function f to mock the Matcher.PlayerStats method
+func (mmPlayerStats *mMatcherMockPlayerStats) Set(f func() (p1 mm_stats.PlayerStatsCollection)) *MatcherMock {
+	if mmPlayerStats.defaultExpectation != nil {
+		mmPlayerStats.mock.t.Fatalf("Default expectation is already set for the Matcher.PlayerStats method")
+	}
+
+	if len(mmPlayerStats.expectations) > 0 {
+		mmPlayerStats.mock.t.Fatalf("Some expectations are already set for the Matcher.PlayerStats method")
+	}
+
+	mmPlayerStats.mock.funcPlayerStats = f
+	return mmPlayerStats.mock
+}
+
+// PlayerStats implements stats.Matcher
+func (mmPlayerStats *MatcherMock) PlayerStats() (p1 mm_stats.PlayerStatsCollection) {
+	mm_atomic.AddUint64(&mmPlayerStats.beforePlayerStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPlayerStats.afterPlayerStatsCounter, 1)
+
+	if mmPlayerStats.inspectFuncPlayerStats != nil {
+		mmPlayerStats.inspectFuncPlayerStats()
+	}
+
+	if mmPlayerStats.PlayerStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPlayerStats.PlayerStatsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmPlayerStats.PlayerStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPlayerStats.t.Fatal("No results are set for the MatcherMock.PlayerStats")
+		}
+		return (*mm_results).p1
+	}
+	if mmPlayerStats.funcPlayerStats != nil {
+		return mmPlayerStats.funcPlayerStats()
+	}
+	mmPlayerStats.t.Fatalf("Unexpected call to MatcherMock.PlayerStats.")
+	return
+}
+
+// PlayerStatsAfterCounter returns a count of finished MatcherMock.PlayerStats invocations
+func (mmPlayerStats *MatcherMock) PlayerStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPlayerStats.afterPlayerStatsCounter)
+}
+
+// PlayerStatsBeforeCounter returns a count of MatcherMock.PlayerStats invocations
+func (mmPlayerStats *MatcherMock) PlayerStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPlayerStats.beforePlayerStatsCounter)
+}
+
+// MinimockPlayerStatsDone returns true if the count of the PlayerStats invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockPlayerStatsDone() bool {
+	for _, e := range m.PlayerStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PlayerStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPlayerStats != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPlayerStatsInspect logs each unmet expectation
+func (m *MatcherMock) MinimockPlayerStatsInspect() {
+	for _, e := range m.PlayerStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.PlayerStats")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PlayerStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PlayerStats")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPlayerStats != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.PlayerStats")
+	}
+}
+
+type mMatcherMockRounds struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockRoundsExpectation
+	expectations       []*MatcherMockRoundsExpectation
+}
+
+// MatcherMockRoundsExpectation specifies expectation struct of the Matcher.Rounds
+type MatcherMockRoundsExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockRoundsResults
+	Counter uint64
+}
+
+// MatcherMockRoundsResults contains results of the Matcher.Rounds
+type MatcherMockRoundsResults struct {
+	ra1 []mm_stats.Round
+}
+
+// Expect sets up expected params for Matcher.Rounds
+func (mmRounds *mMatcherMockRounds) Expect() *mMatcherMockRounds {
+	if mmRounds.mock.funcRounds != nil {
+		mmRounds.mock.t.Fatalf("MatcherMock.Rounds mock is already set by Set")
+	}
+
+	if mmRounds.defaultExpectation == nil {
+		mmRounds.defaultExpectation = &MatcherMockRoundsExpectation{}
+	}
+
+	return mmRounds
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.Rounds
+func (mmRounds *mMatcherMockRounds) Inspect(f func()) *mMatcherMockRounds {
+	if mmRounds.mock.inspectFuncRounds != nil {
+		mmRounds.mock.t.Fatalf("Inspect function is already set for MatcherMock.Rounds")
+	}
+
+	mmRounds.mock.inspectFuncRounds = f
+
+	return mmRounds
+}
+
+// Return sets up results that will be returned by Matcher.Rounds
+func (mmRounds *mMatcherMockRounds) Return(ra1 []mm_stats.Round) *MatcherMock {
+	if mmRounds.mock.funcRounds != nil {
+		mmRounds.mock.t.Fatalf("MatcherMock.Rounds mock is already set by Set")
+	}
+
+	if mmRounds.defaultExpectation == nil {
+		mmRounds.defaultExpectation = &MatcherMockRoundsExpectation{mock: mmRounds.mock}
+	}
+	mmRounds.defaultExpectation.results = &MatcherMockRoundsResults{ra1}
+	return mmRounds.mock
+}
+
+//Set uses given function f to mock the Matcher.Rounds method
+func (mmRounds *mMatcherMockRounds) Set(f func() (ra1 []mm_stats.Round)) *MatcherMock {
+	if mmRounds.defaultExpectation != nil {
+		mmRounds.mock.t.Fatalf("Default expectation is already set for the Matcher.Rounds method")
+	}
+
+	if len(mmRounds.expectations) > 0 {
+		mmRounds.mock.t.Fatalf("Some expectations are already set for the Matcher.Rounds method")
+	}
+
+	mmRounds.mock.funcRounds = f
+	return mmRounds.mock
+}
+
+// Rounds implements stats.Matcher
+func (mmRounds *MatcherMock) Rounds() (ra1 []mm_stats.Round) {
+	mm_atomic.AddUint64(&mmRounds.beforeRoundsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRounds.afterRoundsCounter, 1)
+
+	if mmRounds.inspectFuncRounds != nil {
+		mmRounds.inspectFuncRounds()
+	}
+
+	if mmRounds.RoundsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRounds.RoundsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmRounds.RoundsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRounds.t.Fatal("No results are set for the MatcherMock.Rounds")
+		}
+		return (*mm_results).ra1
+	}
+	if mmRounds.funcRounds != nil {
+		return mmRounds.funcRounds()
+	}
+	mmRounds.t.Fatalf("Unexpected call to MatcherMock.Rounds.")
+	return
+}
+
+// RoundsAfterCounter returns a count of finished MatcherMock.Rounds invocations
+func (mmRounds *MatcherMock) RoundsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRounds.afterRoundsCounter)
+}
+
+// RoundsBeforeCounter returns a count of MatcherMock.Rounds invocations
+func (mmRounds *MatcherMock) RoundsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRounds.beforeRoundsCounter)
+}
+
+// MinimockRoundsDone returns true if the count of the Rounds invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockRoundsDone() bool {
+	for _, e := range m.RoundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RoundsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRoundsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRounds != nil && mm_atomic.LoadUint64(&m.afterRoundsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRoundsInspect logs each unmet expectation
+func (m *MatcherMock) MinimockRoundsInspect() {
+	for _, e := range m.RoundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MatcherMock.Rounds")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RoundsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRoundsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Rounds")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRounds != nil && mm_atomic.LoadUint64(&m.afterRoundsCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Rounds")
+	}
+}
+
+type mMatcherMockScore struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockScoreExpectation
+	expectations       []*MatcherMockScoreExpectation
+}
+
+// MatcherMockScoreExpectation specifies expectation struct of the Matcher.Score
+type MatcherMockScoreExpectation struct {
+	mock *MatcherMock
+
+	results *MatcherMockScoreResults
+	Counter uint64
+}
+
+// MatcherMockScoreResults contains results of the Matcher.Score
+type MatcherMockScoreResults struct {
+	c1 mm_stats.CurrentScores
+}
+
+// Expect sets up expected params for Matcher.Score
+func (mmScore *mMatcherMockScore) Expect() *mMatcherMockScore {
+	if mmScore.mock.funcScore != nil {
+		mmScore.mock.t.Fatalf("MatcherMock.Score mock is already set by Set")
+	}
+
+	if mmScore.defaultExpectation == nil {
+		mmScore.defaultExpectation = &MatcherMockScoreExpectation{}
+	}
+
+	return mmScore
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.Score
+func (mmScore *mMatcherMockScore) Inspect(f func()) *mMatcherMockScore {
+	if mmScore.mock.inspectFuncScore != nil {
+		mmScore.mock.t.Fatalf("Inspect function is already set for MatcherMock.Score")
+	}
+
+	mmScore.mock.inspectFuncScore = f
+
+	return mmScore
+}
+
+// Return sets up results that will be returned by Matcher.Score
+func (mmScore *mMatcherMockScore) Return(c1 mm_stats.CurrentScores) *MatcherMock {
+	if mmScore.mock.funcScore != nil {
+		mmScore.mock.t.Fatalf("MatcherMock.Score mock is already set by Set")
 	}
 
-	if mmPickupPlayers.defaultExpectation == nil {
-		mmPickupPlayers.defaultExpectation = &MatcherMockPickupPlayersExpectation{mock: mmPickupPlayers.mock}
+	if mmScore.defaultExpectation == nil {
+		mmScore.defaultExpectation = &MatcherMockScoreExpectation{mock: mmScore.mock}
 	}
-	mmPickupPlayers.defaultExpectation.results = &MatcherMockPickupPlayersResults{ppa1}
-	return mmPickupPlayers.mock
+	mmScore.defaultExpectation.results = &MatcherMockScoreResults{c1}
+	return mmScore.mock
 }
 
-//Set uses given function f to mock the Matcher.PickupPlayers method
-func (mmPickupPlayers *mMatcherMockPickupPlayers) Set(f func() (ppa1 []*mm_stats.PickupPlayer)) *MatcherMock {
-	if mmPickupPlayers.defaultExpectation != nil {
-		mmPickupPlayers.mock.t.Fatalf("Default expectation is already set for the Matcher.PickupPlayers method")
+//Set uses given function f to mock the Matcher.Score method
+func (mmScore *mMatcherMockScore) Set(f func() (c1 mm_stats.CurrentScores)) *MatcherMock {
+	if mmScore.defaultExpectation != nil {
+		mmScore.mock.t.Fatalf("Default expectation is already set for the Matcher.Score method")
 	}
 
-	if len(mmPickupPlayers.expectations) > 0 {
-		mmPickupPlayers.mock.t.Fatalf("Some expectations are already set for the Matcher.PickupPlayers method")
+	if len(mmScore.expectations) > 0 {
+		mmScore.mock.t.Fatalf("Some expectations are already set for the Matcher.Score method")
 	}
 
-	mmPickupPlayers.mock.funcPickupPlayers = f
-	return mmPickupPlayers.mock
+	mmScore.mock.funcScore = f
+	return mmScore.mock
 }
 
-// PickupPlayers implements stats.Matcher
-func (mmPickupPlayers *MatcherMock) PickupPlayers() (ppa1 []*mm_stats.PickupPlayer) {
-	mm_atomic.AddUint64(&mmPickupPlayers.beforePickupPlayersCounter, 1)
-	defer mm_atomic.AddUint64(&mmPickupPlayers.afterPickupPlayersCounter, 1)
+// Score implements stats.Matcher
+func (mmScore *MatcherMock) Score() (c1 mm_stats.CurrentScores) {
+	mm_atomic.AddUint64(&mmScore.beforeScoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmScore.afterScoreCounter, 1)
 
-	if mmPickupPlayers.inspectFuncPickupPlayers != nil {
-		mmPickupPlayers.inspectFuncPickupPlayers()
+	if mmScore.inspectFuncScore != nil {
+		mmScore.inspectFuncScore()
 	}
 
-	if mmPickupPlayers.PickupPlayersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPickupPlayers.PickupPlayersMock.defaultExpectation.Counter, 1)
+	if mmScore.ScoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScore.ScoreMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmPickupPlayers.PickupPlayersMock.defaultExpectation.results
+		mm_results := mmScore.ScoreMock.defaultExpectation.results
 		if mm_results == nil {
-			mmPickupPlayers.t.Fatal("No results are set for the MatcherMock.PickupPlayers")
+			mmScore.t.Fatal("No results are set for the MatcherMock.Score")
 		}
-		return (*mm_results).ppa1
+		return (*mm_results).c1
 	}
-	if mmPickupPlayers.funcPickupPlayers != nil {
-		return mmPickupPlayers.funcPickupPlayers()
+	if mmScore.funcScore != nil {
+		return mmScore.funcScore()
 	}
-	mmPickupPlayers.t.Fatalf("Unexpected call to MatcherMock.PickupPlayers.")
+	mmScore.t.Fatalf("Unexpected call to MatcherMock.Score.")
 	return
 }
 
-// PickupPlayersAfterCounter returns a count of finished MatcherMock.PickupPlayers invocations
-func (mmPickupPlayers *MatcherMock) PickupPlayersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPickupPlayers.afterPickupPlayersCounter)
+// ScoreAfterCounter returns a count of finished MatcherMock.Score invocations
+func (mmScore *MatcherMock) ScoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScore.afterScoreCounter)
 }
 
-// PickupPlayersBeforeCounter returns a count of MatcherMock.PickupPlayers invocations
-func (mmPickupPlayers *MatcherMock) PickupPlayersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPickupPlayers.beforePickupPlayersCounter)
+// ScoreBeforeCounter returns a count of MatcherMock.Score invocations
+func (mmScore *MatcherMock) ScoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScore.beforeScoreCounter)
 }
 
-// MinimockPickupPlayersDone returns true if the count of the PickupPlayers invocations corresponds
+// MinimockScoreDone returns true if the count of the Score invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockPickupPlayersDone() bool {
-	for _, e := range m.PickupPlayersMock.expectations {
+func (m *MatcherMock) MinimockScoreDone() bool {
+	for _, e := range m.ScoreMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PickupPlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+	if m.ScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScoreCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPickupPlayers != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
+	if m.funcScore != nil && mm_atomic.LoadUint64(&m.afterScoreCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockPickupPlayersInspect logs each unmet expectation
-func (m *MatcherMock) MinimockPickupPlayersInspect() {
-	for _, e := range m.PickupPlayersMock.expectations {
+// MinimockScoreInspect logs each unmet expectation
+func (m *MatcherMock) MinimockScoreInspect() {
+	for _, e := range m.ScoreMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to MatcherMock.PickupPlayers")
+			m.t.Error("Expected call to MatcherMock.Score")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PickupPlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PickupPlayers")
+	if m.ScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScoreCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Score")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPickupPlayers != nil && mm_atomic.LoadUint64(&m.afterPickupPlayersCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PickupPlayers")
+	if m.funcScore != nil && mm_atomic.LoadUint64(&m.afterScoreCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.Score")
 	}
 }
 
-type mMatcherMockPlayerStats struct {
+type mMatcherMockServerID struct {
 	mock               *MatcherMock
-	defaultExpectation *MatcherMockPlayerStatsExpectation
-	expectations       []*MatcherMockPlayerStatsExpectation
+	defaultExpectation *MatcherMockServerIDExpectation
+	expectations       []*MatcherMockServerIDExpectation
 }
 
-// MatcherMockPlayerStatsExpectation specifies expectation struct of the Matcher.PlayerStats
-type MatcherMockPlayerStatsExpectation struct {
+// MatcherMockServerIDExpectation specifies expectation struct of the Matcher.ServerID
+type MatcherMockServerIDExpectation struct {
 	mock *MatcherMock
 
-	results *MatcherMockPlayerStatsResults
+	results *MatcherMockServerIDResults
 	Counter uint64
 }
 
-// MatcherMockPlayerStatsResults contains results of the Matcher.PlayerStats
-type MatcherMockPlayerStatsResults struct {
-	p1 mm_stats.PlayerStatsCollection
+// MatcherMockServerIDResults contains results of the Matcher.ServerID
+type MatcherMockServerIDResults struct {
+	i1 int
 }
 
-// Expect sets up expected params for Matcher.PlayerStats
-func (mmPlayerStats *mMatcherMockPlayerStats) Expect() *mMatcherMockPlayerStats {
-	if mmPlayerStats.mock.funcPlayerStats != nil {
-		mmPlayerStats.mock.t.Fatalf("MatcherMock.PlayerStats mock is already set by Set")
+// Expect sets up expected params for Matcher.ServerID
+func (mmServerID *mMatcherMockServerID) Expect() *mMatcherMockServerID {
+	if mmServerID.mock.funcServerID != nil {
+		mmServerID.mock.t.Fatalf("MatcherMock.ServerID mock is already set by Set")
 	}
 
-	if mmPlayerStats.defaultExpectation == nil {
-		mmPlayerStats.defaultExpectation = &MatcherMockPlayerStatsExpectation{}
+	if mmServerID.defaultExpectation == nil {
+		mmServerID.defaultExpectation = &MatcherMockServerIDExpectation{}
 	}
 
-	return mmPlayerStats
+	return mmServerID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Matcher.PlayerStats
-func (mmPlayerStats *mMatcherMockPlayerStats) Inspect(f func()) *mMatcherMockPlayerStats {
-	if mmPlayerStats.mock.inspectFuncPlayerStats != nil {
-		mmPlayerStats.mock.t.Fatalf("Inspect function is already set for MatcherMock.PlayerStats")
+// Inspect accepts an inspector function that has same arguments as the Matcher.ServerID
+func (mmServerID *mMatcherMockServerID) Inspect(f func()) *mMatcherMockServerID {
+	if mmServerID.mock.inspectFuncServerID != nil {
+		mmServerID.mock.t.Fatalf("Inspect function is already set for MatcherMock.ServerID")
 	}
 
-	mmPlayerStats.mock.inspectFuncPlayerStats = f
+	mmServerID.mock.inspectFuncServerID = f
 
-	return mmPlayerStats
+	return mmServerID
 }
 
-// Return sets up results that will be returned by Matcher.PlayerStats
-func (mmPlayerStats *mMatcherMockPlayerStats) Return(p1 mm_stats.PlayerStatsCollection) *MatcherMock {
-	if mmPlayerStats.mock.funcPlayerStats != nil {
-		mmPlayerStats.mock.t.Fatalf("MatcherMock.PlayerStats mock is already set by Set")
+// Return sets up results that will be returned by Matcher.ServerID
+func (mmServerID *mMatcherMockServerID) Return(i1 int) *MatcherMock {
+	if mmServerID.mock.funcServerID != nil {
+		mmServerID.mock.t.Fatalf("MatcherMock.ServerID mock is already set by Set")
 	}
 
-	if mmPlayerStats.defaultExpectation == nil {
-		mmPlayerStats.defaultExpectation = &MatcherMockPlayerStatsExpectation{mock: mmPlayerStats.mock}
+	if mmServerID.defaultExpectation == nil {
+		mmServerID.defaultExpectation = &MatcherMockServerIDExpectation{mock: mmServerID.mock}
 	}
-	mmPlayerStats.defaultExpectation.results = &MatcherMockPlayerStatsResults{p1}
-	return mmPlayerStats.mock
+	mmServerID.defaultExpectation.results = &MatcherMockServerIDResults{i1}
+	return mmServerID.mock
 }
 
-//Set uses given function f to mock the Matcher.PlayerStats method
-func (mmPlayerStats *mMatcherMockPlayerStats) Set(f func() (p1 mm_stats.PlayerStatsCollection)) *MatcherMock {
-	if mmPlayerStats.defaultExpectation != nil {
-		mmPlayerStats.mock.t.Fatalf("Default expectation is already set for the Matcher.PlayerStats method")
+//Set uses given function f to mock the Matcher.ServerID method
+func (mmServerID *mMatcherMockServerID) Set(f func() (i1 int)) *MatcherMock {
+	if mmServerID.defaultExpectation != nil {
+		mmServerID.mock.t.Fatalf("Default expectation is already set for the Matcher.ServerID method")
 	}
 
-	if len(mmPlayerStats.expectations) > 0 {
-		mmPlayerStats.mock.t.Fatalf("Some expectations are already set for the Matcher.PlayerStats method")
+	if len(mmServerID.expectations) > 0 {
+		mmServerID.mock.t.Fatalf("Some expectations are already set for the Matcher.ServerID method")
 	}
 
-	mmPlayerStats.mock.funcPlayerStats = f
-	return mmPlayerStats.mock
+	mmServerID.mock.funcServerID = f
+	return mmServerID.mock
 }
 
-// PlayerStats implements stats.Matcher
-func (mmPlayerStats *MatcherMock) PlayerStats() (p1 mm_stats.PlayerStatsCollection) {
-	mm_atomic.AddUint64(&mmPlayerStats.beforePlayerStatsCounter, 1)
-	defer mm_atomic.AddUint64(&mmPlayerStats.afterPlayerStatsCounter, 1)
+// ServerID implements stats.Matcher
+func (mmServerID *MatcherMock) ServerID() (i1 int) {
+	mm_atomic.AddUint64(&mmServerID.beforeServerIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmServerID.afterServerIDCounter, 1)
 
-	if mmPlayerStats.inspectFuncPlayerStats != nil {
-		mmPlayerStats.inspectFuncPlayerStats()
+	if mmServerID.inspectFuncServerID != nil {
+		mmServerID.inspectFuncServerID()
 	}
 
-	if mmPlayerStats.PlayerStatsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPlayerStats.PlayerStatsMock.defaultExpectation.Counter, 1)
+	if mmServerID.ServerIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmServerID.ServerIDMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmPlayerStats.PlayerStatsMock.defaultExpectation.results
+		mm_results := mmServerID.ServerIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmPlayerStats.t.Fatal("No results are set for the MatcherMock.PlayerStats")
+			mmServerID.t.Fatal("No results are set for the MatcherMock.ServerID")
 		}
-		return (*mm_results).p1
+		return (*mm_results).i1
 	}
-	if mmPlayerStats.funcPlayerStats != nil {
-		return mmPlayerStats.funcPlayerStats()
+	if mmServerID.funcServerID != nil {
+		return mmServerID.funcServerID()
 	}
-	mmPlayerStats.t.Fatalf("Unexpected call to MatcherMock.PlayerStats.")
+	mmServerID.t.Fatalf("Unexpected call to MatcherMock.ServerID.")
 	return
 }
 
-// PlayerStatsAfterCounter returns a count of finished MatcherMock.PlayerStats invocations
-func (mmPlayerStats *MatcherMock) PlayerStatsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPlayerStats.afterPlayerStatsCounter)
+// ServerIDAfterCounter returns a count of finished MatcherMock.ServerID invocations
+func (mmServerID *MatcherMock) ServerIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmServerID.afterServerIDCounter)
 }
 
-// PlayerStatsBeforeCounter returns a count of MatcherMock.PlayerStats invocations
-func (mmPlayerStats *MatcherMock) PlayerStatsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPlayerStats.beforePlayerStatsCounter)
+// ServerIDBeforeCounter returns a count of MatcherMock.ServerID invocations
+func (mmServerID *MatcherMock) ServerIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmServerID.beforeServerIDCounter)
 }
 
-// MinimockPlayerStatsDone returns true if the count of the PlayerStats invocations corresponds
+// MinimockServerIDDone returns true if the count of the ServerID invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockPlayerStatsDone() bool {
-	for _, e := range m.PlayerStatsMock.expectations {
+func (m *MatcherMock) MinimockServerIDDone() bool {
+	for _, e := range m.ServerIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PlayerStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+	if m.ServerIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterServerIDCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPlayerStats != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
+	if m.funcServerID != nil && mm_atomic.LoadUint64(&m.afterServerIDCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockPlayerStatsInspect logs each unmet expectation
-func (m *MatcherMock) MinimockPlayerStatsInspect() {
-	for _, e := range m.PlayerStatsMock.expectations {
+// MinimockServerIDInspect logs each unmet expectation
+func (m *MatcherMock) MinimockServerIDInspect() {
+	for _, e := range m.ServerIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to MatcherMock.PlayerStats")
+			m.t.Error("Expected call to MatcherMock.ServerID")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PlayerStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PlayerStats")
+	if m.ServerIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterServerIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.ServerID")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPlayerStats != nil && mm_atomic.LoadUint64(&m.afterPlayerStatsCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.PlayerStats")
+	if m.funcServerID != nil && mm_atomic.LoadUint64(&m.afterServerIDCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.ServerID")
 	}
 }
 
@@ -1251,105 +2683,292 @@ func (mmSetBlueScore *MatcherMock) SetBlueScore(score int) {
 	mm_atomic.AddUint64(&mmSetBlueScore.beforeSetBlueScoreCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetBlueScore.afterSetBlueScoreCounter, 1)
 
-	if mmSetBlueScore.inspectFuncSetBlueScore != nil {
-		mmSetBlueScore.inspectFuncSetBlueScore(score)
+	if mmSetBlueScore.inspectFuncSetBlueScore != nil {
+		mmSetBlueScore.inspectFuncSetBlueScore(score)
+	}
+
+	mm_params := &MatcherMockSetBlueScoreParams{score}
+
+	// Record call args
+	mmSetBlueScore.SetBlueScoreMock.mutex.Lock()
+	mmSetBlueScore.SetBlueScoreMock.callArgs = append(mmSetBlueScore.SetBlueScoreMock.callArgs, mm_params)
+	mmSetBlueScore.SetBlueScoreMock.mutex.Unlock()
+
+	for _, e := range mmSetBlueScore.SetBlueScoreMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetBlueScore.SetBlueScoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBlueScore.SetBlueScoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBlueScore.SetBlueScoreMock.defaultExpectation.params
+		mm_got := MatcherMockSetBlueScoreParams{score}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBlueScore.t.Errorf("MatcherMock.SetBlueScore got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetBlueScore.funcSetBlueScore != nil {
+		mmSetBlueScore.funcSetBlueScore(score)
+		return
+	}
+	mmSetBlueScore.t.Fatalf("Unexpected call to MatcherMock.SetBlueScore. %v", score)
+
+}
+
+// SetBlueScoreAfterCounter returns a count of finished MatcherMock.SetBlueScore invocations
+func (mmSetBlueScore *MatcherMock) SetBlueScoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBlueScore.afterSetBlueScoreCounter)
+}
+
+// SetBlueScoreBeforeCounter returns a count of MatcherMock.SetBlueScore invocations
+func (mmSetBlueScore *MatcherMock) SetBlueScoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBlueScore.beforeSetBlueScoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to MatcherMock.SetBlueScore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBlueScore *mMatcherMockSetBlueScore) Calls() []*MatcherMockSetBlueScoreParams {
+	mmSetBlueScore.mutex.RLock()
+
+	argCopy := make([]*MatcherMockSetBlueScoreParams, len(mmSetBlueScore.callArgs))
+	copy(argCopy, mmSetBlueScore.callArgs)
+
+	mmSetBlueScore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBlueScoreDone returns true if the count of the SetBlueScore invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockSetBlueScoreDone() bool {
+	for _, e := range m.SetBlueScoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBlueScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBlueScore != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetBlueScoreInspect logs each unmet expectation
+func (m *MatcherMock) MinimockSetBlueScoreInspect() {
+	for _, e := range m.SetBlueScoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MatcherMock.SetBlueScore with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBlueScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+		if m.SetBlueScoreMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MatcherMock.SetBlueScore")
+		} else {
+			m.t.Errorf("Expected call to MatcherMock.SetBlueScore with params: %#v", *m.SetBlueScoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBlueScore != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.SetBlueScore")
+	}
+}
+
+type mMatcherMockSetIncomplete struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockSetIncompleteExpectation
+	expectations       []*MatcherMockSetIncompleteExpectation
+
+	callArgs []*MatcherMockSetIncompleteParams
+	mutex    sync.RWMutex
+}
+
+// MatcherMockSetIncompleteExpectation specifies expectation struct of the Matcher.SetIncomplete
+type MatcherMockSetIncompleteExpectation struct {
+	mock   *MatcherMock
+	params *MatcherMockSetIncompleteParams
+
+	Counter uint64
+}
+
+// MatcherMockSetIncompleteParams contains parameters of the Matcher.SetIncomplete
+type MatcherMockSetIncompleteParams struct {
+	incomplete bool
+}
+
+// Expect sets up expected params for Matcher.SetIncomplete
+func (mmSetIncomplete *mMatcherMockSetIncomplete) Expect(incomplete bool) *mMatcherMockSetIncomplete {
+	if mmSetIncomplete.mock.funcSetIncomplete != nil {
+		mmSetIncomplete.mock.t.Fatalf("MatcherMock.SetIncomplete mock is already set by Set")
+	}
+
+	if mmSetIncomplete.defaultExpectation == nil {
+		mmSetIncomplete.defaultExpectation = &MatcherMockSetIncompleteExpectation{}
+	}
+
+	mmSetIncomplete.defaultExpectation.params = &MatcherMockSetIncompleteParams{incomplete}
+	for _, e := range mmSetIncomplete.expectations {
+		if minimock.Equal(e.params, mmSetIncomplete.defaultExpectation.params) {
+			mmSetIncomplete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetIncomplete.defaultExpectation.params)
+		}
+	}
+
+	return mmSetIncomplete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.SetIncomplete
+func (mmSetIncomplete *mMatcherMockSetIncomplete) Inspect(f func(incomplete bool)) *mMatcherMockSetIncomplete {
+	if mmSetIncomplete.mock.inspectFuncSetIncomplete != nil {
+		mmSetIncomplete.mock.t.Fatalf("Inspect function is already set for MatcherMock.SetIncomplete")
+	}
+
+	mmSetIncomplete.mock.inspectFuncSetIncomplete = f
+
+	return mmSetIncomplete
+}
+
+// Return sets up results that will be returned by Matcher.SetIncomplete
+func (mmSetIncomplete *mMatcherMockSetIncomplete) Return() *MatcherMock {
+	if mmSetIncomplete.mock.funcSetIncomplete != nil {
+		mmSetIncomplete.mock.t.Fatalf("MatcherMock.SetIncomplete mock is already set by Set")
+	}
+
+	if mmSetIncomplete.defaultExpectation == nil {
+		mmSetIncomplete.defaultExpectation = &MatcherMockSetIncompleteExpectation{mock: mmSetIncomplete.mock}
+	}
+
+	return mmSetIncomplete.mock
+}
+
+//Set uses given function f to mock the Matcher.SetIncomplete method
+func (mmSetIncomplete *mMatcherMockSetIncomplete) Set(f func(incomplete bool)) *MatcherMock {
+	if mmSetIncomplete.defaultExpectation != nil {
+		mmSetIncomplete.mock.t.Fatalf("Default expectation is already set for the Matcher.SetIncomplete method")
+	}
+
+	if len(mmSetIncomplete.expectations) > 0 {
+		mmSetIncomplete.mock.t.Fatalf("Some expectations are already set for the Matcher.SetIncomplete method")
+	}
+
+	mmSetIncomplete.mock.funcSetIncomplete = f
+	return mmSetIncomplete.mock
+}
+
+// SetIncomplete implements stats.Matcher
+func (mmSetIncomplete *MatcherMock) SetIncomplete(incomplete bool) {
+	mm_atomic.AddUint64(&mmSetIncomplete.beforeSetIncompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetIncomplete.afterSetIncompleteCounter, 1)
+
+	if mmSetIncomplete.inspectFuncSetIncomplete != nil {
+		mmSetIncomplete.inspectFuncSetIncomplete(incomplete)
 	}
 
-	mm_params := &MatcherMockSetBlueScoreParams{score}
+	mm_params := &MatcherMockSetIncompleteParams{incomplete}
 
 	// Record call args
-	mmSetBlueScore.SetBlueScoreMock.mutex.Lock()
-	mmSetBlueScore.SetBlueScoreMock.callArgs = append(mmSetBlueScore.SetBlueScoreMock.callArgs, mm_params)
-	mmSetBlueScore.SetBlueScoreMock.mutex.Unlock()
+	mmSetIncomplete.SetIncompleteMock.mutex.Lock()
+	mmSetIncomplete.SetIncompleteMock.callArgs = append(mmSetIncomplete.SetIncompleteMock.callArgs, mm_params)
+	mmSetIncomplete.SetIncompleteMock.mutex.Unlock()
 
-	for _, e := range mmSetBlueScore.SetBlueScoreMock.expectations {
+	for _, e := range mmSetIncomplete.SetIncompleteMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return
 		}
 	}
 
-	if mmSetBlueScore.SetBlueScoreMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSetBlueScore.SetBlueScoreMock.defaultExpectation.Counter, 1)
-		mm_want := mmSetBlueScore.SetBlueScoreMock.defaultExpectation.params
-		mm_got := MatcherMockSetBlueScoreParams{score}
+	if mmSetIncomplete.SetIncompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetIncomplete.SetIncompleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetIncomplete.SetIncompleteMock.defaultExpectation.params
+		mm_got := MatcherMockSetIncompleteParams{incomplete}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSetBlueScore.t.Errorf("MatcherMock.SetBlueScore got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetIncomplete.t.Errorf("MatcherMock.SetIncomplete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
 		return
 
 	}
-	if mmSetBlueScore.funcSetBlueScore != nil {
-		mmSetBlueScore.funcSetBlueScore(score)
+	if mmSetIncomplete.funcSetIncomplete != nil {
+		mmSetIncomplete.funcSetIncomplete(incomplete)
 		return
 	}
-	mmSetBlueScore.t.Fatalf("Unexpected call to MatcherMock.SetBlueScore. %v", score)
+	mmSetIncomplete.t.Fatalf("Unexpected call to MatcherMock.SetIncomplete. %v", incomplete)
 
 }
 
-// SetBlueScoreAfterCounter returns a count of finished MatcherMock.SetBlueScore invocations
-func (mmSetBlueScore *MatcherMock) SetBlueScoreAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetBlueScore.afterSetBlueScoreCounter)
+// SetIncompleteAfterCounter returns a count of finished MatcherMock.SetIncomplete invocations
+func (mmSetIncomplete *MatcherMock) SetIncompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetIncomplete.afterSetIncompleteCounter)
 }
 
-// SetBlueScoreBeforeCounter returns a count of MatcherMock.SetBlueScore invocations
-func (mmSetBlueScore *MatcherMock) SetBlueScoreBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetBlueScore.beforeSetBlueScoreCounter)
+// SetIncompleteBeforeCounter returns a count of MatcherMock.SetIncomplete invocations
+func (mmSetIncomplete *MatcherMock) SetIncompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetIncomplete.beforeSetIncompleteCounter)
 }
 
-// Calls returns a list of arguments used in each call to MatcherMock.SetBlueScore.
+// Calls returns a list of arguments used in each call to MatcherMock.SetIncomplete.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSetBlueScore *mMatcherMockSetBlueScore) Calls() []*MatcherMockSetBlueScoreParams {
-	mmSetBlueScore.mutex.RLock()
+func (mmSetIncomplete *mMatcherMockSetIncomplete) Calls() []*MatcherMockSetIncompleteParams {
+	mmSetIncomplete.mutex.RLock()
 
-	argCopy := make([]*MatcherMockSetBlueScoreParams, len(mmSetBlueScore.callArgs))
-	copy(argCopy, mmSetBlueScore.callArgs)
+	argCopy := make([]*MatcherMockSetIncompleteParams, len(mmSetIncomplete.callArgs))
+	copy(argCopy, mmSetIncomplete.callArgs)
 
-	mmSetBlueScore.mutex.RUnlock()
+	mmSetIncomplete.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSetBlueScoreDone returns true if the count of the SetBlueScore invocations corresponds
+// MinimockSetIncompleteDone returns true if the count of the SetIncomplete invocations corresponds
 // the number of defined expectations
-func (m *MatcherMock) MinimockSetBlueScoreDone() bool {
-	for _, e := range m.SetBlueScoreMock.expectations {
+func (m *MatcherMock) MinimockSetIncompleteDone() bool {
+	for _, e := range m.SetIncompleteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetBlueScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+	if m.SetIncompleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetIncompleteCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetBlueScore != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
+	if m.funcSetIncomplete != nil && mm_atomic.LoadUint64(&m.afterSetIncompleteCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSetBlueScoreInspect logs each unmet expectation
-func (m *MatcherMock) MinimockSetBlueScoreInspect() {
-	for _, e := range m.SetBlueScoreMock.expectations {
+// MinimockSetIncompleteInspect logs each unmet expectation
+func (m *MatcherMock) MinimockSetIncompleteInspect() {
+	for _, e := range m.SetIncompleteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to MatcherMock.SetBlueScore with params: %#v", *e.params)
+			m.t.Errorf("Expected call to MatcherMock.SetIncomplete with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetBlueScoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
-		if m.SetBlueScoreMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to MatcherMock.SetBlueScore")
+	if m.SetIncompleteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetIncompleteCounter) < 1 {
+		if m.SetIncompleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MatcherMock.SetIncomplete")
 		} else {
-			m.t.Errorf("Expected call to MatcherMock.SetBlueScore with params: %#v", *m.SetBlueScoreMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to MatcherMock.SetIncomplete with params: %#v", *m.SetIncompleteMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetBlueScore != nil && mm_atomic.LoadUint64(&m.afterSetBlueScoreCounter) < 1 {
-		m.t.Error("Expected call to MatcherMock.SetBlueScore")
+	if m.funcSetIncomplete != nil && mm_atomic.LoadUint64(&m.afterSetIncompleteCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.SetIncomplete")
 	}
 }
 
@@ -1540,6 +3159,194 @@ func (m *MatcherMock) MinimockSetLengthInspect() {
 	}
 }
 
+type mMatcherMockSetLog struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockSetLogExpectation
+	expectations       []*MatcherMockSetLogExpectation
+
+	callArgs []*MatcherMockSetLogParams
+	mutex    sync.RWMutex
+}
+
+// MatcherMockSetLogExpectation specifies expectation struct of the Matcher.SetLog
+type MatcherMockSetLogExpectation struct {
+	mock   *MatcherMock
+	params *MatcherMockSetLogParams
+
+	Counter uint64
+}
+
+// MatcherMockSetLogParams contains parameters of the Matcher.SetLog
+type MatcherMockSetLogParams struct {
+	id  int
+	url string
+}
+
+// Expect sets up expected params for Matcher.SetLog
+func (mmSetLog *mMatcherMockSetLog) Expect(id int, url string) *mMatcherMockSetLog {
+	if mmSetLog.mock.funcSetLog != nil {
+		mmSetLog.mock.t.Fatalf("MatcherMock.SetLog mock is already set by Set")
+	}
+
+	if mmSetLog.defaultExpectation == nil {
+		mmSetLog.defaultExpectation = &MatcherMockSetLogExpectation{}
+	}
+
+	mmSetLog.defaultExpectation.params = &MatcherMockSetLogParams{id, url}
+	for _, e := range mmSetLog.expectations {
+		if minimock.Equal(e.params, mmSetLog.defaultExpectation.params) {
+			mmSetLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetLog.defaultExpectation.params)
+		}
+	}
+
+	return mmSetLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.SetLog
+func (mmSetLog *mMatcherMockSetLog) Inspect(f func(id int, url string)) *mMatcherMockSetLog {
+	if mmSetLog.mock.inspectFuncSetLog != nil {
+		mmSetLog.mock.t.Fatalf("Inspect function is already set for MatcherMock.SetLog")
+	}
+
+	mmSetLog.mock.inspectFuncSetLog = f
+
+	return mmSetLog
+}
+
+// Return sets up results that will be returned by Matcher.SetLog
+func (mmSetLog *mMatcherMockSetLog) Return() *MatcherMock {
+	if mmSetLog.mock.funcSetLog != nil {
+		mmSetLog.mock.t.Fatalf("MatcherMock.SetLog mock is already set by Set")
+	}
+
+	if mmSetLog.defaultExpectation == nil {
+		mmSetLog.defaultExpectation = &MatcherMockSetLogExpectation{mock: mmSetLog.mock}
+	}
+
+	return mmSetLog.mock
+}
+
+//Set uses given function f to mock the Matcher.SetLog method
+func (mmSetLog *mMatcherMockSetLog) Set(f func(id int, url string)) *MatcherMock {
+	if mmSetLog.defaultExpectation != nil {
+		mmSetLog.mock.t.Fatalf("Default expectation is already set for the Matcher.SetLog method")
+	}
+
+	if len(mmSetLog.expectations) > 0 {
+		mmSetLog.mock.t.Fatalf("Some expectations are already set for the Matcher.SetLog method")
+	}
+
+	mmSetLog.mock.funcSetLog = f
+	return mmSetLog.mock
+}
+
+// SetLog implements stats.Matcher
+func (mmSetLog *MatcherMock) SetLog(id int, url string) {
+	mm_atomic.AddUint64(&mmSetLog.beforeSetLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetLog.afterSetLogCounter, 1)
+
+	if mmSetLog.inspectFuncSetLog != nil {
+		mmSetLog.inspectFuncSetLog(id, url)
+	}
+
+	mm_params := &MatcherMockSetLogParams{id, url}
+
+	// Record call args
+	mmSetLog.SetLogMock.mutex.Lock()
+	mmSetLog.SetLogMock.callArgs = append(mmSetLog.SetLogMock.callArgs, mm_params)
+	mmSetLog.SetLogMock.mutex.Unlock()
+
+	for _, e := range mmSetLog.SetLogMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetLog.SetLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetLog.SetLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetLog.SetLogMock.defaultExpectation.params
+		mm_got := MatcherMockSetLogParams{id, url}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetLog.t.Errorf("MatcherMock.SetLog got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetLog.funcSetLog != nil {
+		mmSetLog.funcSetLog(id, url)
+		return
+	}
+	mmSetLog.t.Fatalf("Unexpected call to MatcherMock.SetLog. %v %v", id, url)
+
+}
+
+// SetLogAfterCounter returns a count of finished MatcherMock.SetLog invocations
+func (mmSetLog *MatcherMock) SetLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetLog.afterSetLogCounter)
+}
+
+// SetLogBeforeCounter returns a count of MatcherMock.SetLog invocations
+func (mmSetLog *MatcherMock) SetLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetLog.beforeSetLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to MatcherMock.SetLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetLog *mMatcherMockSetLog) Calls() []*MatcherMockSetLogParams {
+	mmSetLog.mutex.RLock()
+
+	argCopy := make([]*MatcherMockSetLogParams, len(mmSetLog.callArgs))
+	copy(argCopy, mmSetLog.callArgs)
+
+	mmSetLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetLogDone returns true if the count of the SetLog invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockSetLogDone() bool {
+	for _, e := range m.SetLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetLogMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetLogCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetLog != nil && mm_atomic.LoadUint64(&m.afterSetLogCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetLogInspect logs each unmet expectation
+func (m *MatcherMock) MinimockSetLogInspect() {
+	for _, e := range m.SetLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MatcherMock.SetLog with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetLogMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetLogCounter) < 1 {
+		if m.SetLogMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MatcherMock.SetLog")
+		} else {
+			m.t.Errorf("Expected call to MatcherMock.SetLog with params: %#v", *m.SetLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetLog != nil && mm_atomic.LoadUint64(&m.afterSetLogCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.SetLog")
+	}
+}
+
 type mMatcherMockSetMap struct {
 	mock               *MatcherMock
 	defaultExpectation *MatcherMockSetMapExpectation
@@ -2475,6 +4282,193 @@ func (m *MatcherMock) MinimockSetRedScoreInspect() {
 	}
 }
 
+type mMatcherMockSetRoundLength struct {
+	mock               *MatcherMock
+	defaultExpectation *MatcherMockSetRoundLengthExpectation
+	expectations       []*MatcherMockSetRoundLengthExpectation
+
+	callArgs []*MatcherMockSetRoundLengthParams
+	mutex    sync.RWMutex
+}
+
+// MatcherMockSetRoundLengthExpectation specifies expectation struct of the Matcher.SetRoundLength
+type MatcherMockSetRoundLengthExpectation struct {
+	mock   *MatcherMock
+	params *MatcherMockSetRoundLengthParams
+
+	Counter uint64
+}
+
+// MatcherMockSetRoundLengthParams contains parameters of the Matcher.SetRoundLength
+type MatcherMockSetRoundLengthParams struct {
+	msg string
+}
+
+// Expect sets up expected params for Matcher.SetRoundLength
+func (mmSetRoundLength *mMatcherMockSetRoundLength) Expect(msg string) *mMatcherMockSetRoundLength {
+	if mmSetRoundLength.mock.funcSetRoundLength != nil {
+		mmSetRoundLength.mock.t.Fatalf("MatcherMock.SetRoundLength mock is already set by Set")
+	}
+
+	if mmSetRoundLength.defaultExpectation == nil {
+		mmSetRoundLength.defaultExpectation = &MatcherMockSetRoundLengthExpectation{}
+	}
+
+	mmSetRoundLength.defaultExpectation.params = &MatcherMockSetRoundLengthParams{msg}
+	for _, e := range mmSetRoundLength.expectations {
+		if minimock.Equal(e.params, mmSetRoundLength.defaultExpectation.params) {
+			mmSetRoundLength.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRoundLength.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRoundLength
+}
+
+// Inspect accepts an inspector function that has same arguments as the Matcher.SetRoundLength
+func (mmSetRoundLength *mMatcherMockSetRoundLength) Inspect(f func(msg string)) *mMatcherMockSetRoundLength {
+	if mmSetRoundLength.mock.inspectFuncSetRoundLength != nil {
+		mmSetRoundLength.mock.t.Fatalf("Inspect function is already set for MatcherMock.SetRoundLength")
+	}
+
+	mmSetRoundLength.mock.inspectFuncSetRoundLength = f
+
+	return mmSetRoundLength
+}
+
+// Return sets up results that will be returned by Matcher.SetRoundLength
+func (mmSetRoundLength *mMatcherMockSetRoundLength) Return() *MatcherMock {
+	if mmSetRoundLength.mock.funcSetRoundLength != nil {
+		mmSetRoundLength.mock.t.Fatalf("MatcherMock.SetRoundLength mock is already set by Set")
+	}
+
+	if mmSetRoundLength.defaultExpectation == nil {
+		mmSetRoundLength.defaultExpectation = &MatcherMockSetRoundLengthExpectation{mock: mmSetRoundLength.mock}
+	}
+
+	return mmSetRoundLength.mock
+}
+
+//Set uses given function f to mock the Matcher.SetRoundLength method
+func (mmSetRoundLength *mMatcherMockSetRoundLength) Set(f func(msg string)) *MatcherMock {
+	if mmSetRoundLength.defaultExpectation != nil {
+		mmSetRoundLength.mock.t.Fatalf("Default expectation is already set for the Matcher.SetRoundLength method")
+	}
+
+	if len(mmSetRoundLength.expectations) > 0 {
+		mmSetRoundLength.mock.t.Fatalf("Some expectations are already set for the Matcher.SetRoundLength method")
+	}
+
+	mmSetRoundLength.mock.funcSetRoundLength = f
+	return mmSetRoundLength.mock
+}
+
+// SetRoundLength implements stats.Matcher
+func (mmSetRoundLength *MatcherMock) SetRoundLength(msg string) {
+	mm_atomic.AddUint64(&mmSetRoundLength.beforeSetRoundLengthCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRoundLength.afterSetRoundLengthCounter, 1)
+
+	if mmSetRoundLength.inspectFuncSetRoundLength != nil {
+		mmSetRoundLength.inspectFuncSetRoundLength(msg)
+	}
+
+	mm_params := &MatcherMockSetRoundLengthParams{msg}
+
+	// Record call args
+	mmSetRoundLength.SetRoundLengthMock.mutex.Lock()
+	mmSetRoundLength.SetRoundLengthMock.callArgs = append(mmSetRoundLength.SetRoundLengthMock.callArgs, mm_params)
+	mmSetRoundLength.SetRoundLengthMock.mutex.Unlock()
+
+	for _, e := range mmSetRoundLength.SetRoundLengthMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetRoundLength.SetRoundLengthMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRoundLength.SetRoundLengthMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRoundLength.SetRoundLengthMock.defaultExpectation.params
+		mm_got := MatcherMockSetRoundLengthParams{msg}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRoundLength.t.Errorf("MatcherMock.SetRoundLength got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetRoundLength.funcSetRoundLength != nil {
+		mmSetRoundLength.funcSetRoundLength(msg)
+		return
+	}
+	mmSetRoundLength.t.Fatalf("Unexpected call to MatcherMock.SetRoundLength. %v", msg)
+
+}
+
+// SetRoundLengthAfterCounter returns a count of finished MatcherMock.SetRoundLength invocations
+func (mmSetRoundLength *MatcherMock) SetRoundLengthAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoundLength.afterSetRoundLengthCounter)
+}
+
+// SetRoundLengthBeforeCounter returns a count of MatcherMock.SetRoundLength invocations
+func (mmSetRoundLength *MatcherMock) SetRoundLengthBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRoundLength.beforeSetRoundLengthCounter)
+}
+
+// Calls returns a list of arguments used in each call to MatcherMock.SetRoundLength.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRoundLength *mMatcherMockSetRoundLength) Calls() []*MatcherMockSetRoundLengthParams {
+	mmSetRoundLength.mutex.RLock()
+
+	argCopy := make([]*MatcherMockSetRoundLengthParams, len(mmSetRoundLength.callArgs))
+	copy(argCopy, mmSetRoundLength.callArgs)
+
+	mmSetRoundLength.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRoundLengthDone returns true if the count of the SetRoundLength invocations corresponds
+// the number of defined expectations
+func (m *MatcherMock) MinimockSetRoundLengthDone() bool {
+	for _, e := range m.SetRoundLengthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRoundLengthMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRoundLengthCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoundLength != nil && mm_atomic.LoadUint64(&m.afterSetRoundLengthCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetRoundLengthInspect logs each unmet expectation
+func (m *MatcherMock) MinimockSetRoundLengthInspect() {
+	for _, e := range m.SetRoundLengthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MatcherMock.SetRoundLength with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRoundLengthMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetRoundLengthCounter) < 1 {
+		if m.SetRoundLengthMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MatcherMock.SetRoundLength")
+		} else {
+			m.t.Errorf("Expected call to MatcherMock.SetRoundLength with params: %#v", *m.SetRoundLengthMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRoundLength != nil && mm_atomic.LoadUint64(&m.afterSetRoundLengthCounter) < 1 {
+		m.t.Error("Expected call to MatcherMock.SetRoundLength")
+	}
+}
+
 type mMatcherMockSetStartTime struct {
 	mock               *MatcherMock
 	defaultExpectation *MatcherMockSetStartTimeExpectation
@@ -2995,12 +4989,24 @@ func (m *MatcherMock) MinimockTryParseGameMapInspect() {
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *MatcherMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockAddRoundInspect()
+
 		m.MinimockDomainInspect()
 
+		m.MinimockEndedAtInspect()
+
 		m.MinimockFlushInspect()
 
+		m.MinimockIncompleteInspect()
+
+		m.MinimockLaunchedAtInspect()
+
 		m.MinimockLengthSecondsInspect()
 
+		m.MinimockLogIDInspect()
+
+		m.MinimockLogURLInspect()
+
 		m.MinimockMapInspect()
 
 		m.MinimockPickupIDInspect()
@@ -3009,10 +5015,20 @@ func (m *MatcherMock) MinimockFinish() {
 
 		m.MinimockPlayerStatsInspect()
 
+		m.MinimockRoundsInspect()
+
+		m.MinimockScoreInspect()
+
+		m.MinimockServerIDInspect()
+
 		m.MinimockSetBlueScoreInspect()
 
+		m.MinimockSetIncompleteInspect()
+
 		m.MinimockSetLengthInspect()
 
+		m.MinimockSetLogInspect()
+
 		m.MinimockSetMapInspect()
 
 		m.MinimockSetPickupIDInspect()
@@ -3023,6 +5039,8 @@ func (m *MatcherMock) MinimockFinish() {
 
 		m.MinimockSetRedScoreInspect()
 
+		m.MinimockSetRoundLengthInspect()
+
 		m.MinimockSetStartTimeInspect()
 
 		m.MinimockStringInspect()
@@ -3051,20 +5069,32 @@ func (m *MatcherMock) MinimockWait(timeout mm_time.Duration) {
 func (m *MatcherMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddRoundDone() &&
 		m.MinimockDomainDone() &&
+		m.MinimockEndedAtDone() &&
 		m.MinimockFlushDone() &&
+		m.MinimockIncompleteDone() &&
+		m.MinimockLaunchedAtDone() &&
 		m.MinimockLengthSecondsDone() &&
+		m.MinimockLogIDDone() &&
+		m.MinimockLogURLDone() &&
 		m.MinimockMapDone() &&
 		m.MinimockPickupIDDone() &&
 		m.MinimockPickupPlayersDone() &&
 		m.MinimockPlayerStatsDone() &&
+		m.MinimockRoundsDone() &&
+		m.MinimockScoreDone() &&
+		m.MinimockServerIDDone() &&
 		m.MinimockSetBlueScoreDone() &&
+		m.MinimockSetIncompleteDone() &&
 		m.MinimockSetLengthDone() &&
+		m.MinimockSetLogDone() &&
 		m.MinimockSetMapDone() &&
 		m.MinimockSetPickupIDDone() &&
 		m.MinimockSetPlayerStatsDone() &&
 		m.MinimockSetPlayersDone() &&
 		m.MinimockSetRedScoreDone() &&
+		m.MinimockSetRoundLengthDone() &&
 		m.MinimockSetStartTimeDone() &&
 		m.MinimockStringDone() &&
 		m.MinimockTryParseGameMapDone()
@@ -0,0 +1,1279 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/requests.PickupProvider -o ./pkg/mocks/pickup_provider_mock.go
+
+import (
+	mm_requests "LogWatcher/pkg/requests"
+	"LogWatcher/pkg/stats"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PickupProviderMock implements requests.PickupProvider
+type PickupProviderMock struct {
+	t minimock.Tester
+
+	funcCheckAPI          func(ctx context.Context) (err error)
+	inspectFuncCheckAPI   func(ctx context.Context)
+	afterCheckAPICounter  uint64
+	beforeCheckAPICounter uint64
+	CheckAPIMock          mPickupProviderMockCheckAPI
+
+	funcFindMatchingPickup          func(ctx context.Context, gameMap string) (pp1 *mm_requests.Pickup, err error)
+	inspectFuncFindMatchingPickup   func(ctx context.Context, gameMap string)
+	afterFindMatchingPickupCounter  uint64
+	beforeFindMatchingPickupCounter uint64
+	FindMatchingPickupMock          mPickupProviderMockFindMatchingPickup
+
+	funcReportLogsURL          func(ctx context.Context, pickupID int, logsURL string) (err error)
+	inspectFuncReportLogsURL   func(ctx context.Context, pickupID int, logsURL string)
+	afterReportLogsURLCounter  uint64
+	beforeReportLogsURLCounter uint64
+	ReportLogsURLMock          mPickupProviderMockReportLogsURL
+
+	funcResolvePlayers          func(ctx context.Context, players []*stats.PickupPlayer) (err error)
+	inspectFuncResolvePlayers   func(ctx context.Context, players []*stats.PickupPlayer)
+	afterResolvePlayersCounter  uint64
+	beforeResolvePlayersCounter uint64
+	ResolvePlayersMock          mPickupProviderMockResolvePlayers
+
+	funcSite          func() (s1 string)
+	inspectFuncSite   func()
+	afterSiteCounter  uint64
+	beforeSiteCounter uint64
+	SiteMock          mPickupProviderMockSite
+
+	funcString          func() (s1 string)
+	inspectFuncString   func()
+	afterStringCounter  uint64
+	beforeStringCounter uint64
+	StringMock          mPickupProviderMockString
+}
+
+// NewPickupProviderMock returns a mock for requests.PickupProvider
+func NewPickupProviderMock(t minimock.Tester) *PickupProviderMock {
+	m := &PickupProviderMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckAPIMock = mPickupProviderMockCheckAPI{mock: m}
+	m.CheckAPIMock.callArgs = []*PickupProviderMockCheckAPIParams{}
+
+	m.FindMatchingPickupMock = mPickupProviderMockFindMatchingPickup{mock: m}
+	m.FindMatchingPickupMock.callArgs = []*PickupProviderMockFindMatchingPickupParams{}
+
+	m.ReportLogsURLMock = mPickupProviderMockReportLogsURL{mock: m}
+	m.ReportLogsURLMock.callArgs = []*PickupProviderMockReportLogsURLParams{}
+
+	m.ResolvePlayersMock = mPickupProviderMockResolvePlayers{mock: m}
+	m.ResolvePlayersMock.callArgs = []*PickupProviderMockResolvePlayersParams{}
+
+	m.SiteMock = mPickupProviderMockSite{mock: m}
+
+	m.StringMock = mPickupProviderMockString{mock: m}
+
+	return m
+}
+
+type mPickupProviderMockCheckAPI struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockCheckAPIExpectation
+	expectations       []*PickupProviderMockCheckAPIExpectation
+
+	callArgs []*PickupProviderMockCheckAPIParams
+	mutex    sync.RWMutex
+}
+
+// PickupProviderMockCheckAPIExpectation specifies expectation struct of the PickupProvider.CheckAPI
+type PickupProviderMockCheckAPIExpectation struct {
+	mock    *PickupProviderMock
+	params  *PickupProviderMockCheckAPIParams
+	results *PickupProviderMockCheckAPIResults
+	Counter uint64
+}
+
+// PickupProviderMockCheckAPIParams contains parameters of the PickupProvider.CheckAPI
+type PickupProviderMockCheckAPIParams struct {
+	ctx context.Context
+}
+
+// PickupProviderMockCheckAPIResults contains results of the PickupProvider.CheckAPI
+type PickupProviderMockCheckAPIResults struct {
+	err error
+}
+
+// Expect sets up expected params for PickupProvider.CheckAPI
+func (mmCheckAPI *mPickupProviderMockCheckAPI) Expect(ctx context.Context) *mPickupProviderMockCheckAPI {
+	if mmCheckAPI.mock.funcCheckAPI != nil {
+		mmCheckAPI.mock.t.Fatalf("PickupProviderMock.CheckAPI mock is already set by Set")
+	}
+
+	if mmCheckAPI.defaultExpectation == nil {
+		mmCheckAPI.defaultExpectation = &PickupProviderMockCheckAPIExpectation{}
+	}
+
+	mmCheckAPI.defaultExpectation.params = &PickupProviderMockCheckAPIParams{ctx}
+	for _, e := range mmCheckAPI.expectations {
+		if minimock.Equal(e.params, mmCheckAPI.defaultExpectation.params) {
+			mmCheckAPI.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAPI.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckAPI
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.CheckAPI
+func (mmCheckAPI *mPickupProviderMockCheckAPI) Inspect(f func(ctx context.Context)) *mPickupProviderMockCheckAPI {
+	if mmCheckAPI.mock.inspectFuncCheckAPI != nil {
+		mmCheckAPI.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.CheckAPI")
+	}
+
+	mmCheckAPI.mock.inspectFuncCheckAPI = f
+
+	return mmCheckAPI
+}
+
+// Return sets up results that will be returned by PickupProvider.CheckAPI
+func (mmCheckAPI *mPickupProviderMockCheckAPI) Return(err error) *PickupProviderMock {
+	if mmCheckAPI.mock.funcCheckAPI != nil {
+		mmCheckAPI.mock.t.Fatalf("PickupProviderMock.CheckAPI mock is already set by Set")
+	}
+
+	if mmCheckAPI.defaultExpectation == nil {
+		mmCheckAPI.defaultExpectation = &PickupProviderMockCheckAPIExpectation{mock: mmCheckAPI.mock}
+	}
+	mmCheckAPI.defaultExpectation.results = &PickupProviderMockCheckAPIResults{err}
+	return mmCheckAPI.mock
+}
+
+//Set uses given function f to mock the PickupProvider.CheckAPI method
+func (mmCheckAPI *mPickupProviderMockCheckAPI) Set(f func(ctx context.Context) (err error)) *PickupProviderMock {
+	if mmCheckAPI.defaultExpectation != nil {
+		mmCheckAPI.mock.t.Fatalf("Default expectation is already set for the PickupProvider.CheckAPI method")
+	}
+
+	if len(mmCheckAPI.expectations) > 0 {
+		mmCheckAPI.mock.t.Fatalf("Some expectations are already set for the PickupProvider.CheckAPI method")
+	}
+
+	mmCheckAPI.mock.funcCheckAPI = f
+	return mmCheckAPI.mock
+}
+
+// When sets expectation for the PickupProvider.CheckAPI which will trigger the result defined by the following
+// Then helper
+func (mmCheckAPI *mPickupProviderMockCheckAPI) When(ctx context.Context) *PickupProviderMockCheckAPIExpectation {
+	if mmCheckAPI.mock.funcCheckAPI != nil {
+		mmCheckAPI.mock.t.Fatalf("PickupProviderMock.CheckAPI mock is already set by Set")
+	}
+
+	expectation := &PickupProviderMockCheckAPIExpectation{
+		mock:   mmCheckAPI.mock,
+		params: &PickupProviderMockCheckAPIParams{ctx},
+	}
+	mmCheckAPI.expectations = append(mmCheckAPI.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PickupProvider.CheckAPI return parameters for the expectation previously defined by the When method
+func (e *PickupProviderMockCheckAPIExpectation) Then(err error) *PickupProviderMock {
+	e.results = &PickupProviderMockCheckAPIResults{err}
+	return e.mock
+}
+
+// CheckAPI implements requests.PickupProvider
+func (mmCheckAPI *PickupProviderMock) CheckAPI(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCheckAPI.beforeCheckAPICounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAPI.afterCheckAPICounter, 1)
+
+	if mmCheckAPI.inspectFuncCheckAPI != nil {
+		mmCheckAPI.inspectFuncCheckAPI(ctx)
+	}
+
+	mm_params := &PickupProviderMockCheckAPIParams{ctx}
+
+	// Record call args
+	mmCheckAPI.CheckAPIMock.mutex.Lock()
+	mmCheckAPI.CheckAPIMock.callArgs = append(mmCheckAPI.CheckAPIMock.callArgs, mm_params)
+	mmCheckAPI.CheckAPIMock.mutex.Unlock()
+
+	for _, e := range mmCheckAPI.CheckAPIMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckAPI.CheckAPIMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAPI.CheckAPIMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAPI.CheckAPIMock.defaultExpectation.params
+		mm_got := PickupProviderMockCheckAPIParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAPI.t.Errorf("PickupProviderMock.CheckAPI got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAPI.CheckAPIMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAPI.t.Fatal("No results are set for the PickupProviderMock.CheckAPI")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckAPI.funcCheckAPI != nil {
+		return mmCheckAPI.funcCheckAPI(ctx)
+	}
+	mmCheckAPI.t.Fatalf("Unexpected call to PickupProviderMock.CheckAPI. %v", ctx)
+	return
+}
+
+// CheckAPIAfterCounter returns a count of finished PickupProviderMock.CheckAPI invocations
+func (mmCheckAPI *PickupProviderMock) CheckAPIAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAPI.afterCheckAPICounter)
+}
+
+// CheckAPIBeforeCounter returns a count of PickupProviderMock.CheckAPI invocations
+func (mmCheckAPI *PickupProviderMock) CheckAPIBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAPI.beforeCheckAPICounter)
+}
+
+// Calls returns a list of arguments used in each call to PickupProviderMock.CheckAPI.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAPI *mPickupProviderMockCheckAPI) Calls() []*PickupProviderMockCheckAPIParams {
+	mmCheckAPI.mutex.RLock()
+
+	argCopy := make([]*PickupProviderMockCheckAPIParams, len(mmCheckAPI.callArgs))
+	copy(argCopy, mmCheckAPI.callArgs)
+
+	mmCheckAPI.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAPIDone returns true if the count of the CheckAPI invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockCheckAPIDone() bool {
+	for _, e := range m.CheckAPIMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAPIMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckAPICounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAPI != nil && mm_atomic.LoadUint64(&m.afterCheckAPICounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckAPIInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockCheckAPIInspect() {
+	for _, e := range m.CheckAPIMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PickupProviderMock.CheckAPI with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAPIMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckAPICounter) < 1 {
+		if m.CheckAPIMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PickupProviderMock.CheckAPI")
+		} else {
+			m.t.Errorf("Expected call to PickupProviderMock.CheckAPI with params: %#v", *m.CheckAPIMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAPI != nil && mm_atomic.LoadUint64(&m.afterCheckAPICounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.CheckAPI")
+	}
+}
+
+type mPickupProviderMockFindMatchingPickup struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockFindMatchingPickupExpectation
+	expectations       []*PickupProviderMockFindMatchingPickupExpectation
+
+	callArgs []*PickupProviderMockFindMatchingPickupParams
+	mutex    sync.RWMutex
+}
+
+// PickupProviderMockFindMatchingPickupExpectation specifies expectation struct of the PickupProvider.FindMatchingPickup
+type PickupProviderMockFindMatchingPickupExpectation struct {
+	mock    *PickupProviderMock
+	params  *PickupProviderMockFindMatchingPickupParams
+	results *PickupProviderMockFindMatchingPickupResults
+	Counter uint64
+}
+
+// PickupProviderMockFindMatchingPickupParams contains parameters of the PickupProvider.FindMatchingPickup
+type PickupProviderMockFindMatchingPickupParams struct {
+	ctx     context.Context
+	gameMap string
+}
+
+// PickupProviderMockFindMatchingPickupResults contains results of the PickupProvider.FindMatchingPickup
+type PickupProviderMockFindMatchingPickupResults struct {
+	pp1 *mm_requests.Pickup
+	err error
+}
+
+// Expect sets up expected params for PickupProvider.FindMatchingPickup
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) Expect(ctx context.Context, gameMap string) *mPickupProviderMockFindMatchingPickup {
+	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
+		mmFindMatchingPickup.mock.t.Fatalf("PickupProviderMock.FindMatchingPickup mock is already set by Set")
+	}
+
+	if mmFindMatchingPickup.defaultExpectation == nil {
+		mmFindMatchingPickup.defaultExpectation = &PickupProviderMockFindMatchingPickupExpectation{}
+	}
+
+	mmFindMatchingPickup.defaultExpectation.params = &PickupProviderMockFindMatchingPickupParams{ctx, gameMap}
+	for _, e := range mmFindMatchingPickup.expectations {
+		if minimock.Equal(e.params, mmFindMatchingPickup.defaultExpectation.params) {
+			mmFindMatchingPickup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindMatchingPickup.defaultExpectation.params)
+		}
+	}
+
+	return mmFindMatchingPickup
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.FindMatchingPickup
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) Inspect(f func(ctx context.Context, gameMap string)) *mPickupProviderMockFindMatchingPickup {
+	if mmFindMatchingPickup.mock.inspectFuncFindMatchingPickup != nil {
+		mmFindMatchingPickup.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.FindMatchingPickup")
+	}
+
+	mmFindMatchingPickup.mock.inspectFuncFindMatchingPickup = f
+
+	return mmFindMatchingPickup
+}
+
+// Return sets up results that will be returned by PickupProvider.FindMatchingPickup
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) Return(pp1 *mm_requests.Pickup, err error) *PickupProviderMock {
+	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
+		mmFindMatchingPickup.mock.t.Fatalf("PickupProviderMock.FindMatchingPickup mock is already set by Set")
+	}
+
+	if mmFindMatchingPickup.defaultExpectation == nil {
+		mmFindMatchingPickup.defaultExpectation = &PickupProviderMockFindMatchingPickupExpectation{mock: mmFindMatchingPickup.mock}
+	}
+	mmFindMatchingPickup.defaultExpectation.results = &PickupProviderMockFindMatchingPickupResults{pp1, err}
+	return mmFindMatchingPickup.mock
+}
+
+//Set uses given function f to mock the PickupProvider.FindMatchingPickup method
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) Set(f func(ctx context.Context, gameMap string) (pp1 *mm_requests.Pickup, err error)) *PickupProviderMock {
+	if mmFindMatchingPickup.defaultExpectation != nil {
+		mmFindMatchingPickup.mock.t.Fatalf("Default expectation is already set for the PickupProvider.FindMatchingPickup method")
+	}
+
+	if len(mmFindMatchingPickup.expectations) > 0 {
+		mmFindMatchingPickup.mock.t.Fatalf("Some expectations are already set for the PickupProvider.FindMatchingPickup method")
+	}
+
+	mmFindMatchingPickup.mock.funcFindMatchingPickup = f
+	return mmFindMatchingPickup.mock
+}
+
+// When sets expectation for the PickupProvider.FindMatchingPickup which will trigger the result defined by the following
+// Then helper
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) When(ctx context.Context, gameMap string) *PickupProviderMockFindMatchingPickupExpectation {
+	if mmFindMatchingPickup.mock.funcFindMatchingPickup != nil {
+		mmFindMatchingPickup.mock.t.Fatalf("PickupProviderMock.FindMatchingPickup mock is already set by Set")
+	}
+
+	expectation := &PickupProviderMockFindMatchingPickupExpectation{
+		mock:   mmFindMatchingPickup.mock,
+		params: &PickupProviderMockFindMatchingPickupParams{ctx, gameMap},
+	}
+	mmFindMatchingPickup.expectations = append(mmFindMatchingPickup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PickupProvider.FindMatchingPickup return parameters for the expectation previously defined by the When method
+func (e *PickupProviderMockFindMatchingPickupExpectation) Then(pp1 *mm_requests.Pickup, err error) *PickupProviderMock {
+	e.results = &PickupProviderMockFindMatchingPickupResults{pp1, err}
+	return e.mock
+}
+
+// FindMatchingPickup implements requests.PickupProvider
+func (mmFindMatchingPickup *PickupProviderMock) FindMatchingPickup(ctx context.Context, gameMap string) (pp1 *mm_requests.Pickup, err error) {
+	mm_atomic.AddUint64(&mmFindMatchingPickup.beforeFindMatchingPickupCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindMatchingPickup.afterFindMatchingPickupCounter, 1)
+
+	if mmFindMatchingPickup.inspectFuncFindMatchingPickup != nil {
+		mmFindMatchingPickup.inspectFuncFindMatchingPickup(ctx, gameMap)
+	}
+
+	mm_params := &PickupProviderMockFindMatchingPickupParams{ctx, gameMap}
+
+	// Record call args
+	mmFindMatchingPickup.FindMatchingPickupMock.mutex.Lock()
+	mmFindMatchingPickup.FindMatchingPickupMock.callArgs = append(mmFindMatchingPickup.FindMatchingPickupMock.callArgs, mm_params)
+	mmFindMatchingPickup.FindMatchingPickupMock.mutex.Unlock()
+
+	for _, e := range mmFindMatchingPickup.FindMatchingPickupMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.params
+		mm_got := PickupProviderMockFindMatchingPickupParams{ctx, gameMap}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindMatchingPickup.t.Errorf("PickupProviderMock.FindMatchingPickup got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindMatchingPickup.FindMatchingPickupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindMatchingPickup.t.Fatal("No results are set for the PickupProviderMock.FindMatchingPickup")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmFindMatchingPickup.funcFindMatchingPickup != nil {
+		return mmFindMatchingPickup.funcFindMatchingPickup(ctx, gameMap)
+	}
+	mmFindMatchingPickup.t.Fatalf("Unexpected call to PickupProviderMock.FindMatchingPickup. %v %v", ctx, gameMap)
+	return
+}
+
+// FindMatchingPickupAfterCounter returns a count of finished PickupProviderMock.FindMatchingPickup invocations
+func (mmFindMatchingPickup *PickupProviderMock) FindMatchingPickupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindMatchingPickup.afterFindMatchingPickupCounter)
+}
+
+// FindMatchingPickupBeforeCounter returns a count of PickupProviderMock.FindMatchingPickup invocations
+func (mmFindMatchingPickup *PickupProviderMock) FindMatchingPickupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindMatchingPickup.beforeFindMatchingPickupCounter)
+}
+
+// Calls returns a list of arguments used in each call to PickupProviderMock.FindMatchingPickup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindMatchingPickup *mPickupProviderMockFindMatchingPickup) Calls() []*PickupProviderMockFindMatchingPickupParams {
+	mmFindMatchingPickup.mutex.RLock()
+
+	argCopy := make([]*PickupProviderMockFindMatchingPickupParams, len(mmFindMatchingPickup.callArgs))
+	copy(argCopy, mmFindMatchingPickup.callArgs)
+
+	mmFindMatchingPickup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindMatchingPickupDone returns true if the count of the FindMatchingPickup invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockFindMatchingPickupDone() bool {
+	for _, e := range m.FindMatchingPickupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindMatchingPickupMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindMatchingPickup != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockFindMatchingPickupInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockFindMatchingPickupInspect() {
+	for _, e := range m.FindMatchingPickupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PickupProviderMock.FindMatchingPickup with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindMatchingPickupMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
+		if m.FindMatchingPickupMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PickupProviderMock.FindMatchingPickup")
+		} else {
+			m.t.Errorf("Expected call to PickupProviderMock.FindMatchingPickup with params: %#v", *m.FindMatchingPickupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindMatchingPickup != nil && mm_atomic.LoadUint64(&m.afterFindMatchingPickupCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.FindMatchingPickup")
+	}
+}
+
+type mPickupProviderMockReportLogsURL struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockReportLogsURLExpectation
+	expectations       []*PickupProviderMockReportLogsURLExpectation
+
+	callArgs []*PickupProviderMockReportLogsURLParams
+	mutex    sync.RWMutex
+}
+
+// PickupProviderMockReportLogsURLExpectation specifies expectation struct of the PickupProvider.ReportLogsURL
+type PickupProviderMockReportLogsURLExpectation struct {
+	mock    *PickupProviderMock
+	params  *PickupProviderMockReportLogsURLParams
+	results *PickupProviderMockReportLogsURLResults
+	Counter uint64
+}
+
+// PickupProviderMockReportLogsURLParams contains parameters of the PickupProvider.ReportLogsURL
+type PickupProviderMockReportLogsURLParams struct {
+	ctx      context.Context
+	pickupID int
+	logsURL  string
+}
+
+// PickupProviderMockReportLogsURLResults contains results of the PickupProvider.ReportLogsURL
+type PickupProviderMockReportLogsURLResults struct {
+	err error
+}
+
+// Expect sets up expected params for PickupProvider.ReportLogsURL
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) Expect(ctx context.Context, pickupID int, logsURL string) *mPickupProviderMockReportLogsURL {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("PickupProviderMock.ReportLogsURL mock is already set by Set")
+	}
+
+	if mmReportLogsURL.defaultExpectation == nil {
+		mmReportLogsURL.defaultExpectation = &PickupProviderMockReportLogsURLExpectation{}
+	}
+
+	mmReportLogsURL.defaultExpectation.params = &PickupProviderMockReportLogsURLParams{ctx, pickupID, logsURL}
+	for _, e := range mmReportLogsURL.expectations {
+		if minimock.Equal(e.params, mmReportLogsURL.defaultExpectation.params) {
+			mmReportLogsURL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReportLogsURL.defaultExpectation.params)
+		}
+	}
+
+	return mmReportLogsURL
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.ReportLogsURL
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) Inspect(f func(ctx context.Context, pickupID int, logsURL string)) *mPickupProviderMockReportLogsURL {
+	if mmReportLogsURL.mock.inspectFuncReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.ReportLogsURL")
+	}
+
+	mmReportLogsURL.mock.inspectFuncReportLogsURL = f
+
+	return mmReportLogsURL
+}
+
+// Return sets up results that will be returned by PickupProvider.ReportLogsURL
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) Return(err error) *PickupProviderMock {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("PickupProviderMock.ReportLogsURL mock is already set by Set")
+	}
+
+	if mmReportLogsURL.defaultExpectation == nil {
+		mmReportLogsURL.defaultExpectation = &PickupProviderMockReportLogsURLExpectation{mock: mmReportLogsURL.mock}
+	}
+	mmReportLogsURL.defaultExpectation.results = &PickupProviderMockReportLogsURLResults{err}
+	return mmReportLogsURL.mock
+}
+
+//Set uses given function f to mock the PickupProvider.ReportLogsURL method
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) Set(f func(ctx context.Context, pickupID int, logsURL string) (err error)) *PickupProviderMock {
+	if mmReportLogsURL.defaultExpectation != nil {
+		mmReportLogsURL.mock.t.Fatalf("Default expectation is already set for the PickupProvider.ReportLogsURL method")
+	}
+
+	if len(mmReportLogsURL.expectations) > 0 {
+		mmReportLogsURL.mock.t.Fatalf("Some expectations are already set for the PickupProvider.ReportLogsURL method")
+	}
+
+	mmReportLogsURL.mock.funcReportLogsURL = f
+	return mmReportLogsURL.mock
+}
+
+// When sets expectation for the PickupProvider.ReportLogsURL which will trigger the result defined by the following
+// Then helper
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) When(ctx context.Context, pickupID int, logsURL string) *PickupProviderMockReportLogsURLExpectation {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("PickupProviderMock.ReportLogsURL mock is already set by Set")
+	}
+
+	expectation := &PickupProviderMockReportLogsURLExpectation{
+		mock:   mmReportLogsURL.mock,
+		params: &PickupProviderMockReportLogsURLParams{ctx, pickupID, logsURL},
+	}
+	mmReportLogsURL.expectations = append(mmReportLogsURL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PickupProvider.ReportLogsURL return parameters for the expectation previously defined by the When method
+func (e *PickupProviderMockReportLogsURLExpectation) Then(err error) *PickupProviderMock {
+	e.results = &PickupProviderMockReportLogsURLResults{err}
+	return e.mock
+}
+
+// ReportLogsURL implements requests.PickupProvider
+func (mmReportLogsURL *PickupProviderMock) ReportLogsURL(ctx context.Context, pickupID int, logsURL string) (err error) {
+	mm_atomic.AddUint64(&mmReportLogsURL.beforeReportLogsURLCounter, 1)
+	defer mm_atomic.AddUint64(&mmReportLogsURL.afterReportLogsURLCounter, 1)
+
+	if mmReportLogsURL.inspectFuncReportLogsURL != nil {
+		mmReportLogsURL.inspectFuncReportLogsURL(ctx, pickupID, logsURL)
+	}
+
+	mm_params := &PickupProviderMockReportLogsURLParams{ctx, pickupID, logsURL}
+
+	// Record call args
+	mmReportLogsURL.ReportLogsURLMock.mutex.Lock()
+	mmReportLogsURL.ReportLogsURLMock.callArgs = append(mmReportLogsURL.ReportLogsURLMock.callArgs, mm_params)
+	mmReportLogsURL.ReportLogsURLMock.mutex.Unlock()
+
+	for _, e := range mmReportLogsURL.ReportLogsURLMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReportLogsURL.ReportLogsURLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReportLogsURL.ReportLogsURLMock.defaultExpectation.Counter, 1)
+		mm_want := mmReportLogsURL.ReportLogsURLMock.defaultExpectation.params
+		mm_got := PickupProviderMockReportLogsURLParams{ctx, pickupID, logsURL}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReportLogsURL.t.Errorf("PickupProviderMock.ReportLogsURL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReportLogsURL.ReportLogsURLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReportLogsURL.t.Fatal("No results are set for the PickupProviderMock.ReportLogsURL")
+		}
+		return (*mm_results).err
+	}
+	if mmReportLogsURL.funcReportLogsURL != nil {
+		return mmReportLogsURL.funcReportLogsURL(ctx, pickupID, logsURL)
+	}
+	mmReportLogsURL.t.Fatalf("Unexpected call to PickupProviderMock.ReportLogsURL. %v %v %v", ctx, pickupID, logsURL)
+	return
+}
+
+// ReportLogsURLAfterCounter returns a count of finished PickupProviderMock.ReportLogsURL invocations
+func (mmReportLogsURL *PickupProviderMock) ReportLogsURLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportLogsURL.afterReportLogsURLCounter)
+}
+
+// ReportLogsURLBeforeCounter returns a count of PickupProviderMock.ReportLogsURL invocations
+func (mmReportLogsURL *PickupProviderMock) ReportLogsURLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportLogsURL.beforeReportLogsURLCounter)
+}
+
+// Calls returns a list of arguments used in each call to PickupProviderMock.ReportLogsURL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReportLogsURL *mPickupProviderMockReportLogsURL) Calls() []*PickupProviderMockReportLogsURLParams {
+	mmReportLogsURL.mutex.RLock()
+
+	argCopy := make([]*PickupProviderMockReportLogsURLParams, len(mmReportLogsURL.callArgs))
+	copy(argCopy, mmReportLogsURL.callArgs)
+
+	mmReportLogsURL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportLogsURLDone returns true if the count of the ReportLogsURL invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockReportLogsURLDone() bool {
+	for _, e := range m.ReportLogsURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportLogsURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportLogsURL != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReportLogsURLInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockReportLogsURLInspect() {
+	for _, e := range m.ReportLogsURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PickupProviderMock.ReportLogsURL with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportLogsURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		if m.ReportLogsURLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PickupProviderMock.ReportLogsURL")
+		} else {
+			m.t.Errorf("Expected call to PickupProviderMock.ReportLogsURL with params: %#v", *m.ReportLogsURLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportLogsURL != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.ReportLogsURL")
+	}
+}
+
+type mPickupProviderMockResolvePlayers struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockResolvePlayersExpectation
+	expectations       []*PickupProviderMockResolvePlayersExpectation
+
+	callArgs []*PickupProviderMockResolvePlayersParams
+	mutex    sync.RWMutex
+}
+
+// PickupProviderMockResolvePlayersExpectation specifies expectation struct of the PickupProvider.ResolvePlayers
+type PickupProviderMockResolvePlayersExpectation struct {
+	mock    *PickupProviderMock
+	params  *PickupProviderMockResolvePlayersParams
+	results *PickupProviderMockResolvePlayersResults
+	Counter uint64
+}
+
+// PickupProviderMockResolvePlayersParams contains parameters of the PickupProvider.ResolvePlayers
+type PickupProviderMockResolvePlayersParams struct {
+	ctx     context.Context
+	players []*stats.PickupPlayer
+}
+
+// PickupProviderMockResolvePlayersResults contains results of the PickupProvider.ResolvePlayers
+type PickupProviderMockResolvePlayersResults struct {
+	err error
+}
+
+// Expect sets up expected params for PickupProvider.ResolvePlayers
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) Expect(ctx context.Context, players []*stats.PickupPlayer) *mPickupProviderMockResolvePlayers {
+	if mmResolvePlayers.mock.funcResolvePlayers != nil {
+		mmResolvePlayers.mock.t.Fatalf("PickupProviderMock.ResolvePlayers mock is already set by Set")
+	}
+
+	if mmResolvePlayers.defaultExpectation == nil {
+		mmResolvePlayers.defaultExpectation = &PickupProviderMockResolvePlayersExpectation{}
+	}
+
+	mmResolvePlayers.defaultExpectation.params = &PickupProviderMockResolvePlayersParams{ctx, players}
+	for _, e := range mmResolvePlayers.expectations {
+		if minimock.Equal(e.params, mmResolvePlayers.defaultExpectation.params) {
+			mmResolvePlayers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolvePlayers.defaultExpectation.params)
+		}
+	}
+
+	return mmResolvePlayers
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.ResolvePlayers
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) Inspect(f func(ctx context.Context, players []*stats.PickupPlayer)) *mPickupProviderMockResolvePlayers {
+	if mmResolvePlayers.mock.inspectFuncResolvePlayers != nil {
+		mmResolvePlayers.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.ResolvePlayers")
+	}
+
+	mmResolvePlayers.mock.inspectFuncResolvePlayers = f
+
+	return mmResolvePlayers
+}
+
+// Return sets up results that will be returned by PickupProvider.ResolvePlayers
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) Return(err error) *PickupProviderMock {
+	if mmResolvePlayers.mock.funcResolvePlayers != nil {
+		mmResolvePlayers.mock.t.Fatalf("PickupProviderMock.ResolvePlayers mock is already set by Set")
+	}
+
+	if mmResolvePlayers.defaultExpectation == nil {
+		mmResolvePlayers.defaultExpectation = &PickupProviderMockResolvePlayersExpectation{mock: mmResolvePlayers.mock}
+	}
+	mmResolvePlayers.defaultExpectation.results = &PickupProviderMockResolvePlayersResults{err}
+	return mmResolvePlayers.mock
+}
+
+//Set uses given function f to mock the PickupProvider.ResolvePlayers method
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) Set(f func(ctx context.Context, players []*stats.PickupPlayer) (err error)) *PickupProviderMock {
+	if mmResolvePlayers.defaultExpectation != nil {
+		mmResolvePlayers.mock.t.Fatalf("Default expectation is already set for the PickupProvider.ResolvePlayers method")
+	}
+
+	if len(mmResolvePlayers.expectations) > 0 {
+		mmResolvePlayers.mock.t.Fatalf("Some expectations are already set for the PickupProvider.ResolvePlayers method")
+	}
+
+	mmResolvePlayers.mock.funcResolvePlayers = f
+	return mmResolvePlayers.mock
+}
+
+// When sets expectation for the PickupProvider.ResolvePlayers which will trigger the result defined by the following
+// Then helper
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) When(ctx context.Context, players []*stats.PickupPlayer) *PickupProviderMockResolvePlayersExpectation {
+	if mmResolvePlayers.mock.funcResolvePlayers != nil {
+		mmResolvePlayers.mock.t.Fatalf("PickupProviderMock.ResolvePlayers mock is already set by Set")
+	}
+
+	expectation := &PickupProviderMockResolvePlayersExpectation{
+		mock:   mmResolvePlayers.mock,
+		params: &PickupProviderMockResolvePlayersParams{ctx, players},
+	}
+	mmResolvePlayers.expectations = append(mmResolvePlayers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PickupProvider.ResolvePlayers return parameters for the expectation previously defined by the When method
+func (e *PickupProviderMockResolvePlayersExpectation) Then(err error) *PickupProviderMock {
+	e.results = &PickupProviderMockResolvePlayersResults{err}
+	return e.mock
+}
+
+// ResolvePlayers implements requests.PickupProvider
+func (mmResolvePlayers *PickupProviderMock) ResolvePlayers(ctx context.Context, players []*stats.PickupPlayer) (err error) {
+	mm_atomic.AddUint64(&mmResolvePlayers.beforeResolvePlayersCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolvePlayers.afterResolvePlayersCounter, 1)
+
+	if mmResolvePlayers.inspectFuncResolvePlayers != nil {
+		mmResolvePlayers.inspectFuncResolvePlayers(ctx, players)
+	}
+
+	mm_params := &PickupProviderMockResolvePlayersParams{ctx, players}
+
+	// Record call args
+	mmResolvePlayers.ResolvePlayersMock.mutex.Lock()
+	mmResolvePlayers.ResolvePlayersMock.callArgs = append(mmResolvePlayers.ResolvePlayersMock.callArgs, mm_params)
+	mmResolvePlayers.ResolvePlayersMock.mutex.Unlock()
+
+	for _, e := range mmResolvePlayers.ResolvePlayersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResolvePlayers.ResolvePlayersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolvePlayers.ResolvePlayersMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolvePlayers.ResolvePlayersMock.defaultExpectation.params
+		mm_got := PickupProviderMockResolvePlayersParams{ctx, players}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolvePlayers.t.Errorf("PickupProviderMock.ResolvePlayers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolvePlayers.ResolvePlayersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolvePlayers.t.Fatal("No results are set for the PickupProviderMock.ResolvePlayers")
+		}
+		return (*mm_results).err
+	}
+	if mmResolvePlayers.funcResolvePlayers != nil {
+		return mmResolvePlayers.funcResolvePlayers(ctx, players)
+	}
+	mmResolvePlayers.t.Fatalf("Unexpected call to PickupProviderMock.ResolvePlayers. %v %v", ctx, players)
+	return
+}
+
+// ResolvePlayersAfterCounter returns a count of finished PickupProviderMock.ResolvePlayers invocations
+func (mmResolvePlayers *PickupProviderMock) ResolvePlayersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolvePlayers.afterResolvePlayersCounter)
+}
+
+// ResolvePlayersBeforeCounter returns a count of PickupProviderMock.ResolvePlayers invocations
+func (mmResolvePlayers *PickupProviderMock) ResolvePlayersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolvePlayers.beforeResolvePlayersCounter)
+}
+
+// Calls returns a list of arguments used in each call to PickupProviderMock.ResolvePlayers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolvePlayers *mPickupProviderMockResolvePlayers) Calls() []*PickupProviderMockResolvePlayersParams {
+	mmResolvePlayers.mutex.RLock()
+
+	argCopy := make([]*PickupProviderMockResolvePlayersParams, len(mmResolvePlayers.callArgs))
+	copy(argCopy, mmResolvePlayers.callArgs)
+
+	mmResolvePlayers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolvePlayersDone returns true if the count of the ResolvePlayers invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockResolvePlayersDone() bool {
+	for _, e := range m.ResolvePlayersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolvePlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolvePlayers != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockResolvePlayersInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockResolvePlayersInspect() {
+	for _, e := range m.ResolvePlayersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PickupProviderMock.ResolvePlayers with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolvePlayersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
+		if m.ResolvePlayersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PickupProviderMock.ResolvePlayers")
+		} else {
+			m.t.Errorf("Expected call to PickupProviderMock.ResolvePlayers with params: %#v", *m.ResolvePlayersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolvePlayers != nil && mm_atomic.LoadUint64(&m.afterResolvePlayersCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.ResolvePlayers")
+	}
+}
+
+type mPickupProviderMockSite struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockSiteExpectation
+	expectations       []*PickupProviderMockSiteExpectation
+}
+
+// PickupProviderMockSiteExpectation specifies expectation struct of the PickupProvider.Site
+type PickupProviderMockSiteExpectation struct {
+	mock *PickupProviderMock
+
+	results *PickupProviderMockSiteResults
+	Counter uint64
+}
+
+// PickupProviderMockSiteResults contains results of the PickupProvider.Site
+type PickupProviderMockSiteResults struct {
+	s1 string
+}
+
+// Expect sets up expected params for PickupProvider.Site
+func (mmSite *mPickupProviderMockSite) Expect() *mPickupProviderMockSite {
+	if mmSite.mock.funcSite != nil {
+		mmSite.mock.t.Fatalf("PickupProviderMock.Site mock is already set by Set")
+	}
+
+	if mmSite.defaultExpectation == nil {
+		mmSite.defaultExpectation = &PickupProviderMockSiteExpectation{}
+	}
+
+	return mmSite
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.Site
+func (mmSite *mPickupProviderMockSite) Inspect(f func()) *mPickupProviderMockSite {
+	if mmSite.mock.inspectFuncSite != nil {
+		mmSite.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.Site")
+	}
+
+	mmSite.mock.inspectFuncSite = f
+
+	return mmSite
+}
+
+// Return sets up results that will be returned by PickupProvider.Site
+func (mmSite *mPickupProviderMockSite) Return(s1 string) *PickupProviderMock {
+	if mmSite.mock.funcSite != nil {
+		mmSite.mock.t.Fatalf("PickupProviderMock.Site mock is already set by Set")
+	}
+
+	if mmSite.defaultExpectation == nil {
+		mmSite.defaultExpectation = &PickupProviderMockSiteExpectation{mock: mmSite.mock}
+	}
+	mmSite.defaultExpectation.results = &PickupProviderMockSiteResults{s1}
+	return mmSite.mock
+}
+
+//Set uses given function f to mock the PickupProvider.Site method
+func (mmSite *mPickupProviderMockSite) Set(f func() (s1 string)) *PickupProviderMock {
+	if mmSite.defaultExpectation != nil {
+		mmSite.mock.t.Fatalf("Default expectation is already set for the PickupProvider.Site method")
+	}
+
+	if len(mmSite.expectations) > 0 {
+		mmSite.mock.t.Fatalf("Some expectations are already set for the PickupProvider.Site method")
+	}
+
+	mmSite.mock.funcSite = f
+	return mmSite.mock
+}
+
+// Site implements requests.PickupProvider
+func (mmSite *PickupProviderMock) Site() (s1 string) {
+	mm_atomic.AddUint64(&mmSite.beforeSiteCounter, 1)
+	defer mm_atomic.AddUint64(&mmSite.afterSiteCounter, 1)
+
+	if mmSite.inspectFuncSite != nil {
+		mmSite.inspectFuncSite()
+	}
+
+	if mmSite.SiteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSite.SiteMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmSite.SiteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSite.t.Fatal("No results are set for the PickupProviderMock.Site")
+		}
+		return (*mm_results).s1
+	}
+	if mmSite.funcSite != nil {
+		return mmSite.funcSite()
+	}
+	mmSite.t.Fatalf("Unexpected call to PickupProviderMock.Site.")
+	return
+}
+
+// SiteAfterCounter returns a count of finished PickupProviderMock.Site invocations
+func (mmSite *PickupProviderMock) SiteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSite.afterSiteCounter)
+}
+
+// SiteBeforeCounter returns a count of PickupProviderMock.Site invocations
+func (mmSite *PickupProviderMock) SiteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSite.beforeSiteCounter)
+}
+
+// MinimockSiteDone returns true if the count of the Site invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockSiteDone() bool {
+	for _, e := range m.SiteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SiteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSiteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSite != nil && mm_atomic.LoadUint64(&m.afterSiteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSiteInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockSiteInspect() {
+	for _, e := range m.SiteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to PickupProviderMock.Site")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SiteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSiteCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.Site")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSite != nil && mm_atomic.LoadUint64(&m.afterSiteCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.Site")
+	}
+}
+
+type mPickupProviderMockString struct {
+	mock               *PickupProviderMock
+	defaultExpectation *PickupProviderMockStringExpectation
+	expectations       []*PickupProviderMockStringExpectation
+}
+
+// PickupProviderMockStringExpectation specifies expectation struct of the PickupProvider.String
+type PickupProviderMockStringExpectation struct {
+	mock *PickupProviderMock
+
+	results *PickupProviderMockStringResults
+	Counter uint64
+}
+
+// PickupProviderMockStringResults contains results of the PickupProvider.String
+type PickupProviderMockStringResults struct {
+	s1 string
+}
+
+// Expect sets up expected params for PickupProvider.String
+func (mmString *mPickupProviderMockString) Expect() *mPickupProviderMockString {
+	if mmString.mock.funcString != nil {
+		mmString.mock.t.Fatalf("PickupProviderMock.String mock is already set by Set")
+	}
+
+	if mmString.defaultExpectation == nil {
+		mmString.defaultExpectation = &PickupProviderMockStringExpectation{}
+	}
+
+	return mmString
+}
+
+// Inspect accepts an inspector function that has same arguments as the PickupProvider.String
+func (mmString *mPickupProviderMockString) Inspect(f func()) *mPickupProviderMockString {
+	if mmString.mock.inspectFuncString != nil {
+		mmString.mock.t.Fatalf("Inspect function is already set for PickupProviderMock.String")
+	}
+
+	mmString.mock.inspectFuncString = f
+
+	return mmString
+}
+
+// Return sets up results that will be returned by PickupProvider.String
+func (mmString *mPickupProviderMockString) Return(s1 string) *PickupProviderMock {
+	if mmString.mock.funcString != nil {
+		mmString.mock.t.Fatalf("PickupProviderMock.String mock is already set by Set")
+	}
+
+	if mmString.defaultExpectation == nil {
+		mmString.defaultExpectation = &PickupProviderMockStringExpectation{mock: mmString.mock}
+	}
+	mmString.defaultExpectation.results = &PickupProviderMockStringResults{s1}
+	return mmString.mock
+}
+
+//Set uses given function f to mock the PickupProvider.String method
+func (mmString *mPickupProviderMockString) Set(f func() (s1 string)) *PickupProviderMock {
+	if mmString.defaultExpectation != nil {
+		mmString.mock.t.Fatalf("Default expectation is already set for the PickupProvider.String method")
+	}
+
+	if len(mmString.expectations) > 0 {
+		mmString.mock.t.Fatalf("Some expectations are already set for the PickupProvider.String method")
+	}
+
+	mmString.mock.funcString = f
+	return mmString.mock
+}
+
+// String implements requests.PickupProvider
+func (mmString *PickupProviderMock) String() (s1 string) {
+	mm_atomic.AddUint64(&mmString.beforeStringCounter, 1)
+	defer mm_atomic.AddUint64(&mmString.afterStringCounter, 1)
+
+	if mmString.inspectFuncString != nil {
+		mmString.inspectFuncString()
+	}
+
+	if mmString.StringMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmString.StringMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmString.StringMock.defaultExpectation.results
+		if mm_results == nil {
+			mmString.t.Fatal("No results are set for the PickupProviderMock.String")
+		}
+		return (*mm_results).s1
+	}
+	if mmString.funcString != nil {
+		return mmString.funcString()
+	}
+	mmString.t.Fatalf("Unexpected call to PickupProviderMock.String.")
+	return
+}
+
+// StringAfterCounter returns a count of finished PickupProviderMock.String invocations
+func (mmString *PickupProviderMock) StringAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmString.afterStringCounter)
+}
+
+// StringBeforeCounter returns a count of PickupProviderMock.String invocations
+func (mmString *PickupProviderMock) StringBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmString.beforeStringCounter)
+}
+
+// MinimockStringDone returns true if the count of the String invocations corresponds
+// the number of defined expectations
+func (m *PickupProviderMock) MinimockStringDone() bool {
+	for _, e := range m.StringMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StringMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStringCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcString != nil && mm_atomic.LoadUint64(&m.afterStringCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStringInspect logs each unmet expectation
+func (m *PickupProviderMock) MinimockStringInspect() {
+	for _, e := range m.StringMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to PickupProviderMock.String")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StringMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStringCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.String")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcString != nil && mm_atomic.LoadUint64(&m.afterStringCounter) < 1 {
+		m.t.Error("Expected call to PickupProviderMock.String")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PickupProviderMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockCheckAPIInspect()
+
+		m.MinimockFindMatchingPickupInspect()
+
+		m.MinimockReportLogsURLInspect()
+
+		m.MinimockResolvePlayersInspect()
+
+		m.MinimockSiteInspect()
+
+		m.MinimockStringInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PickupProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PickupProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckAPIDone() &&
+		m.MinimockFindMatchingPickupDone() &&
+		m.MinimockReportLogsURLDone() &&
+		m.MinimockResolvePlayersDone() &&
+		m.MinimockSiteDone() &&
+		m.MinimockStringDone()
+}
@@ -0,0 +1,892 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/admin.Registry -o ./pkg/mocks/registry_mock.go
+
+import (
+	"LogWatcher/pkg/config"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RegistryMock implements admin.Registry
+type RegistryMock struct {
+	t minimock.Tester
+
+	funcAddClient          func(client config.Client) (err error)
+	inspectFuncAddClient   func(client config.Client)
+	afterAddClientCounter  uint64
+	beforeAddClientCounter uint64
+	AddClientMock          mRegistryMockAddClient
+
+	funcClients          func() (ca1 []config.Client)
+	inspectFuncClients   func()
+	afterClientsCounter  uint64
+	beforeClientsCounter uint64
+	ClientsMock          mRegistryMockClients
+
+	funcRemoveClient          func(name string, discard bool) (err error)
+	inspectFuncRemoveClient   func(name string, discard bool)
+	afterRemoveClientCounter  uint64
+	beforeRemoveClientCounter uint64
+	RemoveClientMock          mRegistryMockRemoveClient
+
+	funcUpdateClient          func(client config.Client) (err error)
+	inspectFuncUpdateClient   func(client config.Client)
+	afterUpdateClientCounter  uint64
+	beforeUpdateClientCounter uint64
+	UpdateClientMock          mRegistryMockUpdateClient
+}
+
+// NewRegistryMock returns a mock for admin.Registry
+func NewRegistryMock(t minimock.Tester) *RegistryMock {
+	m := &RegistryMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddClientMock = mRegistryMockAddClient{mock: m}
+	m.AddClientMock.callArgs = []*RegistryMockAddClientParams{}
+
+	m.ClientsMock = mRegistryMockClients{mock: m}
+
+	m.RemoveClientMock = mRegistryMockRemoveClient{mock: m}
+	m.RemoveClientMock.callArgs = []*RegistryMockRemoveClientParams{}
+
+	m.UpdateClientMock = mRegistryMockUpdateClient{mock: m}
+	m.UpdateClientMock.callArgs = []*RegistryMockUpdateClientParams{}
+
+	return m
+}
+
+type mRegistryMockAddClient struct {
+	mock               *RegistryMock
+	defaultExpectation *RegistryMockAddClientExpectation
+	expectations       []*RegistryMockAddClientExpectation
+
+	callArgs []*RegistryMockAddClientParams
+	mutex    sync.RWMutex
+}
+
+// RegistryMockAddClientExpectation specifies expectation struct of the Registry.AddClient
+type RegistryMockAddClientExpectation struct {
+	mock    *RegistryMock
+	params  *RegistryMockAddClientParams
+	results *RegistryMockAddClientResults
+	Counter uint64
+}
+
+// RegistryMockAddClientParams contains parameters of the Registry.AddClient
+type RegistryMockAddClientParams struct {
+	client config.Client
+}
+
+// RegistryMockAddClientResults contains results of the Registry.AddClient
+type RegistryMockAddClientResults struct {
+	err error
+}
+
+// Expect sets up expected params for Registry.AddClient
+func (mmAddClient *mRegistryMockAddClient) Expect(client config.Client) *mRegistryMockAddClient {
+	if mmAddClient.mock.funcAddClient != nil {
+		mmAddClient.mock.t.Fatalf("RegistryMock.AddClient mock is already set by Set")
+	}
+
+	if mmAddClient.defaultExpectation == nil {
+		mmAddClient.defaultExpectation = &RegistryMockAddClientExpectation{}
+	}
+
+	mmAddClient.defaultExpectation.params = &RegistryMockAddClientParams{client}
+	for _, e := range mmAddClient.expectations {
+		if minimock.Equal(e.params, mmAddClient.defaultExpectation.params) {
+			mmAddClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddClient.defaultExpectation.params)
+		}
+	}
+
+	return mmAddClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the Registry.AddClient
+func (mmAddClient *mRegistryMockAddClient) Inspect(f func(client config.Client)) *mRegistryMockAddClient {
+	if mmAddClient.mock.inspectFuncAddClient != nil {
+		mmAddClient.mock.t.Fatalf("Inspect function is already set for RegistryMock.AddClient")
+	}
+
+	mmAddClient.mock.inspectFuncAddClient = f
+
+	return mmAddClient
+}
+
+// Return sets up results that will be returned by Registry.AddClient
+func (mmAddClient *mRegistryMockAddClient) Return(err error) *RegistryMock {
+	if mmAddClient.mock.funcAddClient != nil {
+		mmAddClient.mock.t.Fatalf("RegistryMock.AddClient mock is already set by Set")
+	}
+
+	if mmAddClient.defaultExpectation == nil {
+		mmAddClient.defaultExpectation = &RegistryMockAddClientExpectation{mock: mmAddClient.mock}
+	}
+	mmAddClient.defaultExpectation.results = &RegistryMockAddClientResults{err}
+	return mmAddClient.mock
+}
+
+//Set uses given function f to mock the Registry.AddClient method
+func (mmAddClient *mRegistryMockAddClient) Set(f func(client config.Client) (err error)) *RegistryMock {
+	if mmAddClient.defaultExpectation != nil {
+		mmAddClient.mock.t.Fatalf("Default expectation is already set for the Registry.AddClient method")
+	}
+
+	if len(mmAddClient.expectations) > 0 {
+		mmAddClient.mock.t.Fatalf("Some expectations are already set for the Registry.AddClient method")
+	}
+
+	mmAddClient.mock.funcAddClient = f
+	return mmAddClient.mock
+}
+
+// When sets expectation for the Registry.AddClient which will trigger the result defined by the following
+// Then helper
+func (mmAddClient *mRegistryMockAddClient) When(client config.Client) *RegistryMockAddClientExpectation {
+	if mmAddClient.mock.funcAddClient != nil {
+		mmAddClient.mock.t.Fatalf("RegistryMock.AddClient mock is already set by Set")
+	}
+
+	expectation := &RegistryMockAddClientExpectation{
+		mock:   mmAddClient.mock,
+		params: &RegistryMockAddClientParams{client},
+	}
+	mmAddClient.expectations = append(mmAddClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Registry.AddClient return parameters for the expectation previously defined by the When method
+func (e *RegistryMockAddClientExpectation) Then(err error) *RegistryMock {
+	e.results = &RegistryMockAddClientResults{err}
+	return e.mock
+}
+
+// AddClient implements admin.Registry
+func (mmAddClient *RegistryMock) AddClient(client config.Client) (err error) {
+	mm_atomic.AddUint64(&mmAddClient.beforeAddClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddClient.afterAddClientCounter, 1)
+
+	if mmAddClient.inspectFuncAddClient != nil {
+		mmAddClient.inspectFuncAddClient(client)
+	}
+
+	mm_params := &RegistryMockAddClientParams{client}
+
+	// Record call args
+	mmAddClient.AddClientMock.mutex.Lock()
+	mmAddClient.AddClientMock.callArgs = append(mmAddClient.AddClientMock.callArgs, mm_params)
+	mmAddClient.AddClientMock.mutex.Unlock()
+
+	for _, e := range mmAddClient.AddClientMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddClient.AddClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddClient.AddClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddClient.AddClientMock.defaultExpectation.params
+		mm_got := RegistryMockAddClientParams{client}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddClient.t.Errorf("RegistryMock.AddClient got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddClient.AddClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddClient.t.Fatal("No results are set for the RegistryMock.AddClient")
+		}
+		return (*mm_results).err
+	}
+	if mmAddClient.funcAddClient != nil {
+		return mmAddClient.funcAddClient(client)
+	}
+	mmAddClient.t.Fatalf("Unexpected call to RegistryMock.AddClient. %v", client)
+	return
+}
+
+// AddClientAfterCounter returns a count of finished RegistryMock.AddClient invocations
+func (mmAddClient *RegistryMock) AddClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddClient.afterAddClientCounter)
+}
+
+// AddClientBeforeCounter returns a count of RegistryMock.AddClient invocations
+func (mmAddClient *RegistryMock) AddClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddClient.beforeAddClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to RegistryMock.AddClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddClient *mRegistryMockAddClient) Calls() []*RegistryMockAddClientParams {
+	mmAddClient.mutex.RLock()
+
+	argCopy := make([]*RegistryMockAddClientParams, len(mmAddClient.callArgs))
+	copy(argCopy, mmAddClient.callArgs)
+
+	mmAddClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddClientDone returns true if the count of the AddClient invocations corresponds
+// the number of defined expectations
+func (m *RegistryMock) MinimockAddClientDone() bool {
+	for _, e := range m.AddClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddClientCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddClient != nil && mm_atomic.LoadUint64(&m.afterAddClientCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddClientInspect logs each unmet expectation
+func (m *RegistryMock) MinimockAddClientInspect() {
+	for _, e := range m.AddClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RegistryMock.AddClient with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddClientCounter) < 1 {
+		if m.AddClientMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RegistryMock.AddClient")
+		} else {
+			m.t.Errorf("Expected call to RegistryMock.AddClient with params: %#v", *m.AddClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddClient != nil && mm_atomic.LoadUint64(&m.afterAddClientCounter) < 1 {
+		m.t.Error("Expected call to RegistryMock.AddClient")
+	}
+}
+
+type mRegistryMockClients struct {
+	mock               *RegistryMock
+	defaultExpectation *RegistryMockClientsExpectation
+	expectations       []*RegistryMockClientsExpectation
+}
+
+// RegistryMockClientsExpectation specifies expectation struct of the Registry.Clients
+type RegistryMockClientsExpectation struct {
+	mock *RegistryMock
+
+	results *RegistryMockClientsResults
+	Counter uint64
+}
+
+// RegistryMockClientsResults contains results of the Registry.Clients
+type RegistryMockClientsResults struct {
+	ca1 []config.Client
+}
+
+// Expect sets up expected params for Registry.Clients
+func (mmClients *mRegistryMockClients) Expect() *mRegistryMockClients {
+	if mmClients.mock.funcClients != nil {
+		mmClients.mock.t.Fatalf("RegistryMock.Clients mock is already set by Set")
+	}
+
+	if mmClients.defaultExpectation == nil {
+		mmClients.defaultExpectation = &RegistryMockClientsExpectation{}
+	}
+
+	return mmClients
+}
+
+// Inspect accepts an inspector function that has same arguments as the Registry.Clients
+func (mmClients *mRegistryMockClients) Inspect(f func()) *mRegistryMockClients {
+	if mmClients.mock.inspectFuncClients != nil {
+		mmClients.mock.t.Fatalf("Inspect function is already set for RegistryMock.Clients")
+	}
+
+	mmClients.mock.inspectFuncClients = f
+
+	return mmClients
+}
+
+// Return sets up results that will be returned by Registry.Clients
+func (mmClients *mRegistryMockClients) Return(ca1 []config.Client) *RegistryMock {
+	if mmClients.mock.funcClients != nil {
+		mmClients.mock.t.Fatalf("RegistryMock.Clients mock is already set by Set")
+	}
+
+	if mmClients.defaultExpectation == nil {
+		mmClients.defaultExpectation = &RegistryMockClientsExpectation{mock: mmClients.mock}
+	}
+	mmClients.defaultExpectation.results = &RegistryMockClientsResults{ca1}
+	return mmClients.mock
+}
+
+//Set uses given function f to mock the Registry.Clients method
+func (mmClients *mRegistryMockClients) Set(f func() (ca1 []config.Client)) *RegistryMock {
+	if mmClients.defaultExpectation != nil {
+		mmClients.mock.t.Fatalf("Default expectation is already set for the Registry.Clients method")
+	}
+
+	if len(mmClients.expectations) > 0 {
+		mmClients.mock.t.Fatalf("Some expectations are already set for the Registry.Clients method")
+	}
+
+	mmClients.mock.funcClients = f
+	return mmClients.mock
+}
+
+// Clients implements admin.Registry
+func (mmClients *RegistryMock) Clients() (ca1 []config.Client) {
+	mm_atomic.AddUint64(&mmClients.beforeClientsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClients.afterClientsCounter, 1)
+
+	if mmClients.inspectFuncClients != nil {
+		mmClients.inspectFuncClients()
+	}
+
+	if mmClients.ClientsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClients.ClientsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmClients.ClientsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClients.t.Fatal("No results are set for the RegistryMock.Clients")
+		}
+		return (*mm_results).ca1
+	}
+	if mmClients.funcClients != nil {
+		return mmClients.funcClients()
+	}
+	mmClients.t.Fatalf("Unexpected call to RegistryMock.Clients.")
+	return
+}
+
+// ClientsAfterCounter returns a count of finished RegistryMock.Clients invocations
+func (mmClients *RegistryMock) ClientsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClients.afterClientsCounter)
+}
+
+// ClientsBeforeCounter returns a count of RegistryMock.Clients invocations
+func (mmClients *RegistryMock) ClientsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClients.beforeClientsCounter)
+}
+
+// MinimockClientsDone returns true if the count of the Clients invocations corresponds
+// the number of defined expectations
+func (m *RegistryMock) MinimockClientsDone() bool {
+	for _, e := range m.ClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClientsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterClientsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClients != nil && mm_atomic.LoadUint64(&m.afterClientsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockClientsInspect logs each unmet expectation
+func (m *RegistryMock) MinimockClientsInspect() {
+	for _, e := range m.ClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to RegistryMock.Clients")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClientsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterClientsCounter) < 1 {
+		m.t.Error("Expected call to RegistryMock.Clients")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClients != nil && mm_atomic.LoadUint64(&m.afterClientsCounter) < 1 {
+		m.t.Error("Expected call to RegistryMock.Clients")
+	}
+}
+
+type mRegistryMockRemoveClient struct {
+	mock               *RegistryMock
+	defaultExpectation *RegistryMockRemoveClientExpectation
+	expectations       []*RegistryMockRemoveClientExpectation
+
+	callArgs []*RegistryMockRemoveClientParams
+	mutex    sync.RWMutex
+}
+
+// RegistryMockRemoveClientExpectation specifies expectation struct of the Registry.RemoveClient
+type RegistryMockRemoveClientExpectation struct {
+	mock    *RegistryMock
+	params  *RegistryMockRemoveClientParams
+	results *RegistryMockRemoveClientResults
+	Counter uint64
+}
+
+// RegistryMockRemoveClientParams contains parameters of the Registry.RemoveClient
+type RegistryMockRemoveClientParams struct {
+	name    string
+	discard bool
+}
+
+// RegistryMockRemoveClientResults contains results of the Registry.RemoveClient
+type RegistryMockRemoveClientResults struct {
+	err error
+}
+
+// Expect sets up expected params for Registry.RemoveClient
+func (mmRemoveClient *mRegistryMockRemoveClient) Expect(name string, discard bool) *mRegistryMockRemoveClient {
+	if mmRemoveClient.mock.funcRemoveClient != nil {
+		mmRemoveClient.mock.t.Fatalf("RegistryMock.RemoveClient mock is already set by Set")
+	}
+
+	if mmRemoveClient.defaultExpectation == nil {
+		mmRemoveClient.defaultExpectation = &RegistryMockRemoveClientExpectation{}
+	}
+
+	mmRemoveClient.defaultExpectation.params = &RegistryMockRemoveClientParams{name, discard}
+	for _, e := range mmRemoveClient.expectations {
+		if minimock.Equal(e.params, mmRemoveClient.defaultExpectation.params) {
+			mmRemoveClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveClient.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the Registry.RemoveClient
+func (mmRemoveClient *mRegistryMockRemoveClient) Inspect(f func(name string, discard bool)) *mRegistryMockRemoveClient {
+	if mmRemoveClient.mock.inspectFuncRemoveClient != nil {
+		mmRemoveClient.mock.t.Fatalf("Inspect function is already set for RegistryMock.RemoveClient")
+	}
+
+	mmRemoveClient.mock.inspectFuncRemoveClient = f
+
+	return mmRemoveClient
+}
+
+// Return sets up results that will be returned by Registry.RemoveClient
+func (mmRemoveClient *mRegistryMockRemoveClient) Return(err error) *RegistryMock {
+	if mmRemoveClient.mock.funcRemoveClient != nil {
+		mmRemoveClient.mock.t.Fatalf("RegistryMock.RemoveClient mock is already set by Set")
+	}
+
+	if mmRemoveClient.defaultExpectation == nil {
+		mmRemoveClient.defaultExpectation = &RegistryMockRemoveClientExpectation{mock: mmRemoveClient.mock}
+	}
+	mmRemoveClient.defaultExpectation.results = &RegistryMockRemoveClientResults{err}
+	return mmRemoveClient.mock
+}
+
+//Set uses given function f to mock the Registry.RemoveClient method
+func (mmRemoveClient *mRegistryMockRemoveClient) Set(f func(name string, discard bool) (err error)) *RegistryMock {
+	if mmRemoveClient.defaultExpectation != nil {
+		mmRemoveClient.mock.t.Fatalf("Default expectation is already set for the Registry.RemoveClient method")
+	}
+
+	if len(mmRemoveClient.expectations) > 0 {
+		mmRemoveClient.mock.t.Fatalf("Some expectations are already set for the Registry.RemoveClient method")
+	}
+
+	mmRemoveClient.mock.funcRemoveClient = f
+	return mmRemoveClient.mock
+}
+
+// When sets expectation for the Registry.RemoveClient which will trigger the result defined by the following
+// Then helper
+func (mmRemoveClient *mRegistryMockRemoveClient) When(name string, discard bool) *RegistryMockRemoveClientExpectation {
+	if mmRemoveClient.mock.funcRemoveClient != nil {
+		mmRemoveClient.mock.t.Fatalf("RegistryMock.RemoveClient mock is already set by Set")
+	}
+
+	expectation := &RegistryMockRemoveClientExpectation{
+		mock:   mmRemoveClient.mock,
+		params: &RegistryMockRemoveClientParams{name, discard},
+	}
+	mmRemoveClient.expectations = append(mmRemoveClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Registry.RemoveClient return parameters for the expectation previously defined by the When method
+func (e *RegistryMockRemoveClientExpectation) Then(err error) *RegistryMock {
+	e.results = &RegistryMockRemoveClientResults{err}
+	return e.mock
+}
+
+// RemoveClient implements admin.Registry
+func (mmRemoveClient *RegistryMock) RemoveClient(name string, discard bool) (err error) {
+	mm_atomic.AddUint64(&mmRemoveClient.beforeRemoveClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveClient.afterRemoveClientCounter, 1)
+
+	if mmRemoveClient.inspectFuncRemoveClient != nil {
+		mmRemoveClient.inspectFuncRemoveClient(name, discard)
+	}
+
+	mm_params := &RegistryMockRemoveClientParams{name, discard}
+
+	// Record call args
+	mmRemoveClient.RemoveClientMock.mutex.Lock()
+	mmRemoveClient.RemoveClientMock.callArgs = append(mmRemoveClient.RemoveClientMock.callArgs, mm_params)
+	mmRemoveClient.RemoveClientMock.mutex.Unlock()
+
+	for _, e := range mmRemoveClient.RemoveClientMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveClient.RemoveClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveClient.RemoveClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveClient.RemoveClientMock.defaultExpectation.params
+		mm_got := RegistryMockRemoveClientParams{name, discard}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveClient.t.Errorf("RegistryMock.RemoveClient got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveClient.RemoveClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveClient.t.Fatal("No results are set for the RegistryMock.RemoveClient")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveClient.funcRemoveClient != nil {
+		return mmRemoveClient.funcRemoveClient(name, discard)
+	}
+	mmRemoveClient.t.Fatalf("Unexpected call to RegistryMock.RemoveClient. %v %v", name, discard)
+	return
+}
+
+// RemoveClientAfterCounter returns a count of finished RegistryMock.RemoveClient invocations
+func (mmRemoveClient *RegistryMock) RemoveClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveClient.afterRemoveClientCounter)
+}
+
+// RemoveClientBeforeCounter returns a count of RegistryMock.RemoveClient invocations
+func (mmRemoveClient *RegistryMock) RemoveClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveClient.beforeRemoveClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to RegistryMock.RemoveClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveClient *mRegistryMockRemoveClient) Calls() []*RegistryMockRemoveClientParams {
+	mmRemoveClient.mutex.RLock()
+
+	argCopy := make([]*RegistryMockRemoveClientParams, len(mmRemoveClient.callArgs))
+	copy(argCopy, mmRemoveClient.callArgs)
+
+	mmRemoveClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveClientDone returns true if the count of the RemoveClient invocations corresponds
+// the number of defined expectations
+func (m *RegistryMock) MinimockRemoveClientDone() bool {
+	for _, e := range m.RemoveClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveClientCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveClient != nil && mm_atomic.LoadUint64(&m.afterRemoveClientCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveClientInspect logs each unmet expectation
+func (m *RegistryMock) MinimockRemoveClientInspect() {
+	for _, e := range m.RemoveClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RegistryMock.RemoveClient with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveClientCounter) < 1 {
+		if m.RemoveClientMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RegistryMock.RemoveClient")
+		} else {
+			m.t.Errorf("Expected call to RegistryMock.RemoveClient with params: %#v", *m.RemoveClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveClient != nil && mm_atomic.LoadUint64(&m.afterRemoveClientCounter) < 1 {
+		m.t.Error("Expected call to RegistryMock.RemoveClient")
+	}
+}
+
+type mRegistryMockUpdateClient struct {
+	mock               *RegistryMock
+	defaultExpectation *RegistryMockUpdateClientExpectation
+	expectations       []*RegistryMockUpdateClientExpectation
+
+	callArgs []*RegistryMockUpdateClientParams
+	mutex    sync.RWMutex
+}
+
+// RegistryMockUpdateClientExpectation specifies expectation struct of the Registry.UpdateClient
+type RegistryMockUpdateClientExpectation struct {
+	mock    *RegistryMock
+	params  *RegistryMockUpdateClientParams
+	results *RegistryMockUpdateClientResults
+	Counter uint64
+}
+
+// RegistryMockUpdateClientParams contains parameters of the Registry.UpdateClient
+type RegistryMockUpdateClientParams struct {
+	client config.Client
+}
+
+// RegistryMockUpdateClientResults contains results of the Registry.UpdateClient
+type RegistryMockUpdateClientResults struct {
+	err error
+}
+
+// Expect sets up expected params for Registry.UpdateClient
+func (mmUpdateClient *mRegistryMockUpdateClient) Expect(client config.Client) *mRegistryMockUpdateClient {
+	if mmUpdateClient.mock.funcUpdateClient != nil {
+		mmUpdateClient.mock.t.Fatalf("RegistryMock.UpdateClient mock is already set by Set")
+	}
+
+	if mmUpdateClient.defaultExpectation == nil {
+		mmUpdateClient.defaultExpectation = &RegistryMockUpdateClientExpectation{}
+	}
+
+	mmUpdateClient.defaultExpectation.params = &RegistryMockUpdateClientParams{client}
+	for _, e := range mmUpdateClient.expectations {
+		if minimock.Equal(e.params, mmUpdateClient.defaultExpectation.params) {
+			mmUpdateClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateClient.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the Registry.UpdateClient
+func (mmUpdateClient *mRegistryMockUpdateClient) Inspect(f func(client config.Client)) *mRegistryMockUpdateClient {
+	if mmUpdateClient.mock.inspectFuncUpdateClient != nil {
+		mmUpdateClient.mock.t.Fatalf("Inspect function is already set for RegistryMock.UpdateClient")
+	}
+
+	mmUpdateClient.mock.inspectFuncUpdateClient = f
+
+	return mmUpdateClient
+}
+
+// Return sets up results that will be returned by Registry.UpdateClient
+func (mmUpdateClient *mRegistryMockUpdateClient) Return(err error) *RegistryMock {
+	if mmUpdateClient.mock.funcUpdateClient != nil {
+		mmUpdateClient.mock.t.Fatalf("RegistryMock.UpdateClient mock is already set by Set")
+	}
+
+	if mmUpdateClient.defaultExpectation == nil {
+		mmUpdateClient.defaultExpectation = &RegistryMockUpdateClientExpectation{mock: mmUpdateClient.mock}
+	}
+	mmUpdateClient.defaultExpectation.results = &RegistryMockUpdateClientResults{err}
+	return mmUpdateClient.mock
+}
+
+//Set uses given function f to mock the Registry.UpdateClient method
+func (mmUpdateClient *mRegistryMockUpdateClient) Set(f func(client config.Client) (err error)) *RegistryMock {
+	if mmUpdateClient.defaultExpectation != nil {
+		mmUpdateClient.mock.t.Fatalf("Default expectation is already set for the Registry.UpdateClient method")
+	}
+
+	if len(mmUpdateClient.expectations) > 0 {
+		mmUpdateClient.mock.t.Fatalf("Some expectations are already set for the Registry.UpdateClient method")
+	}
+
+	mmUpdateClient.mock.funcUpdateClient = f
+	return mmUpdateClient.mock
+}
+
+// When sets expectation for the Registry.UpdateClient which will trigger the result defined by the following
+// Then helper
+func (mmUpdateClient *mRegistryMockUpdateClient) When(client config.Client) *RegistryMockUpdateClientExpectation {
+	if mmUpdateClient.mock.funcUpdateClient != nil {
+		mmUpdateClient.mock.t.Fatalf("RegistryMock.UpdateClient mock is already set by Set")
+	}
+
+	expectation := &RegistryMockUpdateClientExpectation{
+		mock:   mmUpdateClient.mock,
+		params: &RegistryMockUpdateClientParams{client},
+	}
+	mmUpdateClient.expectations = append(mmUpdateClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Registry.UpdateClient return parameters for the expectation previously defined by the When method
+func (e *RegistryMockUpdateClientExpectation) Then(err error) *RegistryMock {
+	e.results = &RegistryMockUpdateClientResults{err}
+	return e.mock
+}
+
+// UpdateClient implements admin.Registry
+func (mmUpdateClient *RegistryMock) UpdateClient(client config.Client) (err error) {
+	mm_atomic.AddUint64(&mmUpdateClient.beforeUpdateClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateClient.afterUpdateClientCounter, 1)
+
+	if mmUpdateClient.inspectFuncUpdateClient != nil {
+		mmUpdateClient.inspectFuncUpdateClient(client)
+	}
+
+	mm_params := &RegistryMockUpdateClientParams{client}
+
+	// Record call args
+	mmUpdateClient.UpdateClientMock.mutex.Lock()
+	mmUpdateClient.UpdateClientMock.callArgs = append(mmUpdateClient.UpdateClientMock.callArgs, mm_params)
+	mmUpdateClient.UpdateClientMock.mutex.Unlock()
+
+	for _, e := range mmUpdateClient.UpdateClientMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateClient.UpdateClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateClient.UpdateClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateClient.UpdateClientMock.defaultExpectation.params
+		mm_got := RegistryMockUpdateClientParams{client}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateClient.t.Errorf("RegistryMock.UpdateClient got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateClient.UpdateClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateClient.t.Fatal("No results are set for the RegistryMock.UpdateClient")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateClient.funcUpdateClient != nil {
+		return mmUpdateClient.funcUpdateClient(client)
+	}
+	mmUpdateClient.t.Fatalf("Unexpected call to RegistryMock.UpdateClient. %v", client)
+	return
+}
+
+// UpdateClientAfterCounter returns a count of finished RegistryMock.UpdateClient invocations
+func (mmUpdateClient *RegistryMock) UpdateClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateClient.afterUpdateClientCounter)
+}
+
+// UpdateClientBeforeCounter returns a count of RegistryMock.UpdateClient invocations
+func (mmUpdateClient *RegistryMock) UpdateClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateClient.beforeUpdateClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to RegistryMock.UpdateClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateClient *mRegistryMockUpdateClient) Calls() []*RegistryMockUpdateClientParams {
+	mmUpdateClient.mutex.RLock()
+
+	argCopy := make([]*RegistryMockUpdateClientParams, len(mmUpdateClient.callArgs))
+	copy(argCopy, mmUpdateClient.callArgs)
+
+	mmUpdateClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateClientDone returns true if the count of the UpdateClient invocations corresponds
+// the number of defined expectations
+func (m *RegistryMock) MinimockUpdateClientDone() bool {
+	for _, e := range m.UpdateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateClientCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateClient != nil && mm_atomic.LoadUint64(&m.afterUpdateClientCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateClientInspect logs each unmet expectation
+func (m *RegistryMock) MinimockUpdateClientInspect() {
+	for _, e := range m.UpdateClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RegistryMock.UpdateClient with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateClientMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateClientCounter) < 1 {
+		if m.UpdateClientMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RegistryMock.UpdateClient")
+		} else {
+			m.t.Errorf("Expected call to RegistryMock.UpdateClient with params: %#v", *m.UpdateClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateClient != nil && mm_atomic.LoadUint64(&m.afterUpdateClientCounter) < 1 {
+		m.t.Error("Expected call to RegistryMock.UpdateClient")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RegistryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockAddClientInspect()
+
+		m.MinimockClientsInspect()
+
+		m.MinimockRemoveClientInspect()
+
+		m.MinimockUpdateClientInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RegistryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RegistryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddClientDone() &&
+		m.MinimockClientsDone() &&
+		m.MinimockRemoveClientDone() &&
+		m.MinimockUpdateClientDone()
+}
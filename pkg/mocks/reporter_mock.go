@@ -0,0 +1,286 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/outbox.Reporter -o ./pkg/mocks/reporter_mock.go
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ReporterMock implements outbox.Reporter
+type ReporterMock struct {
+	t minimock.Tester
+
+	funcReportLogsURL          func(ctx context.Context, server string, pickupID int, logsURL string) (err error)
+	inspectFuncReportLogsURL   func(ctx context.Context, server string, pickupID int, logsURL string)
+	afterReportLogsURLCounter  uint64
+	beforeReportLogsURLCounter uint64
+	ReportLogsURLMock          mReporterMockReportLogsURL
+}
+
+// NewReporterMock returns a mock for outbox.Reporter
+func NewReporterMock(t minimock.Tester) *ReporterMock {
+	m := &ReporterMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ReportLogsURLMock = mReporterMockReportLogsURL{mock: m}
+	m.ReportLogsURLMock.callArgs = []*ReporterMockReportLogsURLParams{}
+
+	return m
+}
+
+type mReporterMockReportLogsURL struct {
+	mock               *ReporterMock
+	defaultExpectation *ReporterMockReportLogsURLExpectation
+	expectations       []*ReporterMockReportLogsURLExpectation
+
+	callArgs []*ReporterMockReportLogsURLParams
+	mutex    sync.RWMutex
+}
+
+// ReporterMockReportLogsURLExpectation specifies expectation struct of the Reporter.ReportLogsURL
+type ReporterMockReportLogsURLExpectation struct {
+	mock    *ReporterMock
+	params  *ReporterMockReportLogsURLParams
+	results *ReporterMockReportLogsURLResults
+	Counter uint64
+}
+
+// ReporterMockReportLogsURLParams contains parameters of the Reporter.ReportLogsURL
+type ReporterMockReportLogsURLParams struct {
+	ctx      context.Context
+	server   string
+	pickupID int
+	logsURL  string
+}
+
+// ReporterMockReportLogsURLResults contains results of the Reporter.ReportLogsURL
+type ReporterMockReportLogsURLResults struct {
+	err error
+}
+
+// Expect sets up expected params for Reporter.ReportLogsURL
+func (mmReportLogsURL *mReporterMockReportLogsURL) Expect(ctx context.Context, server string, pickupID int, logsURL string) *mReporterMockReportLogsURL {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("ReporterMock.ReportLogsURL mock is already set by Set")
+	}
+
+	if mmReportLogsURL.defaultExpectation == nil {
+		mmReportLogsURL.defaultExpectation = &ReporterMockReportLogsURLExpectation{}
+	}
+
+	mmReportLogsURL.defaultExpectation.params = &ReporterMockReportLogsURLParams{ctx, server, pickupID, logsURL}
+	for _, e := range mmReportLogsURL.expectations {
+		if minimock.Equal(e.params, mmReportLogsURL.defaultExpectation.params) {
+			mmReportLogsURL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReportLogsURL.defaultExpectation.params)
+		}
+	}
+
+	return mmReportLogsURL
+}
+
+// Inspect accepts an inspector function that has same arguments as the Reporter.ReportLogsURL
+func (mmReportLogsURL *mReporterMockReportLogsURL) Inspect(f func(ctx context.Context, server string, pickupID int, logsURL string)) *mReporterMockReportLogsURL {
+	if mmReportLogsURL.mock.inspectFuncReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("Inspect function is already set for ReporterMock.ReportLogsURL")
+	}
+
+	mmReportLogsURL.mock.inspectFuncReportLogsURL = f
+
+	return mmReportLogsURL
+}
+
+// Return sets up results that will be returned by Reporter.ReportLogsURL
+func (mmReportLogsURL *mReporterMockReportLogsURL) Return(err error) *ReporterMock {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("ReporterMock.ReportLogsURL mock is already set by Set")
+	}
+
+	if mmReportLogsURL.defaultExpectation == nil {
+		mmReportLogsURL.defaultExpectation = &ReporterMockReportLogsURLExpectation{mock: mmReportLogsURL.mock}
+	}
+	mmReportLogsURL.defaultExpectation.results = &ReporterMockReportLogsURLResults{err}
+	return mmReportLogsURL.mock
+}
+
+//Set uses given function f to mock the Reporter.ReportLogsURL method
+func (mmReportLogsURL *mReporterMockReportLogsURL) Set(f func(ctx context.Context, server string, pickupID int, logsURL string) (err error)) *ReporterMock {
+	if mmReportLogsURL.defaultExpectation != nil {
+		mmReportLogsURL.mock.t.Fatalf("Default expectation is already set for the Reporter.ReportLogsURL method")
+	}
+
+	if len(mmReportLogsURL.expectations) > 0 {
+		mmReportLogsURL.mock.t.Fatalf("Some expectations are already set for the Reporter.ReportLogsURL method")
+	}
+
+	mmReportLogsURL.mock.funcReportLogsURL = f
+	return mmReportLogsURL.mock
+}
+
+// When sets expectation for the Reporter.ReportLogsURL which will trigger the result defined by the following
+// Then helper
+func (mmReportLogsURL *mReporterMockReportLogsURL) When(ctx context.Context, server string, pickupID int, logsURL string) *ReporterMockReportLogsURLExpectation {
+	if mmReportLogsURL.mock.funcReportLogsURL != nil {
+		mmReportLogsURL.mock.t.Fatalf("ReporterMock.ReportLogsURL mock is already set by Set")
+	}
+
+	expectation := &ReporterMockReportLogsURLExpectation{
+		mock:   mmReportLogsURL.mock,
+		params: &ReporterMockReportLogsURLParams{ctx, server, pickupID, logsURL},
+	}
+	mmReportLogsURL.expectations = append(mmReportLogsURL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Reporter.ReportLogsURL return parameters for the expectation previously defined by the When method
+func (e *ReporterMockReportLogsURLExpectation) Then(err error) *ReporterMock {
+	e.results = &ReporterMockReportLogsURLResults{err}
+	return e.mock
+}
+
+// ReportLogsURL implements outbox.Reporter
+func (mmReportLogsURL *ReporterMock) ReportLogsURL(ctx context.Context, server string, pickupID int, logsURL string) (err error) {
+	mm_atomic.AddUint64(&mmReportLogsURL.beforeReportLogsURLCounter, 1)
+	defer mm_atomic.AddUint64(&mmReportLogsURL.afterReportLogsURLCounter, 1)
+
+	if mmReportLogsURL.inspectFuncReportLogsURL != nil {
+		mmReportLogsURL.inspectFuncReportLogsURL(ctx, server, pickupID, logsURL)
+	}
+
+	mm_params := &ReporterMockReportLogsURLParams{ctx, server, pickupID, logsURL}
+
+	// Record call args
+	mmReportLogsURL.ReportLogsURLMock.mutex.Lock()
+	mmReportLogsURL.ReportLogsURLMock.callArgs = append(mmReportLogsURL.ReportLogsURLMock.callArgs, mm_params)
+	mmReportLogsURL.ReportLogsURLMock.mutex.Unlock()
+
+	for _, e := range mmReportLogsURL.ReportLogsURLMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReportLogsURL.ReportLogsURLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReportLogsURL.ReportLogsURLMock.defaultExpectation.Counter, 1)
+		mm_want := mmReportLogsURL.ReportLogsURLMock.defaultExpectation.params
+		mm_got := ReporterMockReportLogsURLParams{ctx, server, pickupID, logsURL}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReportLogsURL.t.Errorf("ReporterMock.ReportLogsURL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReportLogsURL.ReportLogsURLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReportLogsURL.t.Fatal("No results are set for the ReporterMock.ReportLogsURL")
+		}
+		return (*mm_results).err
+	}
+	if mmReportLogsURL.funcReportLogsURL != nil {
+		return mmReportLogsURL.funcReportLogsURL(ctx, server, pickupID, logsURL)
+	}
+	mmReportLogsURL.t.Fatalf("Unexpected call to ReporterMock.ReportLogsURL. %v %v %v %v", ctx, server, pickupID, logsURL)
+	return
+}
+
+// ReportLogsURLAfterCounter returns a count of finished ReporterMock.ReportLogsURL invocations
+func (mmReportLogsURL *ReporterMock) ReportLogsURLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportLogsURL.afterReportLogsURLCounter)
+}
+
+// ReportLogsURLBeforeCounter returns a count of ReporterMock.ReportLogsURL invocations
+func (mmReportLogsURL *ReporterMock) ReportLogsURLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportLogsURL.beforeReportLogsURLCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReporterMock.ReportLogsURL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReportLogsURL *mReporterMockReportLogsURL) Calls() []*ReporterMockReportLogsURLParams {
+	mmReportLogsURL.mutex.RLock()
+
+	argCopy := make([]*ReporterMockReportLogsURLParams, len(mmReportLogsURL.callArgs))
+	copy(argCopy, mmReportLogsURL.callArgs)
+
+	mmReportLogsURL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportLogsURLDone returns true if the count of the ReportLogsURL invocations corresponds
+// the number of defined expectations
+func (m *ReporterMock) MinimockReportLogsURLDone() bool {
+	for _, e := range m.ReportLogsURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportLogsURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportLogsURL != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReportLogsURLInspect logs each unmet expectation
+func (m *ReporterMock) MinimockReportLogsURLInspect() {
+	for _, e := range m.ReportLogsURLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReporterMock.ReportLogsURL with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportLogsURLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		if m.ReportLogsURLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReporterMock.ReportLogsURL")
+		} else {
+			m.t.Errorf("Expected call to ReporterMock.ReportLogsURL with params: %#v", *m.ReportLogsURLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportLogsURL != nil && mm_atomic.LoadUint64(&m.afterReportLogsURLCounter) < 1 {
+		m.t.Error("Expected call to ReporterMock.ReportLogsURL")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReporterMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockReportLogsURLInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReporterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReporterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReportLogsURLDone()
+}
@@ -0,0 +1,209 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/admin.StateLister -o ./pkg/mocks/state_lister_mock.go
+
+import (
+	sm "LogWatcher/pkg/stateMachine"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// StateListerMock implements admin.StateLister
+type StateListerMock struct {
+	t minimock.Tester
+
+	funcStates          func() (sa1 []sm.Snapshot)
+	inspectFuncStates   func()
+	afterStatesCounter  uint64
+	beforeStatesCounter uint64
+	StatesMock          mStateListerMockStates
+}
+
+// NewStateListerMock returns a mock for admin.StateLister
+func NewStateListerMock(t minimock.Tester) *StateListerMock {
+	m := &StateListerMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.StatesMock = mStateListerMockStates{mock: m}
+
+	return m
+}
+
+type mStateListerMockStates struct {
+	mock               *StateListerMock
+	defaultExpectation *StateListerMockStatesExpectation
+	expectations       []*StateListerMockStatesExpectation
+}
+
+// StateListerMockStatesExpectation specifies expectation struct of the StateLister.States
+type StateListerMockStatesExpectation struct {
+	mock *StateListerMock
+
+	results *StateListerMockStatesResults
+	Counter uint64
+}
+
+// StateListerMockStatesResults contains results of the StateLister.States
+type StateListerMockStatesResults struct {
+	sa1 []sm.Snapshot
+}
+
+// Expect sets up expected params for StateLister.States
+func (mmStates *mStateListerMockStates) Expect() *mStateListerMockStates {
+	if mmStates.mock.funcStates != nil {
+		mmStates.mock.t.Fatalf("StateListerMock.States mock is already set by Set")
+	}
+
+	if mmStates.defaultExpectation == nil {
+		mmStates.defaultExpectation = &StateListerMockStatesExpectation{}
+	}
+
+	return mmStates
+}
+
+// Inspect accepts an inspector function that has same arguments as the StateLister.States
+func (mmStates *mStateListerMockStates) Inspect(f func()) *mStateListerMockStates {
+	if mmStates.mock.inspectFuncStates != nil {
+		mmStates.mock.t.Fatalf("Inspect function is already set for StateListerMock.States")
+	}
+
+	mmStates.mock.inspectFuncStates = f
+
+	return mmStates
+}
+
+// Return sets up results that will be returned by StateLister.States
+func (mmStates *mStateListerMockStates) Return(sa1 []sm.Snapshot) *StateListerMock {
+	if mmStates.mock.funcStates != nil {
+		mmStates.mock.t.Fatalf("StateListerMock.States mock is already set by Set")
+	}
+
+	if mmStates.defaultExpectation == nil {
+		mmStates.defaultExpectation = &StateListerMockStatesExpectation{mock: mmStates.mock}
+	}
+	mmStates.defaultExpectation.results = &StateListerMockStatesResults{sa1}
+	return mmStates.mock
+}
+
+//Set uses given function f to mock the StateLister.States method
+func (mmStates *mStateListerMockStates) Set(f func() (sa1 []sm.Snapshot)) *StateListerMock {
+	if mmStates.defaultExpectation != nil {
+		mmStates.mock.t.Fatalf("Default expectation is already set for the StateLister.States method")
+	}
+
+	if len(mmStates.expectations) > 0 {
+		mmStates.mock.t.Fatalf("Some expectations are already set for the StateLister.States method")
+	}
+
+	mmStates.mock.funcStates = f
+	return mmStates.mock
+}
+
+// States implements admin.StateLister
+func (mmStates *StateListerMock) States() (sa1 []sm.Snapshot) {
+	mm_atomic.AddUint64(&mmStates.beforeStatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmStates.afterStatesCounter, 1)
+
+	if mmStates.inspectFuncStates != nil {
+		mmStates.inspectFuncStates()
+	}
+
+	if mmStates.StatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStates.StatesMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmStates.StatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStates.t.Fatal("No results are set for the StateListerMock.States")
+		}
+		return (*mm_results).sa1
+	}
+	if mmStates.funcStates != nil {
+		return mmStates.funcStates()
+	}
+	mmStates.t.Fatalf("Unexpected call to StateListerMock.States.")
+	return
+}
+
+// StatesAfterCounter returns a count of finished StateListerMock.States invocations
+func (mmStates *StateListerMock) StatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStates.afterStatesCounter)
+}
+
+// StatesBeforeCounter returns a count of StateListerMock.States invocations
+func (mmStates *StateListerMock) StatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStates.beforeStatesCounter)
+}
+
+// MinimockStatesDone returns true if the count of the States invocations corresponds
+// the number of defined expectations
+func (m *StateListerMock) MinimockStatesDone() bool {
+	for _, e := range m.StatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStates != nil && mm_atomic.LoadUint64(&m.afterStatesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStatesInspect logs each unmet expectation
+func (m *StateListerMock) MinimockStatesInspect() {
+	for _, e := range m.StatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to StateListerMock.States")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatesCounter) < 1 {
+		m.t.Error("Expected call to StateListerMock.States")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStates != nil && mm_atomic.LoadUint64(&m.afterStatesCounter) < 1 {
+		m.t.Error("Expected call to StateListerMock.States")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StateListerMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockStatesInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StateListerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StateListerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockStatesDone()
+}
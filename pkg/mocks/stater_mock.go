@@ -5,13 +5,9 @@ package mocks
 //go:generate minimock -i LogWatcher/pkg/stateMachine.Stater -o ./pkg/mocks/stater_mock.go
 
 import (
-	"LogWatcher/pkg/mongo"
-	"LogWatcher/pkg/requests"
-	"LogWatcher/pkg/server"
-	mm_stateMachine "LogWatcher/pkg/stateMachine"
-	"LogWatcher/pkg/stats"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -21,30 +17,6 @@ import (
 type StaterMock struct {
 	t minimock.Tester
 
-	funcChannel          func() (ch1 chan string)
-	inspectFuncChannel   func()
-	afterChannelCounter  uint64
-	beforeChannelCounter uint64
-	ChannelMock          mStaterMockChannel
-
-	funcFile          func() (l1 server.LogFiler)
-	inspectFuncFile   func()
-	afterFileCounter  uint64
-	beforeFileCounter uint64
-	FileMock          mStaterMockFile
-
-	funcInserter          func() (i1 mongo.Inserter)
-	inspectFuncInserter   func()
-	afterInserterCounter  uint64
-	beforeInserterCounter uint64
-	InserterMock          mStaterMockInserter
-
-	funcMatch          func() (m1 stats.Matcher)
-	inspectFuncMatch   func()
-	afterMatchCounter  uint64
-	beforeMatchCounter uint64
-	MatchMock          mStaterMockMatch
-
 	funcProcessGameLogLine          func(msg string)
 	inspectFuncProcessGameLogLine   func(msg string)
 	afterProcessGameLogLineCounter  uint64
@@ -69,11 +41,11 @@ type StaterMock struct {
 	beforeProcessLogLineCounter uint64
 	ProcessLogLineMock          mStaterMockProcessLogLine
 
-	funcSetState          func(state mm_stateMachine.StateType)
-	inspectFuncSetState   func(state mm_stateMachine.StateType)
-	afterSetStateCounter  uint64
-	beforeSetStateCounter uint64
-	SetStateMock          mStaterMockSetState
+	funcRecover          func(resumeWindow time.Duration) (err error)
+	inspectFuncRecover   func(resumeWindow time.Duration)
+	afterRecoverCounter  uint64
+	beforeRecoverCounter uint64
+	RecoverMock          mStaterMockRecover
 
 	funcStartWorker          func()
 	inspectFuncStartWorker   func()
@@ -81,23 +53,23 @@ type StaterMock struct {
 	beforeStartWorkerCounter uint64
 	StartWorkerMock          mStaterMockStartWorker
 
-	funcState          func() (s1 mm_stateMachine.StateType)
-	inspectFuncState   func()
-	afterStateCounter  uint64
-	beforeStateCounter uint64
-	StateMock          mStaterMockState
+	funcStop          func(discard bool)
+	inspectFuncStop   func(discard bool)
+	afterStopCounter  uint64
+	beforeStopCounter uint64
+	StopMock          mStaterMockStop
+
+	funcSuspend          func()
+	inspectFuncSuspend   func()
+	afterSuspendCounter  uint64
+	beforeSuspendCounter uint64
+	SuspendMock          mStaterMockSuspend
 
 	funcUpdatePickupInfo          func() (err error)
 	inspectFuncUpdatePickupInfo   func()
 	afterUpdatePickupInfoCounter  uint64
 	beforeUpdatePickupInfoCounter uint64
 	UpdatePickupInfoMock          mStaterMockUpdatePickupInfo
-
-	funcUploader          func() (l1 requests.LogUploader)
-	inspectFuncUploader   func()
-	afterUploaderCounter  uint64
-	beforeUploaderCounter uint64
-	UploaderMock          mStaterMockUploader
 }
 
 // NewStaterMock returns a mock for stateMachine.Stater
@@ -107,14 +79,6 @@ func NewStaterMock(t minimock.Tester) *StaterMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.ChannelMock = mStaterMockChannel{mock: m}
-
-	m.FileMock = mStaterMockFile{mock: m}
-
-	m.InserterMock = mStaterMockInserter{mock: m}
-
-	m.MatchMock = mStaterMockMatch{mock: m}
-
 	m.ProcessGameLogLineMock = mStaterMockProcessGameLogLine{mock: m}
 	m.ProcessGameLogLineMock.callArgs = []*StaterMockProcessGameLogLineParams{}
 
@@ -127,592 +91,21 @@ func NewStaterMock(t minimock.Tester) *StaterMock {
 	m.ProcessLogLineMock = mStaterMockProcessLogLine{mock: m}
 	m.ProcessLogLineMock.callArgs = []*StaterMockProcessLogLineParams{}
 
-	m.SetStateMock = mStaterMockSetState{mock: m}
-	m.SetStateMock.callArgs = []*StaterMockSetStateParams{}
+	m.RecoverMock = mStaterMockRecover{mock: m}
+	m.RecoverMock.callArgs = []*StaterMockRecoverParams{}
 
 	m.StartWorkerMock = mStaterMockStartWorker{mock: m}
 
-	m.StateMock = mStaterMockState{mock: m}
+	m.StopMock = mStaterMockStop{mock: m}
+	m.StopMock.callArgs = []*StaterMockStopParams{}
 
-	m.UpdatePickupInfoMock = mStaterMockUpdatePickupInfo{mock: m}
+	m.SuspendMock = mStaterMockSuspend{mock: m}
 
-	m.UploaderMock = mStaterMockUploader{mock: m}
+	m.UpdatePickupInfoMock = mStaterMockUpdatePickupInfo{mock: m}
 
 	return m
 }
 
-type mStaterMockChannel struct {
-	mock               *StaterMock
-	defaultExpectation *StaterMockChannelExpectation
-	expectations       []*StaterMockChannelExpectation
-}
-
-// StaterMockChannelExpectation specifies expectation struct of the Stater.Channel
-type StaterMockChannelExpectation struct {
-	mock *StaterMock
-
-	results *StaterMockChannelResults
-	Counter uint64
-}
-
-// StaterMockChannelResults contains results of the Stater.Channel
-type StaterMockChannelResults struct {
-	ch1 chan string
-}
-
-// Expect sets up expected params for Stater.Channel
-func (mmChannel *mStaterMockChannel) Expect() *mStaterMockChannel {
-	if mmChannel.mock.funcChannel != nil {
-		mmChannel.mock.t.Fatalf("StaterMock.Channel mock is already set by Set")
-	}
-
-	if mmChannel.defaultExpectation == nil {
-		mmChannel.defaultExpectation = &StaterMockChannelExpectation{}
-	}
-
-	return mmChannel
-}
-
-// Inspect accepts an inspector function that has same arguments as the Stater.Channel
-func (mmChannel *mStaterMockChannel) Inspect(f func()) *mStaterMockChannel {
-	if mmChannel.mock.inspectFuncChannel != nil {
-		mmChannel.mock.t.Fatalf("Inspect function is already set for StaterMock.Channel")
-	}
-
-	mmChannel.mock.inspectFuncChannel = f
-
-	return mmChannel
-}
-
-// Return sets up results that will be returned by Stater.Channel
-func (mmChannel *mStaterMockChannel) Return(ch1 chan string) *StaterMock {
-	if mmChannel.mock.funcChannel != nil {
-		mmChannel.mock.t.Fatalf("StaterMock.Channel mock is already set by Set")
-	}
-
-	if mmChannel.defaultExpectation == nil {
-		mmChannel.defaultExpectation = &StaterMockChannelExpectation{mock: mmChannel.mock}
-	}
-	mmChannel.defaultExpectation.results = &StaterMockChannelResults{ch1}
-	return mmChannel.mock
-}
-
-//Set uses given function f to mock the Stater.Channel method
-func (mmChannel *mStaterMockChannel) Set(f func() (ch1 chan string)) *StaterMock {
-	if mmChannel.defaultExpectation != nil {
-		mmChannel.mock.t.Fatalf("Default expectation is already set for the Stater.Channel method")
-	}
-
-	if len(mmChannel.expectations) > 0 {
-		mmChannel.mock.t.Fatalf("Some expectations are already set for the Stater.Channel method")
-	}
-
-	mmChannel.mock.funcChannel = f
-	return mmChannel.mock
-}
-
-// Channel implements stateMachine.Stater
-func (mmChannel *StaterMock) Channel() (ch1 chan string) {
-	mm_atomic.AddUint64(&mmChannel.beforeChannelCounter, 1)
-	defer mm_atomic.AddUint64(&mmChannel.afterChannelCounter, 1)
-
-	if mmChannel.inspectFuncChannel != nil {
-		mmChannel.inspectFuncChannel()
-	}
-
-	if mmChannel.ChannelMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmChannel.ChannelMock.defaultExpectation.Counter, 1)
-
-		mm_results := mmChannel.ChannelMock.defaultExpectation.results
-		if mm_results == nil {
-			mmChannel.t.Fatal("No results are set for the StaterMock.Channel")
-		}
-		return (*mm_results).ch1
-	}
-	if mmChannel.funcChannel != nil {
-		return mmChannel.funcChannel()
-	}
-	mmChannel.t.Fatalf("Unexpected call to StaterMock.Channel.")
-	return
-}
-
-// ChannelAfterCounter returns a count of finished StaterMock.Channel invocations
-func (mmChannel *StaterMock) ChannelAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmChannel.afterChannelCounter)
-}
-
-// ChannelBeforeCounter returns a count of StaterMock.Channel invocations
-func (mmChannel *StaterMock) ChannelBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmChannel.beforeChannelCounter)
-}
-
-// MinimockChannelDone returns true if the count of the Channel invocations corresponds
-// the number of defined expectations
-func (m *StaterMock) MinimockChannelDone() bool {
-	for _, e := range m.ChannelMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ChannelMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterChannelCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcChannel != nil && mm_atomic.LoadUint64(&m.afterChannelCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockChannelInspect logs each unmet expectation
-func (m *StaterMock) MinimockChannelInspect() {
-	for _, e := range m.ChannelMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.Channel")
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.ChannelMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterChannelCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Channel")
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcChannel != nil && mm_atomic.LoadUint64(&m.afterChannelCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Channel")
-	}
-}
-
-type mStaterMockFile struct {
-	mock               *StaterMock
-	defaultExpectation *StaterMockFileExpectation
-	expectations       []*StaterMockFileExpectation
-}
-
-// StaterMockFileExpectation specifies expectation struct of the Stater.File
-type StaterMockFileExpectation struct {
-	mock *StaterMock
-
-	results *StaterMockFileResults
-	Counter uint64
-}
-
-// StaterMockFileResults contains results of the Stater.File
-type StaterMockFileResults struct {
-	l1 server.LogFiler
-}
-
-// Expect sets up expected params for Stater.File
-func (mmFile *mStaterMockFile) Expect() *mStaterMockFile {
-	if mmFile.mock.funcFile != nil {
-		mmFile.mock.t.Fatalf("StaterMock.File mock is already set by Set")
-	}
-
-	if mmFile.defaultExpectation == nil {
-		mmFile.defaultExpectation = &StaterMockFileExpectation{}
-	}
-
-	return mmFile
-}
-
-// Inspect accepts an inspector function that has same arguments as the Stater.File
-func (mmFile *mStaterMockFile) Inspect(f func()) *mStaterMockFile {
-	if mmFile.mock.inspectFuncFile != nil {
-		mmFile.mock.t.Fatalf("Inspect function is already set for StaterMock.File")
-	}
-
-	mmFile.mock.inspectFuncFile = f
-
-	return mmFile
-}
-
-// Return sets up results that will be returned by Stater.File
-func (mmFile *mStaterMockFile) Return(l1 server.LogFiler) *StaterMock {
-	if mmFile.mock.funcFile != nil {
-		mmFile.mock.t.Fatalf("StaterMock.File mock is already set by Set")
-	}
-
-	if mmFile.defaultExpectation == nil {
-		mmFile.defaultExpectation = &StaterMockFileExpectation{mock: mmFile.mock}
-	}
-	mmFile.defaultExpectation.results = &StaterMockFileResults{l1}
-	return mmFile.mock
-}
-
-//Set uses given function f to mock the Stater.File method
-func (mmFile *mStaterMockFile) Set(f func() (l1 server.LogFiler)) *StaterMock {
-	if mmFile.defaultExpectation != nil {
-		mmFile.mock.t.Fatalf("Default expectation is already set for the Stater.File method")
-	}
-
-	if len(mmFile.expectations) > 0 {
-		mmFile.mock.t.Fatalf("Some expectations are already set for the Stater.File method")
-	}
-
-	mmFile.mock.funcFile = f
-	return mmFile.mock
-}
-
-// File implements stateMachine.Stater
-func (mmFile *StaterMock) File() (l1 server.LogFiler) {
-	mm_atomic.AddUint64(&mmFile.beforeFileCounter, 1)
-	defer mm_atomic.AddUint64(&mmFile.afterFileCounter, 1)
-
-	if mmFile.inspectFuncFile != nil {
-		mmFile.inspectFuncFile()
-	}
-
-	if mmFile.FileMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmFile.FileMock.defaultExpectation.Counter, 1)
-
-		mm_results := mmFile.FileMock.defaultExpectation.results
-		if mm_results == nil {
-			mmFile.t.Fatal("No results are set for the StaterMock.File")
-		}
-		return (*mm_results).l1
-	}
-	if mmFile.funcFile != nil {
-		return mmFile.funcFile()
-	}
-	mmFile.t.Fatalf("Unexpected call to StaterMock.File.")
-	return
-}
-
-// FileAfterCounter returns a count of finished StaterMock.File invocations
-func (mmFile *StaterMock) FileAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFile.afterFileCounter)
-}
-
-// FileBeforeCounter returns a count of StaterMock.File invocations
-func (mmFile *StaterMock) FileBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmFile.beforeFileCounter)
-}
-
-// MinimockFileDone returns true if the count of the File invocations corresponds
-// the number of defined expectations
-func (m *StaterMock) MinimockFileDone() bool {
-	for _, e := range m.FileMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FileMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFileCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFile != nil && mm_atomic.LoadUint64(&m.afterFileCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockFileInspect logs each unmet expectation
-func (m *StaterMock) MinimockFileInspect() {
-	for _, e := range m.FileMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.File")
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.FileMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFileCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.File")
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcFile != nil && mm_atomic.LoadUint64(&m.afterFileCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.File")
-	}
-}
-
-type mStaterMockInserter struct {
-	mock               *StaterMock
-	defaultExpectation *StaterMockInserterExpectation
-	expectations       []*StaterMockInserterExpectation
-}
-
-// StaterMockInserterExpectation specifies expectation struct of the Stater.Inserter
-type StaterMockInserterExpectation struct {
-	mock *StaterMock
-
-	results *StaterMockInserterResults
-	Counter uint64
-}
-
-// StaterMockInserterResults contains results of the Stater.Inserter
-type StaterMockInserterResults struct {
-	i1 mongo.Inserter
-}
-
-// Expect sets up expected params for Stater.Inserter
-func (mmInserter *mStaterMockInserter) Expect() *mStaterMockInserter {
-	if mmInserter.mock.funcInserter != nil {
-		mmInserter.mock.t.Fatalf("StaterMock.Inserter mock is already set by Set")
-	}
-
-	if mmInserter.defaultExpectation == nil {
-		mmInserter.defaultExpectation = &StaterMockInserterExpectation{}
-	}
-
-	return mmInserter
-}
-
-// Inspect accepts an inspector function that has same arguments as the Stater.Inserter
-func (mmInserter *mStaterMockInserter) Inspect(f func()) *mStaterMockInserter {
-	if mmInserter.mock.inspectFuncInserter != nil {
-		mmInserter.mock.t.Fatalf("Inspect function is already set for StaterMock.Inserter")
-	}
-
-	mmInserter.mock.inspectFuncInserter = f
-
-	return mmInserter
-}
-
-// Return sets up results that will be returned by Stater.Inserter
-func (mmInserter *mStaterMockInserter) Return(i1 mongo.Inserter) *StaterMock {
-	if mmInserter.mock.funcInserter != nil {
-		mmInserter.mock.t.Fatalf("StaterMock.Inserter mock is already set by Set")
-	}
-
-	if mmInserter.defaultExpectation == nil {
-		mmInserter.defaultExpectation = &StaterMockInserterExpectation{mock: mmInserter.mock}
-	}
-	mmInserter.defaultExpectation.results = &StaterMockInserterResults{i1}
-	return mmInserter.mock
-}
-
-//Set uses given function f to mock the Stater.Inserter method
-func (mmInserter *mStaterMockInserter) Set(f func() (i1 mongo.Inserter)) *StaterMock {
-	if mmInserter.defaultExpectation != nil {
-		mmInserter.mock.t.Fatalf("Default expectation is already set for the Stater.Inserter method")
-	}
-
-	if len(mmInserter.expectations) > 0 {
-		mmInserter.mock.t.Fatalf("Some expectations are already set for the Stater.Inserter method")
-	}
-
-	mmInserter.mock.funcInserter = f
-	return mmInserter.mock
-}
-
-// Inserter implements stateMachine.Stater
-func (mmInserter *StaterMock) Inserter() (i1 mongo.Inserter) {
-	mm_atomic.AddUint64(&mmInserter.beforeInserterCounter, 1)
-	defer mm_atomic.AddUint64(&mmInserter.afterInserterCounter, 1)
-
-	if mmInserter.inspectFuncInserter != nil {
-		mmInserter.inspectFuncInserter()
-	}
-
-	if mmInserter.InserterMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmInserter.InserterMock.defaultExpectation.Counter, 1)
-
-		mm_results := mmInserter.InserterMock.defaultExpectation.results
-		if mm_results == nil {
-			mmInserter.t.Fatal("No results are set for the StaterMock.Inserter")
-		}
-		return (*mm_results).i1
-	}
-	if mmInserter.funcInserter != nil {
-		return mmInserter.funcInserter()
-	}
-	mmInserter.t.Fatalf("Unexpected call to StaterMock.Inserter.")
-	return
-}
-
-// InserterAfterCounter returns a count of finished StaterMock.Inserter invocations
-func (mmInserter *StaterMock) InserterAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmInserter.afterInserterCounter)
-}
-
-// InserterBeforeCounter returns a count of StaterMock.Inserter invocations
-func (mmInserter *StaterMock) InserterBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmInserter.beforeInserterCounter)
-}
-
-// MinimockInserterDone returns true if the count of the Inserter invocations corresponds
-// the number of defined expectations
-func (m *StaterMock) MinimockInserterDone() bool {
-	for _, e := range m.InserterMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.InserterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInserterCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcInserter != nil && mm_atomic.LoadUint64(&m.afterInserterCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockInserterInspect logs each unmet expectation
-func (m *StaterMock) MinimockInserterInspect() {
-	for _, e := range m.InserterMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.Inserter")
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.InserterMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInserterCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Inserter")
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcInserter != nil && mm_atomic.LoadUint64(&m.afterInserterCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Inserter")
-	}
-}
-
-type mStaterMockMatch struct {
-	mock               *StaterMock
-	defaultExpectation *StaterMockMatchExpectation
-	expectations       []*StaterMockMatchExpectation
-}
-
-// StaterMockMatchExpectation specifies expectation struct of the Stater.Match
-type StaterMockMatchExpectation struct {
-	mock *StaterMock
-
-	results *StaterMockMatchResults
-	Counter uint64
-}
-
-// StaterMockMatchResults contains results of the Stater.Match
-type StaterMockMatchResults struct {
-	m1 stats.Matcher
-}
-
-// Expect sets up expected params for Stater.Match
-func (mmMatch *mStaterMockMatch) Expect() *mStaterMockMatch {
-	if mmMatch.mock.funcMatch != nil {
-		mmMatch.mock.t.Fatalf("StaterMock.Match mock is already set by Set")
-	}
-
-	if mmMatch.defaultExpectation == nil {
-		mmMatch.defaultExpectation = &StaterMockMatchExpectation{}
-	}
-
-	return mmMatch
-}
-
-// Inspect accepts an inspector function that has same arguments as the Stater.Match
-func (mmMatch *mStaterMockMatch) Inspect(f func()) *mStaterMockMatch {
-	if mmMatch.mock.inspectFuncMatch != nil {
-		mmMatch.mock.t.Fatalf("Inspect function is already set for StaterMock.Match")
-	}
-
-	mmMatch.mock.inspectFuncMatch = f
-
-	return mmMatch
-}
-
-// Return sets up results that will be returned by Stater.Match
-func (mmMatch *mStaterMockMatch) Return(m1 stats.Matcher) *StaterMock {
-	if mmMatch.mock.funcMatch != nil {
-		mmMatch.mock.t.Fatalf("StaterMock.Match mock is already set by Set")
-	}
-
-	if mmMatch.defaultExpectation == nil {
-		mmMatch.defaultExpectation = &StaterMockMatchExpectation{mock: mmMatch.mock}
-	}
-	mmMatch.defaultExpectation.results = &StaterMockMatchResults{m1}
-	return mmMatch.mock
-}
-
-//Set uses given function f to mock the Stater.Match method
-func (mmMatch *mStaterMockMatch) Set(f func() (m1 stats.Matcher)) *StaterMock {
-	if mmMatch.defaultExpectation != nil {
-		mmMatch.mock.t.Fatalf("Default expectation is already set for the Stater.Match method")
-	}
-
-	if len(mmMatch.expectations) > 0 {
-		mmMatch.mock.t.Fatalf("Some expectations are already set for the Stater.Match method")
-	}
-
-	mmMatch.mock.funcMatch = f
-	return mmMatch.mock
-}
-
-// Match implements stateMachine.Stater
-func (mmMatch *StaterMock) Match() (m1 stats.Matcher) {
-	mm_atomic.AddUint64(&mmMatch.beforeMatchCounter, 1)
-	defer mm_atomic.AddUint64(&mmMatch.afterMatchCounter, 1)
-
-	if mmMatch.inspectFuncMatch != nil {
-		mmMatch.inspectFuncMatch()
-	}
-
-	if mmMatch.MatchMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMatch.MatchMock.defaultExpectation.Counter, 1)
-
-		mm_results := mmMatch.MatchMock.defaultExpectation.results
-		if mm_results == nil {
-			mmMatch.t.Fatal("No results are set for the StaterMock.Match")
-		}
-		return (*mm_results).m1
-	}
-	if mmMatch.funcMatch != nil {
-		return mmMatch.funcMatch()
-	}
-	mmMatch.t.Fatalf("Unexpected call to StaterMock.Match.")
-	return
-}
-
-// MatchAfterCounter returns a count of finished StaterMock.Match invocations
-func (mmMatch *StaterMock) MatchAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMatch.afterMatchCounter)
-}
-
-// MatchBeforeCounter returns a count of StaterMock.Match invocations
-func (mmMatch *StaterMock) MatchBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMatch.beforeMatchCounter)
-}
-
-// MinimockMatchDone returns true if the count of the Match invocations corresponds
-// the number of defined expectations
-func (m *StaterMock) MinimockMatchDone() bool {
-	for _, e := range m.MatchMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.MatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMatchCounter) < 1 {
-		return false
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcMatch != nil && mm_atomic.LoadUint64(&m.afterMatchCounter) < 1 {
-		return false
-	}
-	return true
-}
-
-// MinimockMatchInspect logs each unmet expectation
-func (m *StaterMock) MinimockMatchInspect() {
-	for _, e := range m.MatchMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.Match")
-		}
-	}
-
-	// if default expectation was set then invocations count should be greater than zero
-	if m.MatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMatchCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Match")
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcMatch != nil && mm_atomic.LoadUint64(&m.afterMatchCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Match")
-	}
-}
-
 type mStaterMockProcessGameLogLine struct {
 	mock               *StaterMock
 	defaultExpectation *StaterMockProcessGameLogLineExpectation
@@ -1461,190 +854,218 @@ func (m *StaterMock) MinimockProcessLogLineInspect() {
 	}
 }
 
-type mStaterMockSetState struct {
+type mStaterMockRecover struct {
 	mock               *StaterMock
-	defaultExpectation *StaterMockSetStateExpectation
-	expectations       []*StaterMockSetStateExpectation
+	defaultExpectation *StaterMockRecoverExpectation
+	expectations       []*StaterMockRecoverExpectation
 
-	callArgs []*StaterMockSetStateParams
+	callArgs []*StaterMockRecoverParams
 	mutex    sync.RWMutex
 }
 
-// StaterMockSetStateExpectation specifies expectation struct of the Stater.SetState
-type StaterMockSetStateExpectation struct {
-	mock   *StaterMock
-	params *StaterMockSetStateParams
-
+// StaterMockRecoverExpectation specifies expectation struct of the Stater.Recover
+type StaterMockRecoverExpectation struct {
+	mock    *StaterMock
+	params  *StaterMockRecoverParams
+	results *StaterMockRecoverResults
 	Counter uint64
 }
 
-// StaterMockSetStateParams contains parameters of the Stater.SetState
-type StaterMockSetStateParams struct {
-	state mm_stateMachine.StateType
+// StaterMockRecoverParams contains parameters of the Stater.Recover
+type StaterMockRecoverParams struct {
+	resumeWindow time.Duration
+}
+
+// StaterMockRecoverResults contains results of the Stater.Recover
+type StaterMockRecoverResults struct {
+	err error
 }
 
-// Expect sets up expected params for Stater.SetState
-func (mmSetState *mStaterMockSetState) Expect(state mm_stateMachine.StateType) *mStaterMockSetState {
-	if mmSetState.mock.funcSetState != nil {
-		mmSetState.mock.t.Fatalf("StaterMock.SetState mock is already set by Set")
+// Expect sets up expected params for Stater.Recover
+func (mmRecover *mStaterMockRecover) Expect(resumeWindow time.Duration) *mStaterMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("StaterMock.Recover mock is already set by Set")
 	}
 
-	if mmSetState.defaultExpectation == nil {
-		mmSetState.defaultExpectation = &StaterMockSetStateExpectation{}
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &StaterMockRecoverExpectation{}
 	}
 
-	mmSetState.defaultExpectation.params = &StaterMockSetStateParams{state}
-	for _, e := range mmSetState.expectations {
-		if minimock.Equal(e.params, mmSetState.defaultExpectation.params) {
-			mmSetState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetState.defaultExpectation.params)
+	mmRecover.defaultExpectation.params = &StaterMockRecoverParams{resumeWindow}
+	for _, e := range mmRecover.expectations {
+		if minimock.Equal(e.params, mmRecover.defaultExpectation.params) {
+			mmRecover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecover.defaultExpectation.params)
 		}
 	}
 
-	return mmSetState
+	return mmRecover
 }
 
-// Inspect accepts an inspector function that has same arguments as the Stater.SetState
-func (mmSetState *mStaterMockSetState) Inspect(f func(state mm_stateMachine.StateType)) *mStaterMockSetState {
-	if mmSetState.mock.inspectFuncSetState != nil {
-		mmSetState.mock.t.Fatalf("Inspect function is already set for StaterMock.SetState")
+// Inspect accepts an inspector function that has same arguments as the Stater.Recover
+func (mmRecover *mStaterMockRecover) Inspect(f func(resumeWindow time.Duration)) *mStaterMockRecover {
+	if mmRecover.mock.inspectFuncRecover != nil {
+		mmRecover.mock.t.Fatalf("Inspect function is already set for StaterMock.Recover")
 	}
 
-	mmSetState.mock.inspectFuncSetState = f
+	mmRecover.mock.inspectFuncRecover = f
 
-	return mmSetState
+	return mmRecover
 }
 
-// Return sets up results that will be returned by Stater.SetState
-func (mmSetState *mStaterMockSetState) Return() *StaterMock {
-	if mmSetState.mock.funcSetState != nil {
-		mmSetState.mock.t.Fatalf("StaterMock.SetState mock is already set by Set")
+// Return sets up results that will be returned by Stater.Recover
+func (mmRecover *mStaterMockRecover) Return(err error) *StaterMock {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("StaterMock.Recover mock is already set by Set")
 	}
 
-	if mmSetState.defaultExpectation == nil {
-		mmSetState.defaultExpectation = &StaterMockSetStateExpectation{mock: mmSetState.mock}
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &StaterMockRecoverExpectation{mock: mmRecover.mock}
+	}
+	mmRecover.defaultExpectation.results = &StaterMockRecoverResults{err}
+	return mmRecover.mock
+}
+
+//Set uses given function f to mock the Stater.Recover method
+func (mmRecover *mStaterMockRecover) Set(f func(resumeWindow time.Duration) (err error)) *StaterMock {
+	if mmRecover.defaultExpectation != nil {
+		mmRecover.mock.t.Fatalf("Default expectation is already set for the Stater.Recover method")
 	}
 
-	return mmSetState.mock
+	if len(mmRecover.expectations) > 0 {
+		mmRecover.mock.t.Fatalf("Some expectations are already set for the Stater.Recover method")
+	}
+
+	mmRecover.mock.funcRecover = f
+	return mmRecover.mock
 }
 
-//Set uses given function f to mock the Stater.SetState method
-func (mmSetState *mStaterMockSetState) Set(f func(state mm_stateMachine.StateType)) *StaterMock {
-	if mmSetState.defaultExpectation != nil {
-		mmSetState.mock.t.Fatalf("Default expectation is already set for the Stater.SetState method")
+// When sets expectation for the Stater.Recover which will trigger the result defined by the following
+// Then helper
+func (mmRecover *mStaterMockRecover) When(resumeWindow time.Duration) *StaterMockRecoverExpectation {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("StaterMock.Recover mock is already set by Set")
 	}
 
-	if len(mmSetState.expectations) > 0 {
-		mmSetState.mock.t.Fatalf("Some expectations are already set for the Stater.SetState method")
+	expectation := &StaterMockRecoverExpectation{
+		mock:   mmRecover.mock,
+		params: &StaterMockRecoverParams{resumeWindow},
 	}
+	mmRecover.expectations = append(mmRecover.expectations, expectation)
+	return expectation
+}
 
-	mmSetState.mock.funcSetState = f
-	return mmSetState.mock
+// Then sets up Stater.Recover return parameters for the expectation previously defined by the When method
+func (e *StaterMockRecoverExpectation) Then(err error) *StaterMock {
+	e.results = &StaterMockRecoverResults{err}
+	return e.mock
 }
 
-// SetState implements stateMachine.Stater
-func (mmSetState *StaterMock) SetState(state mm_stateMachine.StateType) {
-	mm_atomic.AddUint64(&mmSetState.beforeSetStateCounter, 1)
-	defer mm_atomic.AddUint64(&mmSetState.afterSetStateCounter, 1)
+// Recover implements stateMachine.Stater
+func (mmRecover *StaterMock) Recover(resumeWindow time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmRecover.beforeRecoverCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecover.afterRecoverCounter, 1)
 
-	if mmSetState.inspectFuncSetState != nil {
-		mmSetState.inspectFuncSetState(state)
+	if mmRecover.inspectFuncRecover != nil {
+		mmRecover.inspectFuncRecover(resumeWindow)
 	}
 
-	mm_params := &StaterMockSetStateParams{state}
+	mm_params := &StaterMockRecoverParams{resumeWindow}
 
 	// Record call args
-	mmSetState.SetStateMock.mutex.Lock()
-	mmSetState.SetStateMock.callArgs = append(mmSetState.SetStateMock.callArgs, mm_params)
-	mmSetState.SetStateMock.mutex.Unlock()
+	mmRecover.RecoverMock.mutex.Lock()
+	mmRecover.RecoverMock.callArgs = append(mmRecover.RecoverMock.callArgs, mm_params)
+	mmRecover.RecoverMock.mutex.Unlock()
 
-	for _, e := range mmSetState.SetStateMock.expectations {
+	for _, e := range mmRecover.RecoverMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
-	if mmSetState.SetStateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSetState.SetStateMock.defaultExpectation.Counter, 1)
-		mm_want := mmSetState.SetStateMock.defaultExpectation.params
-		mm_got := StaterMockSetStateParams{state}
+	if mmRecover.RecoverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecover.RecoverMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecover.RecoverMock.defaultExpectation.params
+		mm_got := StaterMockRecoverParams{resumeWindow}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSetState.t.Errorf("StaterMock.SetState got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRecover.t.Errorf("StaterMock.Recover got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmRecover.RecoverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecover.t.Fatal("No results are set for the StaterMock.Recover")
+		}
+		return (*mm_results).err
 	}
-	if mmSetState.funcSetState != nil {
-		mmSetState.funcSetState(state)
-		return
+	if mmRecover.funcRecover != nil {
+		return mmRecover.funcRecover(resumeWindow)
 	}
-	mmSetState.t.Fatalf("Unexpected call to StaterMock.SetState. %v", state)
-
+	mmRecover.t.Fatalf("Unexpected call to StaterMock.Recover. %v", resumeWindow)
+	return
 }
 
-// SetStateAfterCounter returns a count of finished StaterMock.SetState invocations
-func (mmSetState *StaterMock) SetStateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetState.afterSetStateCounter)
+// RecoverAfterCounter returns a count of finished StaterMock.Recover invocations
+func (mmRecover *StaterMock) RecoverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecover.afterRecoverCounter)
 }
 
-// SetStateBeforeCounter returns a count of StaterMock.SetState invocations
-func (mmSetState *StaterMock) SetStateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetState.beforeSetStateCounter)
+// RecoverBeforeCounter returns a count of StaterMock.Recover invocations
+func (mmRecover *StaterMock) RecoverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecover.beforeRecoverCounter)
 }
 
-// Calls returns a list of arguments used in each call to StaterMock.SetState.
+// Calls returns a list of arguments used in each call to StaterMock.Recover.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSetState *mStaterMockSetState) Calls() []*StaterMockSetStateParams {
-	mmSetState.mutex.RLock()
+func (mmRecover *mStaterMockRecover) Calls() []*StaterMockRecoverParams {
+	mmRecover.mutex.RLock()
 
-	argCopy := make([]*StaterMockSetStateParams, len(mmSetState.callArgs))
-	copy(argCopy, mmSetState.callArgs)
+	argCopy := make([]*StaterMockRecoverParams, len(mmRecover.callArgs))
+	copy(argCopy, mmRecover.callArgs)
 
-	mmSetState.mutex.RUnlock()
+	mmRecover.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSetStateDone returns true if the count of the SetState invocations corresponds
+// MinimockRecoverDone returns true if the count of the Recover invocations corresponds
 // the number of defined expectations
-func (m *StaterMock) MinimockSetStateDone() bool {
-	for _, e := range m.SetStateMock.expectations {
+func (m *StaterMock) MinimockRecoverDone() bool {
+	for _, e := range m.RecoverMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetStateCounter) < 1 {
+	if m.RecoverMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRecoverCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetState != nil && mm_atomic.LoadUint64(&m.afterSetStateCounter) < 1 {
+	if m.funcRecover != nil && mm_atomic.LoadUint64(&m.afterRecoverCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSetStateInspect logs each unmet expectation
-func (m *StaterMock) MinimockSetStateInspect() {
-	for _, e := range m.SetStateMock.expectations {
+// MinimockRecoverInspect logs each unmet expectation
+func (m *StaterMock) MinimockRecoverInspect() {
+	for _, e := range m.RecoverMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StaterMock.SetState with params: %#v", *e.params)
+			m.t.Errorf("Expected call to StaterMock.Recover with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetStateCounter) < 1 {
-		if m.SetStateMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to StaterMock.SetState")
+	if m.RecoverMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRecoverCounter) < 1 {
+		if m.RecoverMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StaterMock.Recover")
 		} else {
-			m.t.Errorf("Expected call to StaterMock.SetState with params: %#v", *m.SetStateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StaterMock.Recover with params: %#v", *m.RecoverMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetState != nil && mm_atomic.LoadUint64(&m.afterSetStateCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.SetState")
+	if m.funcRecover != nil && mm_atomic.LoadUint64(&m.afterRecoverCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.Recover")
 	}
 }
 
@@ -1783,446 +1204,474 @@ func (m *StaterMock) MinimockStartWorkerInspect() {
 	}
 }
 
-type mStaterMockState struct {
+type mStaterMockStop struct {
 	mock               *StaterMock
-	defaultExpectation *StaterMockStateExpectation
-	expectations       []*StaterMockStateExpectation
+	defaultExpectation *StaterMockStopExpectation
+	expectations       []*StaterMockStopExpectation
+
+	callArgs []*StaterMockStopParams
+	mutex    sync.RWMutex
 }
 
-// StaterMockStateExpectation specifies expectation struct of the Stater.State
-type StaterMockStateExpectation struct {
-	mock *StaterMock
+// StaterMockStopExpectation specifies expectation struct of the Stater.Stop
+type StaterMockStopExpectation struct {
+	mock   *StaterMock
+	params *StaterMockStopParams
 
-	results *StaterMockStateResults
 	Counter uint64
 }
 
-// StaterMockStateResults contains results of the Stater.State
-type StaterMockStateResults struct {
-	s1 mm_stateMachine.StateType
+// StaterMockStopParams contains parameters of the Stater.Stop
+type StaterMockStopParams struct {
+	discard bool
 }
 
-// Expect sets up expected params for Stater.State
-func (mmState *mStaterMockState) Expect() *mStaterMockState {
-	if mmState.mock.funcState != nil {
-		mmState.mock.t.Fatalf("StaterMock.State mock is already set by Set")
+// Expect sets up expected params for Stater.Stop
+func (mmStop *mStaterMockStop) Expect(discard bool) *mStaterMockStop {
+	if mmStop.mock.funcStop != nil {
+		mmStop.mock.t.Fatalf("StaterMock.Stop mock is already set by Set")
 	}
 
-	if mmState.defaultExpectation == nil {
-		mmState.defaultExpectation = &StaterMockStateExpectation{}
+	if mmStop.defaultExpectation == nil {
+		mmStop.defaultExpectation = &StaterMockStopExpectation{}
 	}
 
-	return mmState
+	mmStop.defaultExpectation.params = &StaterMockStopParams{discard}
+	for _, e := range mmStop.expectations {
+		if minimock.Equal(e.params, mmStop.defaultExpectation.params) {
+			mmStop.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStop.defaultExpectation.params)
+		}
+	}
+
+	return mmStop
 }
 
-// Inspect accepts an inspector function that has same arguments as the Stater.State
-func (mmState *mStaterMockState) Inspect(f func()) *mStaterMockState {
-	if mmState.mock.inspectFuncState != nil {
-		mmState.mock.t.Fatalf("Inspect function is already set for StaterMock.State")
+// Inspect accepts an inspector function that has same arguments as the Stater.Stop
+func (mmStop *mStaterMockStop) Inspect(f func(discard bool)) *mStaterMockStop {
+	if mmStop.mock.inspectFuncStop != nil {
+		mmStop.mock.t.Fatalf("Inspect function is already set for StaterMock.Stop")
 	}
 
-	mmState.mock.inspectFuncState = f
+	mmStop.mock.inspectFuncStop = f
 
-	return mmState
+	return mmStop
 }
 
-// Return sets up results that will be returned by Stater.State
-func (mmState *mStaterMockState) Return(s1 mm_stateMachine.StateType) *StaterMock {
-	if mmState.mock.funcState != nil {
-		mmState.mock.t.Fatalf("StaterMock.State mock is already set by Set")
+// Return sets up results that will be returned by Stater.Stop
+func (mmStop *mStaterMockStop) Return() *StaterMock {
+	if mmStop.mock.funcStop != nil {
+		mmStop.mock.t.Fatalf("StaterMock.Stop mock is already set by Set")
 	}
 
-	if mmState.defaultExpectation == nil {
-		mmState.defaultExpectation = &StaterMockStateExpectation{mock: mmState.mock}
+	if mmStop.defaultExpectation == nil {
+		mmStop.defaultExpectation = &StaterMockStopExpectation{mock: mmStop.mock}
 	}
-	mmState.defaultExpectation.results = &StaterMockStateResults{s1}
-	return mmState.mock
+
+	return mmStop.mock
 }
 
-//Set uses given function f to mock the Stater.State method
-func (mmState *mStaterMockState) Set(f func() (s1 mm_stateMachine.StateType)) *StaterMock {
-	if mmState.defaultExpectation != nil {
-		mmState.mock.t.Fatalf("Default expectation is already set for the Stater.State method")
+//Set uses given function f to mock the Stater.Stop method
+func (mmStop *mStaterMockStop) Set(f func(discard bool)) *StaterMock {
+	if mmStop.defaultExpectation != nil {
+		mmStop.mock.t.Fatalf("Default expectation is already set for the Stater.Stop method")
 	}
 
-	if len(mmState.expectations) > 0 {
-		mmState.mock.t.Fatalf("Some expectations are already set for the Stater.State method")
+	if len(mmStop.expectations) > 0 {
+		mmStop.mock.t.Fatalf("Some expectations are already set for the Stater.Stop method")
 	}
 
-	mmState.mock.funcState = f
-	return mmState.mock
+	mmStop.mock.funcStop = f
+	return mmStop.mock
 }
 
-// State implements stateMachine.Stater
-func (mmState *StaterMock) State() (s1 mm_stateMachine.StateType) {
-	mm_atomic.AddUint64(&mmState.beforeStateCounter, 1)
-	defer mm_atomic.AddUint64(&mmState.afterStateCounter, 1)
+// Stop implements stateMachine.Stater
+func (mmStop *StaterMock) Stop(discard bool) {
+	mm_atomic.AddUint64(&mmStop.beforeStopCounter, 1)
+	defer mm_atomic.AddUint64(&mmStop.afterStopCounter, 1)
 
-	if mmState.inspectFuncState != nil {
-		mmState.inspectFuncState()
+	if mmStop.inspectFuncStop != nil {
+		mmStop.inspectFuncStop(discard)
 	}
 
-	if mmState.StateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmState.StateMock.defaultExpectation.Counter, 1)
+	mm_params := &StaterMockStopParams{discard}
 
-		mm_results := mmState.StateMock.defaultExpectation.results
-		if mm_results == nil {
-			mmState.t.Fatal("No results are set for the StaterMock.State")
+	// Record call args
+	mmStop.StopMock.mutex.Lock()
+	mmStop.StopMock.callArgs = append(mmStop.StopMock.callArgs, mm_params)
+	mmStop.StopMock.mutex.Unlock()
+
+	for _, e := range mmStop.StopMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
 		}
-		return (*mm_results).s1
 	}
-	if mmState.funcState != nil {
-		return mmState.funcState()
+
+	if mmStop.StopMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStop.StopMock.defaultExpectation.Counter, 1)
+		mm_want := mmStop.StopMock.defaultExpectation.params
+		mm_got := StaterMockStopParams{discard}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStop.t.Errorf("StaterMock.Stop got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
 	}
-	mmState.t.Fatalf("Unexpected call to StaterMock.State.")
-	return
+	if mmStop.funcStop != nil {
+		mmStop.funcStop(discard)
+		return
+	}
+	mmStop.t.Fatalf("Unexpected call to StaterMock.Stop. %v", discard)
+
 }
 
-// StateAfterCounter returns a count of finished StaterMock.State invocations
-func (mmState *StaterMock) StateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmState.afterStateCounter)
+// StopAfterCounter returns a count of finished StaterMock.Stop invocations
+func (mmStop *StaterMock) StopAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStop.afterStopCounter)
 }
 
-// StateBeforeCounter returns a count of StaterMock.State invocations
-func (mmState *StaterMock) StateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmState.beforeStateCounter)
+// StopBeforeCounter returns a count of StaterMock.Stop invocations
+func (mmStop *StaterMock) StopBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStop.beforeStopCounter)
 }
 
-// MinimockStateDone returns true if the count of the State invocations corresponds
+// Calls returns a list of arguments used in each call to StaterMock.Stop.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStop *mStaterMockStop) Calls() []*StaterMockStopParams {
+	mmStop.mutex.RLock()
+
+	argCopy := make([]*StaterMockStopParams, len(mmStop.callArgs))
+	copy(argCopy, mmStop.callArgs)
+
+	mmStop.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStopDone returns true if the count of the Stop invocations corresponds
 // the number of defined expectations
-func (m *StaterMock) MinimockStateDone() bool {
-	for _, e := range m.StateMock.expectations {
+func (m *StaterMock) MinimockStopDone() bool {
+	for _, e := range m.StopMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.StateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStateCounter) < 1 {
+	if m.StopMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcState != nil && mm_atomic.LoadUint64(&m.afterStateCounter) < 1 {
+	if m.funcStop != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockStateInspect logs each unmet expectation
-func (m *StaterMock) MinimockStateInspect() {
-	for _, e := range m.StateMock.expectations {
+// MinimockStopInspect logs each unmet expectation
+func (m *StaterMock) MinimockStopInspect() {
+	for _, e := range m.StopMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.State")
+			m.t.Errorf("Expected call to StaterMock.Stop with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.StateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStateCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.State")
+	if m.StopMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		if m.StopMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StaterMock.Stop")
+		} else {
+			m.t.Errorf("Expected call to StaterMock.Stop with params: %#v", *m.StopMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcState != nil && mm_atomic.LoadUint64(&m.afterStateCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.State")
+	if m.funcStop != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.Stop")
 	}
 }
 
-type mStaterMockUpdatePickupInfo struct {
+type mStaterMockSuspend struct {
 	mock               *StaterMock
-	defaultExpectation *StaterMockUpdatePickupInfoExpectation
-	expectations       []*StaterMockUpdatePickupInfoExpectation
+	defaultExpectation *StaterMockSuspendExpectation
+	expectations       []*StaterMockSuspendExpectation
 }
 
-// StaterMockUpdatePickupInfoExpectation specifies expectation struct of the Stater.FindMatchingPickup
-type StaterMockUpdatePickupInfoExpectation struct {
+// StaterMockSuspendExpectation specifies expectation struct of the Stater.Suspend
+type StaterMockSuspendExpectation struct {
 	mock *StaterMock
 
-	results *StaterMockUpdatePickupInfoResults
 	Counter uint64
 }
 
-// StaterMockUpdatePickupInfoResults contains results of the Stater.FindMatchingPickup
-type StaterMockUpdatePickupInfoResults struct {
-	err error
-}
-
-// Expect sets up expected params for Stater.FindMatchingPickup
-func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Expect() *mStaterMockUpdatePickupInfo {
-	if mmUpdatePickupInfo.mock.funcUpdatePickupInfo != nil {
-		mmUpdatePickupInfo.mock.t.Fatalf("StaterMock.FindMatchingPickup mock is already set by Set")
+// Expect sets up expected params for Stater.Suspend
+func (mmSuspend *mStaterMockSuspend) Expect() *mStaterMockSuspend {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("StaterMock.Suspend mock is already set by Set")
 	}
 
-	if mmUpdatePickupInfo.defaultExpectation == nil {
-		mmUpdatePickupInfo.defaultExpectation = &StaterMockUpdatePickupInfoExpectation{}
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &StaterMockSuspendExpectation{}
 	}
 
-	return mmUpdatePickupInfo
+	return mmSuspend
 }
 
-// Inspect accepts an inspector function that has same arguments as the Stater.FindMatchingPickup
-func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Inspect(f func()) *mStaterMockUpdatePickupInfo {
-	if mmUpdatePickupInfo.mock.inspectFuncUpdatePickupInfo != nil {
-		mmUpdatePickupInfo.mock.t.Fatalf("Inspect function is already set for StaterMock.FindMatchingPickup")
+// Inspect accepts an inspector function that has same arguments as the Stater.Suspend
+func (mmSuspend *mStaterMockSuspend) Inspect(f func()) *mStaterMockSuspend {
+	if mmSuspend.mock.inspectFuncSuspend != nil {
+		mmSuspend.mock.t.Fatalf("Inspect function is already set for StaterMock.Suspend")
 	}
 
-	mmUpdatePickupInfo.mock.inspectFuncUpdatePickupInfo = f
+	mmSuspend.mock.inspectFuncSuspend = f
 
-	return mmUpdatePickupInfo
+	return mmSuspend
 }
 
-// Return sets up results that will be returned by Stater.FindMatchingPickup
-func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Return(err error) *StaterMock {
-	if mmUpdatePickupInfo.mock.funcUpdatePickupInfo != nil {
-		mmUpdatePickupInfo.mock.t.Fatalf("StaterMock.FindMatchingPickup mock is already set by Set")
+// Return sets up results that will be returned by Stater.Suspend
+func (mmSuspend *mStaterMockSuspend) Return() *StaterMock {
+	if mmSuspend.mock.funcSuspend != nil {
+		mmSuspend.mock.t.Fatalf("StaterMock.Suspend mock is already set by Set")
 	}
 
-	if mmUpdatePickupInfo.defaultExpectation == nil {
-		mmUpdatePickupInfo.defaultExpectation = &StaterMockUpdatePickupInfoExpectation{mock: mmUpdatePickupInfo.mock}
+	if mmSuspend.defaultExpectation == nil {
+		mmSuspend.defaultExpectation = &StaterMockSuspendExpectation{mock: mmSuspend.mock}
 	}
-	mmUpdatePickupInfo.defaultExpectation.results = &StaterMockUpdatePickupInfoResults{err}
-	return mmUpdatePickupInfo.mock
+
+	return mmSuspend.mock
 }
 
-//Set uses given function f to mock the Stater.FindMatchingPickup method
-func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Set(f func() (err error)) *StaterMock {
-	if mmUpdatePickupInfo.defaultExpectation != nil {
-		mmUpdatePickupInfo.mock.t.Fatalf("Default expectation is already set for the Stater.FindMatchingPickup method")
+//Set uses given function f to mock the Stater.Suspend method
+func (mmSuspend *mStaterMockSuspend) Set(f func()) *StaterMock {
+	if mmSuspend.defaultExpectation != nil {
+		mmSuspend.mock.t.Fatalf("Default expectation is already set for the Stater.Suspend method")
 	}
 
-	if len(mmUpdatePickupInfo.expectations) > 0 {
-		mmUpdatePickupInfo.mock.t.Fatalf("Some expectations are already set for the Stater.FindMatchingPickup method")
+	if len(mmSuspend.expectations) > 0 {
+		mmSuspend.mock.t.Fatalf("Some expectations are already set for the Stater.Suspend method")
 	}
 
-	mmUpdatePickupInfo.mock.funcUpdatePickupInfo = f
-	return mmUpdatePickupInfo.mock
+	mmSuspend.mock.funcSuspend = f
+	return mmSuspend.mock
 }
 
-// UpdatePickupInfo implements stateMachine.Stater
-func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfo() (err error) {
-	mm_atomic.AddUint64(&mmUpdatePickupInfo.beforeUpdatePickupInfoCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdatePickupInfo.afterUpdatePickupInfoCounter, 1)
+// Suspend implements stateMachine.Stater
+func (mmSuspend *StaterMock) Suspend() {
+	mm_atomic.AddUint64(&mmSuspend.beforeSuspendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSuspend.afterSuspendCounter, 1)
 
-	if mmUpdatePickupInfo.inspectFuncUpdatePickupInfo != nil {
-		mmUpdatePickupInfo.inspectFuncUpdatePickupInfo()
+	if mmSuspend.inspectFuncSuspend != nil {
+		mmSuspend.inspectFuncSuspend()
 	}
 
-	if mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation.Counter, 1)
+	if mmSuspend.SuspendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSuspend.SuspendMock.defaultExpectation.Counter, 1)
+
+		return
 
-		mm_results := mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation.results
-		if mm_results == nil {
-			mmUpdatePickupInfo.t.Fatal("No results are set for the StaterMock.FindMatchingPickup")
-		}
-		return (*mm_results).err
 	}
-	if mmUpdatePickupInfo.funcUpdatePickupInfo != nil {
-		return mmUpdatePickupInfo.funcUpdatePickupInfo()
+	if mmSuspend.funcSuspend != nil {
+		mmSuspend.funcSuspend()
+		return
 	}
-	mmUpdatePickupInfo.t.Fatalf("Unexpected call to StaterMock.FindMatchingPickup.")
-	return
+	mmSuspend.t.Fatalf("Unexpected call to StaterMock.Suspend.")
+
 }
 
-// UpdatePickupInfoAfterCounter returns a count of finished StaterMock.UpdatePickupInfo invocations
-func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfoAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdatePickupInfo.afterUpdatePickupInfoCounter)
+// SuspendAfterCounter returns a count of finished StaterMock.Suspend invocations
+func (mmSuspend *StaterMock) SuspendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuspend.afterSuspendCounter)
 }
 
-// UpdatePickupInfoBeforeCounter returns a count of StaterMock.UpdatePickupInfo invocations
-func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfoBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdatePickupInfo.beforeUpdatePickupInfoCounter)
+// SuspendBeforeCounter returns a count of StaterMock.Suspend invocations
+func (mmSuspend *StaterMock) SuspendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuspend.beforeSuspendCounter)
 }
 
-// MinimockUpdatePickupInfoDone returns true if the count of the UpdatePickupInfo invocations corresponds
+// MinimockSuspendDone returns true if the count of the Suspend invocations corresponds
 // the number of defined expectations
-func (m *StaterMock) MinimockUpdatePickupInfoDone() bool {
-	for _, e := range m.UpdatePickupInfoMock.expectations {
+func (m *StaterMock) MinimockSuspendDone() bool {
+	for _, e := range m.SuspendMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdatePickupInfoMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
+	if m.SuspendMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSuspendCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdatePickupInfo != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
+	if m.funcSuspend != nil && mm_atomic.LoadUint64(&m.afterSuspendCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockUpdatePickupInfoInspect logs each unmet expectation
-func (m *StaterMock) MinimockUpdatePickupInfoInspect() {
-	for _, e := range m.UpdatePickupInfoMock.expectations {
+// MinimockSuspendInspect logs each unmet expectation
+func (m *StaterMock) MinimockSuspendInspect() {
+	for _, e := range m.SuspendMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.FindMatchingPickup")
+			m.t.Error("Expected call to StaterMock.Suspend")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdatePickupInfoMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.FindMatchingPickup")
+	if m.SuspendMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSuspendCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.Suspend")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdatePickupInfo != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.FindMatchingPickup")
+	if m.funcSuspend != nil && mm_atomic.LoadUint64(&m.afterSuspendCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.Suspend")
 	}
 }
 
-type mStaterMockUploader struct {
+type mStaterMockUpdatePickupInfo struct {
 	mock               *StaterMock
-	defaultExpectation *StaterMockUploaderExpectation
-	expectations       []*StaterMockUploaderExpectation
+	defaultExpectation *StaterMockUpdatePickupInfoExpectation
+	expectations       []*StaterMockUpdatePickupInfoExpectation
 }
 
-// StaterMockUploaderExpectation specifies expectation struct of the Stater.Uploader
-type StaterMockUploaderExpectation struct {
+// StaterMockUpdatePickupInfoExpectation specifies expectation struct of the Stater.UpdatePickupInfo
+type StaterMockUpdatePickupInfoExpectation struct {
 	mock *StaterMock
 
-	results *StaterMockUploaderResults
+	results *StaterMockUpdatePickupInfoResults
 	Counter uint64
 }
 
-// StaterMockUploaderResults contains results of the Stater.Uploader
-type StaterMockUploaderResults struct {
-	l1 requests.LogUploader
+// StaterMockUpdatePickupInfoResults contains results of the Stater.UpdatePickupInfo
+type StaterMockUpdatePickupInfoResults struct {
+	err error
 }
 
-// Expect sets up expected params for Stater.Uploader
-func (mmUploader *mStaterMockUploader) Expect() *mStaterMockUploader {
-	if mmUploader.mock.funcUploader != nil {
-		mmUploader.mock.t.Fatalf("StaterMock.Uploader mock is already set by Set")
+// Expect sets up expected params for Stater.UpdatePickupInfo
+func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Expect() *mStaterMockUpdatePickupInfo {
+	if mmUpdatePickupInfo.mock.funcUpdatePickupInfo != nil {
+		mmUpdatePickupInfo.mock.t.Fatalf("StaterMock.UpdatePickupInfo mock is already set by Set")
 	}
 
-	if mmUploader.defaultExpectation == nil {
-		mmUploader.defaultExpectation = &StaterMockUploaderExpectation{}
+	if mmUpdatePickupInfo.defaultExpectation == nil {
+		mmUpdatePickupInfo.defaultExpectation = &StaterMockUpdatePickupInfoExpectation{}
 	}
 
-	return mmUploader
+	return mmUpdatePickupInfo
 }
 
-// Inspect accepts an inspector function that has same arguments as the Stater.Uploader
-func (mmUploader *mStaterMockUploader) Inspect(f func()) *mStaterMockUploader {
-	if mmUploader.mock.inspectFuncUploader != nil {
-		mmUploader.mock.t.Fatalf("Inspect function is already set for StaterMock.Uploader")
+// Inspect accepts an inspector function that has same arguments as the Stater.UpdatePickupInfo
+func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Inspect(f func()) *mStaterMockUpdatePickupInfo {
+	if mmUpdatePickupInfo.mock.inspectFuncUpdatePickupInfo != nil {
+		mmUpdatePickupInfo.mock.t.Fatalf("Inspect function is already set for StaterMock.UpdatePickupInfo")
 	}
 
-	mmUploader.mock.inspectFuncUploader = f
+	mmUpdatePickupInfo.mock.inspectFuncUpdatePickupInfo = f
 
-	return mmUploader
+	return mmUpdatePickupInfo
 }
 
-// Return sets up results that will be returned by Stater.Uploader
-func (mmUploader *mStaterMockUploader) Return(l1 requests.LogUploader) *StaterMock {
-	if mmUploader.mock.funcUploader != nil {
-		mmUploader.mock.t.Fatalf("StaterMock.Uploader mock is already set by Set")
+// Return sets up results that will be returned by Stater.UpdatePickupInfo
+func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Return(err error) *StaterMock {
+	if mmUpdatePickupInfo.mock.funcUpdatePickupInfo != nil {
+		mmUpdatePickupInfo.mock.t.Fatalf("StaterMock.UpdatePickupInfo mock is already set by Set")
 	}
 
-	if mmUploader.defaultExpectation == nil {
-		mmUploader.defaultExpectation = &StaterMockUploaderExpectation{mock: mmUploader.mock}
+	if mmUpdatePickupInfo.defaultExpectation == nil {
+		mmUpdatePickupInfo.defaultExpectation = &StaterMockUpdatePickupInfoExpectation{mock: mmUpdatePickupInfo.mock}
 	}
-	mmUploader.defaultExpectation.results = &StaterMockUploaderResults{l1}
-	return mmUploader.mock
+	mmUpdatePickupInfo.defaultExpectation.results = &StaterMockUpdatePickupInfoResults{err}
+	return mmUpdatePickupInfo.mock
 }
 
-//Set uses given function f to mock the Stater.Uploader method
-func (mmUploader *mStaterMockUploader) Set(f func() (l1 requests.LogUploader)) *StaterMock {
-	if mmUploader.defaultExpectation != nil {
-		mmUploader.mock.t.Fatalf("Default expectation is already set for the Stater.Uploader method")
+//Set uses given function f to mock the Stater.UpdatePickupInfo method
+func (mmUpdatePickupInfo *mStaterMockUpdatePickupInfo) Set(f func() (err error)) *StaterMock {
+	if mmUpdatePickupInfo.defaultExpectation != nil {
+		mmUpdatePickupInfo.mock.t.Fatalf("Default expectation is already set for the Stater.UpdatePickupInfo method")
 	}
 
-	if len(mmUploader.expectations) > 0 {
-		mmUploader.mock.t.Fatalf("Some expectations are already set for the Stater.Uploader method")
+	if len(mmUpdatePickupInfo.expectations) > 0 {
+		mmUpdatePickupInfo.mock.t.Fatalf("Some expectations are already set for the Stater.UpdatePickupInfo method")
 	}
 
-	mmUploader.mock.funcUploader = f
-	return mmUploader.mock
+	mmUpdatePickupInfo.mock.funcUpdatePickupInfo = f
+	return mmUpdatePickupInfo.mock
 }
 
-// Uploader implements stateMachine.Stater
-func (mmUploader *StaterMock) Uploader() (l1 requests.LogUploader) {
-	mm_atomic.AddUint64(&mmUploader.beforeUploaderCounter, 1)
-	defer mm_atomic.AddUint64(&mmUploader.afterUploaderCounter, 1)
+// UpdatePickupInfo implements stateMachine.Stater
+func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfo() (err error) {
+	mm_atomic.AddUint64(&mmUpdatePickupInfo.beforeUpdatePickupInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePickupInfo.afterUpdatePickupInfoCounter, 1)
 
-	if mmUploader.inspectFuncUploader != nil {
-		mmUploader.inspectFuncUploader()
+	if mmUpdatePickupInfo.inspectFuncUpdatePickupInfo != nil {
+		mmUpdatePickupInfo.inspectFuncUpdatePickupInfo()
 	}
 
-	if mmUploader.UploaderMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUploader.UploaderMock.defaultExpectation.Counter, 1)
+	if mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation.Counter, 1)
 
-		mm_results := mmUploader.UploaderMock.defaultExpectation.results
+		mm_results := mmUpdatePickupInfo.UpdatePickupInfoMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUploader.t.Fatal("No results are set for the StaterMock.Uploader")
+			mmUpdatePickupInfo.t.Fatal("No results are set for the StaterMock.UpdatePickupInfo")
 		}
-		return (*mm_results).l1
+		return (*mm_results).err
 	}
-	if mmUploader.funcUploader != nil {
-		return mmUploader.funcUploader()
+	if mmUpdatePickupInfo.funcUpdatePickupInfo != nil {
+		return mmUpdatePickupInfo.funcUpdatePickupInfo()
 	}
-	mmUploader.t.Fatalf("Unexpected call to StaterMock.Uploader.")
+	mmUpdatePickupInfo.t.Fatalf("Unexpected call to StaterMock.UpdatePickupInfo.")
 	return
 }
 
-// UploaderAfterCounter returns a count of finished StaterMock.Uploader invocations
-func (mmUploader *StaterMock) UploaderAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUploader.afterUploaderCounter)
+// UpdatePickupInfoAfterCounter returns a count of finished StaterMock.UpdatePickupInfo invocations
+func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePickupInfo.afterUpdatePickupInfoCounter)
 }
 
-// UploaderBeforeCounter returns a count of StaterMock.Uploader invocations
-func (mmUploader *StaterMock) UploaderBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUploader.beforeUploaderCounter)
+// UpdatePickupInfoBeforeCounter returns a count of StaterMock.UpdatePickupInfo invocations
+func (mmUpdatePickupInfo *StaterMock) UpdatePickupInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePickupInfo.beforeUpdatePickupInfoCounter)
 }
 
-// MinimockUploaderDone returns true if the count of the Uploader invocations corresponds
+// MinimockUpdatePickupInfoDone returns true if the count of the UpdatePickupInfo invocations corresponds
 // the number of defined expectations
-func (m *StaterMock) MinimockUploaderDone() bool {
-	for _, e := range m.UploaderMock.expectations {
+func (m *StaterMock) MinimockUpdatePickupInfoDone() bool {
+	for _, e := range m.UpdatePickupInfoMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UploaderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUploaderCounter) < 1 {
+	if m.UpdatePickupInfoMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUploader != nil && mm_atomic.LoadUint64(&m.afterUploaderCounter) < 1 {
+	if m.funcUpdatePickupInfo != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockUploaderInspect logs each unmet expectation
-func (m *StaterMock) MinimockUploaderInspect() {
-	for _, e := range m.UploaderMock.expectations {
+// MinimockUpdatePickupInfoInspect logs each unmet expectation
+func (m *StaterMock) MinimockUpdatePickupInfoInspect() {
+	for _, e := range m.UpdatePickupInfoMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to StaterMock.Uploader")
+			m.t.Error("Expected call to StaterMock.UpdatePickupInfo")
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UploaderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUploaderCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Uploader")
+	if m.UpdatePickupInfoMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.UpdatePickupInfo")
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUploader != nil && mm_atomic.LoadUint64(&m.afterUploaderCounter) < 1 {
-		m.t.Error("Expected call to StaterMock.Uploader")
+	if m.funcUpdatePickupInfo != nil && mm_atomic.LoadUint64(&m.afterUpdatePickupInfoCounter) < 1 {
+		m.t.Error("Expected call to StaterMock.UpdatePickupInfo")
 	}
 }
 
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *StaterMock) MinimockFinish() {
 	if !m.minimockDone() {
-		m.MinimockChannelInspect()
-
-		m.MinimockFileInspect()
-
-		m.MinimockInserterInspect()
-
-		m.MinimockMatchInspect()
-
 		m.MinimockProcessGameLogLineInspect()
 
 		m.MinimockProcessGameOverEventInspect()
@@ -2231,15 +1680,15 @@ func (m *StaterMock) MinimockFinish() {
 
 		m.MinimockProcessLogLineInspect()
 
-		m.MinimockSetStateInspect()
+		m.MinimockRecoverInspect()
 
 		m.MinimockStartWorkerInspect()
 
-		m.MinimockStateInspect()
+		m.MinimockStopInspect()
 
-		m.MinimockUpdatePickupInfoInspect()
+		m.MinimockSuspendInspect()
 
-		m.MinimockUploaderInspect()
+		m.MinimockUpdatePickupInfoInspect()
 		m.t.FailNow()
 	}
 }
@@ -2263,17 +1712,13 @@ func (m *StaterMock) MinimockWait(timeout mm_time.Duration) {
 func (m *StaterMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockChannelDone() &&
-		m.MinimockFileDone() &&
-		m.MinimockInserterDone() &&
-		m.MinimockMatchDone() &&
 		m.MinimockProcessGameLogLineDone() &&
 		m.MinimockProcessGameOverEventDone() &&
 		m.MinimockProcessGameStartedEventDone() &&
 		m.MinimockProcessLogLineDone() &&
-		m.MinimockSetStateDone() &&
+		m.MinimockRecoverDone() &&
 		m.MinimockStartWorkerDone() &&
-		m.MinimockStateDone() &&
-		m.MinimockUpdatePickupInfoDone() &&
-		m.MinimockUploaderDone()
+		m.MinimockStopDone() &&
+		m.MinimockSuspendDone() &&
+		m.MinimockUpdatePickupInfoDone()
 }
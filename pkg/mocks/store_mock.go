@@ -0,0 +1,740 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/mongo.Store -o ./pkg/mocks/store_mock.go
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// StoreMock implements mongo.Store
+type StoreMock struct {
+	t minimock.Tester
+
+	funcInsertGameStats          func(ctx context.Context, documents []interface{}) (err error)
+	inspectFuncInsertGameStats   func(ctx context.Context, documents []interface{})
+	afterInsertGameStatsCounter  uint64
+	beforeInsertGameStatsCounter uint64
+	InsertGameStatsMock          mStoreMockInsertGameStats
+
+	funcInsertMatch          func(ctx context.Context, document interface{}) (err error)
+	inspectFuncInsertMatch   func(ctx context.Context, document interface{})
+	afterInsertMatchCounter  uint64
+	beforeInsertMatchCounter uint64
+	InsertMatchMock          mStoreMockInsertMatch
+
+	funcUpsertGameStats          func(ctx context.Context, documents []interface{}) (err error)
+	inspectFuncUpsertGameStats   func(ctx context.Context, documents []interface{})
+	afterUpsertGameStatsCounter  uint64
+	beforeUpsertGameStatsCounter uint64
+	UpsertGameStatsMock          mStoreMockUpsertGameStats
+}
+
+// NewStoreMock returns a mock for mongo.Store
+func NewStoreMock(t minimock.Tester) *StoreMock {
+	m := &StoreMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.InsertGameStatsMock = mStoreMockInsertGameStats{mock: m}
+	m.InsertGameStatsMock.callArgs = []*StoreMockInsertGameStatsParams{}
+
+	m.InsertMatchMock = mStoreMockInsertMatch{mock: m}
+	m.InsertMatchMock.callArgs = []*StoreMockInsertMatchParams{}
+
+	m.UpsertGameStatsMock = mStoreMockUpsertGameStats{mock: m}
+	m.UpsertGameStatsMock.callArgs = []*StoreMockUpsertGameStatsParams{}
+
+	return m
+}
+
+type mStoreMockInsertGameStats struct {
+	mock               *StoreMock
+	defaultExpectation *StoreMockInsertGameStatsExpectation
+	expectations       []*StoreMockInsertGameStatsExpectation
+
+	callArgs []*StoreMockInsertGameStatsParams
+	mutex    sync.RWMutex
+}
+
+// StoreMockInsertGameStatsExpectation specifies expectation struct of the Store.InsertGameStats
+type StoreMockInsertGameStatsExpectation struct {
+	mock    *StoreMock
+	params  *StoreMockInsertGameStatsParams
+	results *StoreMockInsertGameStatsResults
+	Counter uint64
+}
+
+// StoreMockInsertGameStatsParams contains parameters of the Store.InsertGameStats
+type StoreMockInsertGameStatsParams struct {
+	ctx       context.Context
+	documents []interface{}
+}
+
+// StoreMockInsertGameStatsResults contains results of the Store.InsertGameStats
+type StoreMockInsertGameStatsResults struct {
+	err error
+}
+
+// Expect sets up expected params for Store.InsertGameStats
+func (mmInsertGameStats *mStoreMockInsertGameStats) Expect(ctx context.Context, documents []interface{}) *mStoreMockInsertGameStats {
+	if mmInsertGameStats.mock.funcInsertGameStats != nil {
+		mmInsertGameStats.mock.t.Fatalf("StoreMock.InsertGameStats mock is already set by Set")
+	}
+
+	if mmInsertGameStats.defaultExpectation == nil {
+		mmInsertGameStats.defaultExpectation = &StoreMockInsertGameStatsExpectation{}
+	}
+
+	mmInsertGameStats.defaultExpectation.params = &StoreMockInsertGameStatsParams{ctx, documents}
+	for _, e := range mmInsertGameStats.expectations {
+		if minimock.Equal(e.params, mmInsertGameStats.defaultExpectation.params) {
+			mmInsertGameStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInsertGameStats.defaultExpectation.params)
+		}
+	}
+
+	return mmInsertGameStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the Store.InsertGameStats
+func (mmInsertGameStats *mStoreMockInsertGameStats) Inspect(f func(ctx context.Context, documents []interface{})) *mStoreMockInsertGameStats {
+	if mmInsertGameStats.mock.inspectFuncInsertGameStats != nil {
+		mmInsertGameStats.mock.t.Fatalf("Inspect function is already set for StoreMock.InsertGameStats")
+	}
+
+	mmInsertGameStats.mock.inspectFuncInsertGameStats = f
+
+	return mmInsertGameStats
+}
+
+// Return sets up results that will be returned by Store.InsertGameStats
+func (mmInsertGameStats *mStoreMockInsertGameStats) Return(err error) *StoreMock {
+	if mmInsertGameStats.mock.funcInsertGameStats != nil {
+		mmInsertGameStats.mock.t.Fatalf("StoreMock.InsertGameStats mock is already set by Set")
+	}
+
+	if mmInsertGameStats.defaultExpectation == nil {
+		mmInsertGameStats.defaultExpectation = &StoreMockInsertGameStatsExpectation{mock: mmInsertGameStats.mock}
+	}
+	mmInsertGameStats.defaultExpectation.results = &StoreMockInsertGameStatsResults{err}
+	return mmInsertGameStats.mock
+}
+
+//Set uses given function f to mock the Store.InsertGameStats method
+func (mmInsertGameStats *mStoreMockInsertGameStats) Set(f func(ctx context.Context, documents []interface{}) (err error)) *StoreMock {
+	if mmInsertGameStats.defaultExpectation != nil {
+		mmInsertGameStats.mock.t.Fatalf("Default expectation is already set for the Store.InsertGameStats method")
+	}
+
+	if len(mmInsertGameStats.expectations) > 0 {
+		mmInsertGameStats.mock.t.Fatalf("Some expectations are already set for the Store.InsertGameStats method")
+	}
+
+	mmInsertGameStats.mock.funcInsertGameStats = f
+	return mmInsertGameStats.mock
+}
+
+// When sets expectation for the Store.InsertGameStats which will trigger the result defined by the following
+// Then helper
+func (mmInsertGameStats *mStoreMockInsertGameStats) When(ctx context.Context, documents []interface{}) *StoreMockInsertGameStatsExpectation {
+	if mmInsertGameStats.mock.funcInsertGameStats != nil {
+		mmInsertGameStats.mock.t.Fatalf("StoreMock.InsertGameStats mock is already set by Set")
+	}
+
+	expectation := &StoreMockInsertGameStatsExpectation{
+		mock:   mmInsertGameStats.mock,
+		params: &StoreMockInsertGameStatsParams{ctx, documents},
+	}
+	mmInsertGameStats.expectations = append(mmInsertGameStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Store.InsertGameStats return parameters for the expectation previously defined by the When method
+func (e *StoreMockInsertGameStatsExpectation) Then(err error) *StoreMock {
+	e.results = &StoreMockInsertGameStatsResults{err}
+	return e.mock
+}
+
+// InsertGameStats implements mongo.Store
+func (mmInsertGameStats *StoreMock) InsertGameStats(ctx context.Context, documents []interface{}) (err error) {
+	mm_atomic.AddUint64(&mmInsertGameStats.beforeInsertGameStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmInsertGameStats.afterInsertGameStatsCounter, 1)
+
+	if mmInsertGameStats.inspectFuncInsertGameStats != nil {
+		mmInsertGameStats.inspectFuncInsertGameStats(ctx, documents)
+	}
+
+	mm_params := &StoreMockInsertGameStatsParams{ctx, documents}
+
+	// Record call args
+	mmInsertGameStats.InsertGameStatsMock.mutex.Lock()
+	mmInsertGameStats.InsertGameStatsMock.callArgs = append(mmInsertGameStats.InsertGameStatsMock.callArgs, mm_params)
+	mmInsertGameStats.InsertGameStatsMock.mutex.Unlock()
+
+	for _, e := range mmInsertGameStats.InsertGameStatsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInsertGameStats.InsertGameStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInsertGameStats.InsertGameStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmInsertGameStats.InsertGameStatsMock.defaultExpectation.params
+		mm_got := StoreMockInsertGameStatsParams{ctx, documents}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInsertGameStats.t.Errorf("StoreMock.InsertGameStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInsertGameStats.InsertGameStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInsertGameStats.t.Fatal("No results are set for the StoreMock.InsertGameStats")
+		}
+		return (*mm_results).err
+	}
+	if mmInsertGameStats.funcInsertGameStats != nil {
+		return mmInsertGameStats.funcInsertGameStats(ctx, documents)
+	}
+	mmInsertGameStats.t.Fatalf("Unexpected call to StoreMock.InsertGameStats. %v %v", ctx, documents)
+	return
+}
+
+// InsertGameStatsAfterCounter returns a count of finished StoreMock.InsertGameStats invocations
+func (mmInsertGameStats *StoreMock) InsertGameStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertGameStats.afterInsertGameStatsCounter)
+}
+
+// InsertGameStatsBeforeCounter returns a count of StoreMock.InsertGameStats invocations
+func (mmInsertGameStats *StoreMock) InsertGameStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertGameStats.beforeInsertGameStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StoreMock.InsertGameStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInsertGameStats *mStoreMockInsertGameStats) Calls() []*StoreMockInsertGameStatsParams {
+	mmInsertGameStats.mutex.RLock()
+
+	argCopy := make([]*StoreMockInsertGameStatsParams, len(mmInsertGameStats.callArgs))
+	copy(argCopy, mmInsertGameStats.callArgs)
+
+	mmInsertGameStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInsertGameStatsDone returns true if the count of the InsertGameStats invocations corresponds
+// the number of defined expectations
+func (m *StoreMock) MinimockInsertGameStatsDone() bool {
+	for _, e := range m.InsertGameStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertGameStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertGameStatsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertGameStats != nil && mm_atomic.LoadUint64(&m.afterInsertGameStatsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockInsertGameStatsInspect logs each unmet expectation
+func (m *StoreMock) MinimockInsertGameStatsInspect() {
+	for _, e := range m.InsertGameStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StoreMock.InsertGameStats with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertGameStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertGameStatsCounter) < 1 {
+		if m.InsertGameStatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StoreMock.InsertGameStats")
+		} else {
+			m.t.Errorf("Expected call to StoreMock.InsertGameStats with params: %#v", *m.InsertGameStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertGameStats != nil && mm_atomic.LoadUint64(&m.afterInsertGameStatsCounter) < 1 {
+		m.t.Error("Expected call to StoreMock.InsertGameStats")
+	}
+}
+
+type mStoreMockInsertMatch struct {
+	mock               *StoreMock
+	defaultExpectation *StoreMockInsertMatchExpectation
+	expectations       []*StoreMockInsertMatchExpectation
+
+	callArgs []*StoreMockInsertMatchParams
+	mutex    sync.RWMutex
+}
+
+// StoreMockInsertMatchExpectation specifies expectation struct of the Store.InsertMatch
+type StoreMockInsertMatchExpectation struct {
+	mock    *StoreMock
+	params  *StoreMockInsertMatchParams
+	results *StoreMockInsertMatchResults
+	Counter uint64
+}
+
+// StoreMockInsertMatchParams contains parameters of the Store.InsertMatch
+type StoreMockInsertMatchParams struct {
+	ctx      context.Context
+	document interface{}
+}
+
+// StoreMockInsertMatchResults contains results of the Store.InsertMatch
+type StoreMockInsertMatchResults struct {
+	err error
+}
+
+// Expect sets up expected params for Store.InsertMatch
+func (mmInsertMatch *mStoreMockInsertMatch) Expect(ctx context.Context, document interface{}) *mStoreMockInsertMatch {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("StoreMock.InsertMatch mock is already set by Set")
+	}
+
+	if mmInsertMatch.defaultExpectation == nil {
+		mmInsertMatch.defaultExpectation = &StoreMockInsertMatchExpectation{}
+	}
+
+	mmInsertMatch.defaultExpectation.params = &StoreMockInsertMatchParams{ctx, document}
+	for _, e := range mmInsertMatch.expectations {
+		if minimock.Equal(e.params, mmInsertMatch.defaultExpectation.params) {
+			mmInsertMatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInsertMatch.defaultExpectation.params)
+		}
+	}
+
+	return mmInsertMatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the Store.InsertMatch
+func (mmInsertMatch *mStoreMockInsertMatch) Inspect(f func(ctx context.Context, document interface{})) *mStoreMockInsertMatch {
+	if mmInsertMatch.mock.inspectFuncInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("Inspect function is already set for StoreMock.InsertMatch")
+	}
+
+	mmInsertMatch.mock.inspectFuncInsertMatch = f
+
+	return mmInsertMatch
+}
+
+// Return sets up results that will be returned by Store.InsertMatch
+func (mmInsertMatch *mStoreMockInsertMatch) Return(err error) *StoreMock {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("StoreMock.InsertMatch mock is already set by Set")
+	}
+
+	if mmInsertMatch.defaultExpectation == nil {
+		mmInsertMatch.defaultExpectation = &StoreMockInsertMatchExpectation{mock: mmInsertMatch.mock}
+	}
+	mmInsertMatch.defaultExpectation.results = &StoreMockInsertMatchResults{err}
+	return mmInsertMatch.mock
+}
+
+//Set uses given function f to mock the Store.InsertMatch method
+func (mmInsertMatch *mStoreMockInsertMatch) Set(f func(ctx context.Context, document interface{}) (err error)) *StoreMock {
+	if mmInsertMatch.defaultExpectation != nil {
+		mmInsertMatch.mock.t.Fatalf("Default expectation is already set for the Store.InsertMatch method")
+	}
+
+	if len(mmInsertMatch.expectations) > 0 {
+		mmInsertMatch.mock.t.Fatalf("Some expectations are already set for the Store.InsertMatch method")
+	}
+
+	mmInsertMatch.mock.funcInsertMatch = f
+	return mmInsertMatch.mock
+}
+
+// When sets expectation for the Store.InsertMatch which will trigger the result defined by the following
+// Then helper
+func (mmInsertMatch *mStoreMockInsertMatch) When(ctx context.Context, document interface{}) *StoreMockInsertMatchExpectation {
+	if mmInsertMatch.mock.funcInsertMatch != nil {
+		mmInsertMatch.mock.t.Fatalf("StoreMock.InsertMatch mock is already set by Set")
+	}
+
+	expectation := &StoreMockInsertMatchExpectation{
+		mock:   mmInsertMatch.mock,
+		params: &StoreMockInsertMatchParams{ctx, document},
+	}
+	mmInsertMatch.expectations = append(mmInsertMatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Store.InsertMatch return parameters for the expectation previously defined by the When method
+func (e *StoreMockInsertMatchExpectation) Then(err error) *StoreMock {
+	e.results = &StoreMockInsertMatchResults{err}
+	return e.mock
+}
+
+// InsertMatch implements mongo.Store
+func (mmInsertMatch *StoreMock) InsertMatch(ctx context.Context, document interface{}) (err error) {
+	mm_atomic.AddUint64(&mmInsertMatch.beforeInsertMatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmInsertMatch.afterInsertMatchCounter, 1)
+
+	if mmInsertMatch.inspectFuncInsertMatch != nil {
+		mmInsertMatch.inspectFuncInsertMatch(ctx, document)
+	}
+
+	mm_params := &StoreMockInsertMatchParams{ctx, document}
+
+	// Record call args
+	mmInsertMatch.InsertMatchMock.mutex.Lock()
+	mmInsertMatch.InsertMatchMock.callArgs = append(mmInsertMatch.InsertMatchMock.callArgs, mm_params)
+	mmInsertMatch.InsertMatchMock.mutex.Unlock()
+
+	for _, e := range mmInsertMatch.InsertMatchMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmInsertMatch.InsertMatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInsertMatch.InsertMatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmInsertMatch.InsertMatchMock.defaultExpectation.params
+		mm_got := StoreMockInsertMatchParams{ctx, document}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInsertMatch.t.Errorf("StoreMock.InsertMatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInsertMatch.InsertMatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInsertMatch.t.Fatal("No results are set for the StoreMock.InsertMatch")
+		}
+		return (*mm_results).err
+	}
+	if mmInsertMatch.funcInsertMatch != nil {
+		return mmInsertMatch.funcInsertMatch(ctx, document)
+	}
+	mmInsertMatch.t.Fatalf("Unexpected call to StoreMock.InsertMatch. %v %v", ctx, document)
+	return
+}
+
+// InsertMatchAfterCounter returns a count of finished StoreMock.InsertMatch invocations
+func (mmInsertMatch *StoreMock) InsertMatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertMatch.afterInsertMatchCounter)
+}
+
+// InsertMatchBeforeCounter returns a count of StoreMock.InsertMatch invocations
+func (mmInsertMatch *StoreMock) InsertMatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInsertMatch.beforeInsertMatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to StoreMock.InsertMatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInsertMatch *mStoreMockInsertMatch) Calls() []*StoreMockInsertMatchParams {
+	mmInsertMatch.mutex.RLock()
+
+	argCopy := make([]*StoreMockInsertMatchParams, len(mmInsertMatch.callArgs))
+	copy(argCopy, mmInsertMatch.callArgs)
+
+	mmInsertMatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInsertMatchDone returns true if the count of the InsertMatch invocations corresponds
+// the number of defined expectations
+func (m *StoreMock) MinimockInsertMatchDone() bool {
+	for _, e := range m.InsertMatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertMatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertMatch != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockInsertMatchInspect logs each unmet expectation
+func (m *StoreMock) MinimockInsertMatchInspect() {
+	for _, e := range m.InsertMatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StoreMock.InsertMatch with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InsertMatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		if m.InsertMatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StoreMock.InsertMatch")
+		} else {
+			m.t.Errorf("Expected call to StoreMock.InsertMatch with params: %#v", *m.InsertMatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInsertMatch != nil && mm_atomic.LoadUint64(&m.afterInsertMatchCounter) < 1 {
+		m.t.Error("Expected call to StoreMock.InsertMatch")
+	}
+}
+
+type mStoreMockUpsertGameStats struct {
+	mock               *StoreMock
+	defaultExpectation *StoreMockUpsertGameStatsExpectation
+	expectations       []*StoreMockUpsertGameStatsExpectation
+
+	callArgs []*StoreMockUpsertGameStatsParams
+	mutex    sync.RWMutex
+}
+
+// StoreMockUpsertGameStatsExpectation specifies expectation struct of the Store.UpsertGameStats
+type StoreMockUpsertGameStatsExpectation struct {
+	mock    *StoreMock
+	params  *StoreMockUpsertGameStatsParams
+	results *StoreMockUpsertGameStatsResults
+	Counter uint64
+}
+
+// StoreMockUpsertGameStatsParams contains parameters of the Store.UpsertGameStats
+type StoreMockUpsertGameStatsParams struct {
+	ctx       context.Context
+	documents []interface{}
+}
+
+// StoreMockUpsertGameStatsResults contains results of the Store.UpsertGameStats
+type StoreMockUpsertGameStatsResults struct {
+	err error
+}
+
+// Expect sets up expected params for Store.UpsertGameStats
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) Expect(ctx context.Context, documents []interface{}) *mStoreMockUpsertGameStats {
+	if mmUpsertGameStats.mock.funcUpsertGameStats != nil {
+		mmUpsertGameStats.mock.t.Fatalf("StoreMock.UpsertGameStats mock is already set by Set")
+	}
+
+	if mmUpsertGameStats.defaultExpectation == nil {
+		mmUpsertGameStats.defaultExpectation = &StoreMockUpsertGameStatsExpectation{}
+	}
+
+	mmUpsertGameStats.defaultExpectation.params = &StoreMockUpsertGameStatsParams{ctx, documents}
+	for _, e := range mmUpsertGameStats.expectations {
+		if minimock.Equal(e.params, mmUpsertGameStats.defaultExpectation.params) {
+			mmUpsertGameStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertGameStats.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsertGameStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the Store.UpsertGameStats
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) Inspect(f func(ctx context.Context, documents []interface{})) *mStoreMockUpsertGameStats {
+	if mmUpsertGameStats.mock.inspectFuncUpsertGameStats != nil {
+		mmUpsertGameStats.mock.t.Fatalf("Inspect function is already set for StoreMock.UpsertGameStats")
+	}
+
+	mmUpsertGameStats.mock.inspectFuncUpsertGameStats = f
+
+	return mmUpsertGameStats
+}
+
+// Return sets up results that will be returned by Store.UpsertGameStats
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) Return(err error) *StoreMock {
+	if mmUpsertGameStats.mock.funcUpsertGameStats != nil {
+		mmUpsertGameStats.mock.t.Fatalf("StoreMock.UpsertGameStats mock is already set by Set")
+	}
+
+	if mmUpsertGameStats.defaultExpectation == nil {
+		mmUpsertGameStats.defaultExpectation = &StoreMockUpsertGameStatsExpectation{mock: mmUpsertGameStats.mock}
+	}
+	mmUpsertGameStats.defaultExpectation.results = &StoreMockUpsertGameStatsResults{err}
+	return mmUpsertGameStats.mock
+}
+
+//Set uses given function f to mock the Store.UpsertGameStats method
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) Set(f func(ctx context.Context, documents []interface{}) (err error)) *StoreMock {
+	if mmUpsertGameStats.defaultExpectation != nil {
+		mmUpsertGameStats.mock.t.Fatalf("Default expectation is already set for the Store.UpsertGameStats method")
+	}
+
+	if len(mmUpsertGameStats.expectations) > 0 {
+		mmUpsertGameStats.mock.t.Fatalf("Some expectations are already set for the Store.UpsertGameStats method")
+	}
+
+	mmUpsertGameStats.mock.funcUpsertGameStats = f
+	return mmUpsertGameStats.mock
+}
+
+// When sets expectation for the Store.UpsertGameStats which will trigger the result defined by the following
+// Then helper
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) When(ctx context.Context, documents []interface{}) *StoreMockUpsertGameStatsExpectation {
+	if mmUpsertGameStats.mock.funcUpsertGameStats != nil {
+		mmUpsertGameStats.mock.t.Fatalf("StoreMock.UpsertGameStats mock is already set by Set")
+	}
+
+	expectation := &StoreMockUpsertGameStatsExpectation{
+		mock:   mmUpsertGameStats.mock,
+		params: &StoreMockUpsertGameStatsParams{ctx, documents},
+	}
+	mmUpsertGameStats.expectations = append(mmUpsertGameStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Store.UpsertGameStats return parameters for the expectation previously defined by the When method
+func (e *StoreMockUpsertGameStatsExpectation) Then(err error) *StoreMock {
+	e.results = &StoreMockUpsertGameStatsResults{err}
+	return e.mock
+}
+
+// UpsertGameStats implements mongo.Store
+func (mmUpsertGameStats *StoreMock) UpsertGameStats(ctx context.Context, documents []interface{}) (err error) {
+	mm_atomic.AddUint64(&mmUpsertGameStats.beforeUpsertGameStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertGameStats.afterUpsertGameStatsCounter, 1)
+
+	if mmUpsertGameStats.inspectFuncUpsertGameStats != nil {
+		mmUpsertGameStats.inspectFuncUpsertGameStats(ctx, documents)
+	}
+
+	mm_params := &StoreMockUpsertGameStatsParams{ctx, documents}
+
+	// Record call args
+	mmUpsertGameStats.UpsertGameStatsMock.mutex.Lock()
+	mmUpsertGameStats.UpsertGameStatsMock.callArgs = append(mmUpsertGameStats.UpsertGameStatsMock.callArgs, mm_params)
+	mmUpsertGameStats.UpsertGameStatsMock.mutex.Unlock()
+
+	for _, e := range mmUpsertGameStats.UpsertGameStatsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpsertGameStats.UpsertGameStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertGameStats.UpsertGameStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertGameStats.UpsertGameStatsMock.defaultExpectation.params
+		mm_got := StoreMockUpsertGameStatsParams{ctx, documents}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsertGameStats.t.Errorf("StoreMock.UpsertGameStats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsertGameStats.UpsertGameStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsertGameStats.t.Fatal("No results are set for the StoreMock.UpsertGameStats")
+		}
+		return (*mm_results).err
+	}
+	if mmUpsertGameStats.funcUpsertGameStats != nil {
+		return mmUpsertGameStats.funcUpsertGameStats(ctx, documents)
+	}
+	mmUpsertGameStats.t.Fatalf("Unexpected call to StoreMock.UpsertGameStats. %v %v", ctx, documents)
+	return
+}
+
+// UpsertGameStatsAfterCounter returns a count of finished StoreMock.UpsertGameStats invocations
+func (mmUpsertGameStats *StoreMock) UpsertGameStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertGameStats.afterUpsertGameStatsCounter)
+}
+
+// UpsertGameStatsBeforeCounter returns a count of StoreMock.UpsertGameStats invocations
+func (mmUpsertGameStats *StoreMock) UpsertGameStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertGameStats.beforeUpsertGameStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StoreMock.UpsertGameStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsertGameStats *mStoreMockUpsertGameStats) Calls() []*StoreMockUpsertGameStatsParams {
+	mmUpsertGameStats.mutex.RLock()
+
+	argCopy := make([]*StoreMockUpsertGameStatsParams, len(mmUpsertGameStats.callArgs))
+	copy(argCopy, mmUpsertGameStats.callArgs)
+
+	mmUpsertGameStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertGameStatsDone returns true if the count of the UpsertGameStats invocations corresponds
+// the number of defined expectations
+func (m *StoreMock) MinimockUpsertGameStatsDone() bool {
+	for _, e := range m.UpsertGameStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertGameStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpsertGameStatsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsertGameStats != nil && mm_atomic.LoadUint64(&m.afterUpsertGameStatsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpsertGameStatsInspect logs each unmet expectation
+func (m *StoreMock) MinimockUpsertGameStatsInspect() {
+	for _, e := range m.UpsertGameStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StoreMock.UpsertGameStats with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertGameStatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpsertGameStatsCounter) < 1 {
+		if m.UpsertGameStatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StoreMock.UpsertGameStats")
+		} else {
+			m.t.Errorf("Expected call to StoreMock.UpsertGameStats with params: %#v", *m.UpsertGameStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsertGameStats != nil && mm_atomic.LoadUint64(&m.afterUpsertGameStatsCounter) < 1 {
+		m.t.Error("Expected call to StoreMock.UpsertGameStats")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StoreMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockInsertGameStatsInspect()
+
+		m.MinimockInsertMatchInspect()
+
+		m.MinimockUpsertGameStatsInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockInsertGameStatsDone() &&
+		m.MinimockInsertMatchDone() &&
+		m.MinimockUpsertGameStatsDone()
+}
@@ -0,0 +1,667 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/admin.UploadQueue -o ./pkg/mocks/upload_queue_mock.go
+
+import (
+	"LogWatcher/pkg/outbox"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UploadQueueMock implements admin.UploadQueue
+type UploadQueueMock struct {
+	t minimock.Tester
+
+	funcDrop          func(id string) (err error)
+	inspectFuncDrop   func(id string)
+	afterDropCounter  uint64
+	beforeDropCounter uint64
+	DropMock          mUploadQueueMockDrop
+
+	funcList          func() (ea1 []outbox.Entry, err error)
+	inspectFuncList   func()
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mUploadQueueMockList
+
+	funcRetry          func(ctx context.Context, id string) (err error)
+	inspectFuncRetry   func(ctx context.Context, id string)
+	afterRetryCounter  uint64
+	beforeRetryCounter uint64
+	RetryMock          mUploadQueueMockRetry
+}
+
+// NewUploadQueueMock returns a mock for admin.UploadQueue
+func NewUploadQueueMock(t minimock.Tester) *UploadQueueMock {
+	m := &UploadQueueMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DropMock = mUploadQueueMockDrop{mock: m}
+	m.DropMock.callArgs = []*UploadQueueMockDropParams{}
+
+	m.ListMock = mUploadQueueMockList{mock: m}
+
+	m.RetryMock = mUploadQueueMockRetry{mock: m}
+	m.RetryMock.callArgs = []*UploadQueueMockRetryParams{}
+
+	return m
+}
+
+type mUploadQueueMockDrop struct {
+	mock               *UploadQueueMock
+	defaultExpectation *UploadQueueMockDropExpectation
+	expectations       []*UploadQueueMockDropExpectation
+
+	callArgs []*UploadQueueMockDropParams
+	mutex    sync.RWMutex
+}
+
+// UploadQueueMockDropExpectation specifies expectation struct of the UploadQueue.Drop
+type UploadQueueMockDropExpectation struct {
+	mock    *UploadQueueMock
+	params  *UploadQueueMockDropParams
+	results *UploadQueueMockDropResults
+	Counter uint64
+}
+
+// UploadQueueMockDropParams contains parameters of the UploadQueue.Drop
+type UploadQueueMockDropParams struct {
+	id string
+}
+
+// UploadQueueMockDropResults contains results of the UploadQueue.Drop
+type UploadQueueMockDropResults struct {
+	err error
+}
+
+// Expect sets up expected params for UploadQueue.Drop
+func (mmDrop *mUploadQueueMockDrop) Expect(id string) *mUploadQueueMockDrop {
+	if mmDrop.mock.funcDrop != nil {
+		mmDrop.mock.t.Fatalf("UploadQueueMock.Drop mock is already set by Set")
+	}
+
+	if mmDrop.defaultExpectation == nil {
+		mmDrop.defaultExpectation = &UploadQueueMockDropExpectation{}
+	}
+
+	mmDrop.defaultExpectation.params = &UploadQueueMockDropParams{id}
+	for _, e := range mmDrop.expectations {
+		if minimock.Equal(e.params, mmDrop.defaultExpectation.params) {
+			mmDrop.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDrop.defaultExpectation.params)
+		}
+	}
+
+	return mmDrop
+}
+
+// Inspect accepts an inspector function that has same arguments as the UploadQueue.Drop
+func (mmDrop *mUploadQueueMockDrop) Inspect(f func(id string)) *mUploadQueueMockDrop {
+	if mmDrop.mock.inspectFuncDrop != nil {
+		mmDrop.mock.t.Fatalf("Inspect function is already set for UploadQueueMock.Drop")
+	}
+
+	mmDrop.mock.inspectFuncDrop = f
+
+	return mmDrop
+}
+
+// Return sets up results that will be returned by UploadQueue.Drop
+func (mmDrop *mUploadQueueMockDrop) Return(err error) *UploadQueueMock {
+	if mmDrop.mock.funcDrop != nil {
+		mmDrop.mock.t.Fatalf("UploadQueueMock.Drop mock is already set by Set")
+	}
+
+	if mmDrop.defaultExpectation == nil {
+		mmDrop.defaultExpectation = &UploadQueueMockDropExpectation{mock: mmDrop.mock}
+	}
+	mmDrop.defaultExpectation.results = &UploadQueueMockDropResults{err}
+	return mmDrop.mock
+}
+
+//Set uses given function f to mock the UploadQueue.Drop method
+func (mmDrop *mUploadQueueMockDrop) Set(f func(id string) (err error)) *UploadQueueMock {
+	if mmDrop.defaultExpectation != nil {
+		mmDrop.mock.t.Fatalf("Default expectation is already set for the UploadQueue.Drop method")
+	}
+
+	if len(mmDrop.expectations) > 0 {
+		mmDrop.mock.t.Fatalf("Some expectations are already set for the UploadQueue.Drop method")
+	}
+
+	mmDrop.mock.funcDrop = f
+	return mmDrop.mock
+}
+
+// When sets expectation for the UploadQueue.Drop which will trigger the result defined by the following
+// Then helper
+func (mmDrop *mUploadQueueMockDrop) When(id string) *UploadQueueMockDropExpectation {
+	if mmDrop.mock.funcDrop != nil {
+		mmDrop.mock.t.Fatalf("UploadQueueMock.Drop mock is already set by Set")
+	}
+
+	expectation := &UploadQueueMockDropExpectation{
+		mock:   mmDrop.mock,
+		params: &UploadQueueMockDropParams{id},
+	}
+	mmDrop.expectations = append(mmDrop.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UploadQueue.Drop return parameters for the expectation previously defined by the When method
+func (e *UploadQueueMockDropExpectation) Then(err error) *UploadQueueMock {
+	e.results = &UploadQueueMockDropResults{err}
+	return e.mock
+}
+
+// Drop implements admin.UploadQueue
+func (mmDrop *UploadQueueMock) Drop(id string) (err error) {
+	mm_atomic.AddUint64(&mmDrop.beforeDropCounter, 1)
+	defer mm_atomic.AddUint64(&mmDrop.afterDropCounter, 1)
+
+	if mmDrop.inspectFuncDrop != nil {
+		mmDrop.inspectFuncDrop(id)
+	}
+
+	mm_params := &UploadQueueMockDropParams{id}
+
+	// Record call args
+	mmDrop.DropMock.mutex.Lock()
+	mmDrop.DropMock.callArgs = append(mmDrop.DropMock.callArgs, mm_params)
+	mmDrop.DropMock.mutex.Unlock()
+
+	for _, e := range mmDrop.DropMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDrop.DropMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDrop.DropMock.defaultExpectation.Counter, 1)
+		mm_want := mmDrop.DropMock.defaultExpectation.params
+		mm_got := UploadQueueMockDropParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDrop.t.Errorf("UploadQueueMock.Drop got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDrop.DropMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDrop.t.Fatal("No results are set for the UploadQueueMock.Drop")
+		}
+		return (*mm_results).err
+	}
+	if mmDrop.funcDrop != nil {
+		return mmDrop.funcDrop(id)
+	}
+	mmDrop.t.Fatalf("Unexpected call to UploadQueueMock.Drop. %v", id)
+	return
+}
+
+// DropAfterCounter returns a count of finished UploadQueueMock.Drop invocations
+func (mmDrop *UploadQueueMock) DropAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrop.afterDropCounter)
+}
+
+// DropBeforeCounter returns a count of UploadQueueMock.Drop invocations
+func (mmDrop *UploadQueueMock) DropBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrop.beforeDropCounter)
+}
+
+// Calls returns a list of arguments used in each call to UploadQueueMock.Drop.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDrop *mUploadQueueMockDrop) Calls() []*UploadQueueMockDropParams {
+	mmDrop.mutex.RLock()
+
+	argCopy := make([]*UploadQueueMockDropParams, len(mmDrop.callArgs))
+	copy(argCopy, mmDrop.callArgs)
+
+	mmDrop.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDropDone returns true if the count of the Drop invocations corresponds
+// the number of defined expectations
+func (m *UploadQueueMock) MinimockDropDone() bool {
+	for _, e := range m.DropMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DropMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDropCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrop != nil && mm_atomic.LoadUint64(&m.afterDropCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDropInspect logs each unmet expectation
+func (m *UploadQueueMock) MinimockDropInspect() {
+	for _, e := range m.DropMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UploadQueueMock.Drop with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DropMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDropCounter) < 1 {
+		if m.DropMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UploadQueueMock.Drop")
+		} else {
+			m.t.Errorf("Expected call to UploadQueueMock.Drop with params: %#v", *m.DropMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrop != nil && mm_atomic.LoadUint64(&m.afterDropCounter) < 1 {
+		m.t.Error("Expected call to UploadQueueMock.Drop")
+	}
+}
+
+type mUploadQueueMockList struct {
+	mock               *UploadQueueMock
+	defaultExpectation *UploadQueueMockListExpectation
+	expectations       []*UploadQueueMockListExpectation
+}
+
+// UploadQueueMockListExpectation specifies expectation struct of the UploadQueue.List
+type UploadQueueMockListExpectation struct {
+	mock *UploadQueueMock
+
+	results *UploadQueueMockListResults
+	Counter uint64
+}
+
+// UploadQueueMockListResults contains results of the UploadQueue.List
+type UploadQueueMockListResults struct {
+	ea1 []outbox.Entry
+	err error
+}
+
+// Expect sets up expected params for UploadQueue.List
+func (mmList *mUploadQueueMockList) Expect() *mUploadQueueMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UploadQueueMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UploadQueueMockListExpectation{}
+	}
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the UploadQueue.List
+func (mmList *mUploadQueueMockList) Inspect(f func()) *mUploadQueueMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for UploadQueueMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by UploadQueue.List
+func (mmList *mUploadQueueMockList) Return(ea1 []outbox.Entry, err error) *UploadQueueMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UploadQueueMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UploadQueueMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &UploadQueueMockListResults{ea1, err}
+	return mmList.mock
+}
+
+//Set uses given function f to mock the UploadQueue.List method
+func (mmList *mUploadQueueMockList) Set(f func() (ea1 []outbox.Entry, err error)) *UploadQueueMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the UploadQueue.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the UploadQueue.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// List implements admin.UploadQueue
+func (mmList *UploadQueueMock) List() (ea1 []outbox.Entry, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList()
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the UploadQueueMock.List")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList()
+	}
+	mmList.t.Fatalf("Unexpected call to UploadQueueMock.List.")
+	return
+}
+
+// ListAfterCounter returns a count of finished UploadQueueMock.List invocations
+func (mmList *UploadQueueMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of UploadQueueMock.List invocations
+func (mmList *UploadQueueMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *UploadQueueMock) MinimockListDone() bool {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *UploadQueueMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to UploadQueueMock.List")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		m.t.Error("Expected call to UploadQueueMock.List")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && mm_atomic.LoadUint64(&m.afterListCounter) < 1 {
+		m.t.Error("Expected call to UploadQueueMock.List")
+	}
+}
+
+type mUploadQueueMockRetry struct {
+	mock               *UploadQueueMock
+	defaultExpectation *UploadQueueMockRetryExpectation
+	expectations       []*UploadQueueMockRetryExpectation
+
+	callArgs []*UploadQueueMockRetryParams
+	mutex    sync.RWMutex
+}
+
+// UploadQueueMockRetryExpectation specifies expectation struct of the UploadQueue.Retry
+type UploadQueueMockRetryExpectation struct {
+	mock    *UploadQueueMock
+	params  *UploadQueueMockRetryParams
+	results *UploadQueueMockRetryResults
+	Counter uint64
+}
+
+// UploadQueueMockRetryParams contains parameters of the UploadQueue.Retry
+type UploadQueueMockRetryParams struct {
+	ctx context.Context
+	id  string
+}
+
+// UploadQueueMockRetryResults contains results of the UploadQueue.Retry
+type UploadQueueMockRetryResults struct {
+	err error
+}
+
+// Expect sets up expected params for UploadQueue.Retry
+func (mmRetry *mUploadQueueMockRetry) Expect(ctx context.Context, id string) *mUploadQueueMockRetry {
+	if mmRetry.mock.funcRetry != nil {
+		mmRetry.mock.t.Fatalf("UploadQueueMock.Retry mock is already set by Set")
+	}
+
+	if mmRetry.defaultExpectation == nil {
+		mmRetry.defaultExpectation = &UploadQueueMockRetryExpectation{}
+	}
+
+	mmRetry.defaultExpectation.params = &UploadQueueMockRetryParams{ctx, id}
+	for _, e := range mmRetry.expectations {
+		if minimock.Equal(e.params, mmRetry.defaultExpectation.params) {
+			mmRetry.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRetry.defaultExpectation.params)
+		}
+	}
+
+	return mmRetry
+}
+
+// Inspect accepts an inspector function that has same arguments as the UploadQueue.Retry
+func (mmRetry *mUploadQueueMockRetry) Inspect(f func(ctx context.Context, id string)) *mUploadQueueMockRetry {
+	if mmRetry.mock.inspectFuncRetry != nil {
+		mmRetry.mock.t.Fatalf("Inspect function is already set for UploadQueueMock.Retry")
+	}
+
+	mmRetry.mock.inspectFuncRetry = f
+
+	return mmRetry
+}
+
+// Return sets up results that will be returned by UploadQueue.Retry
+func (mmRetry *mUploadQueueMockRetry) Return(err error) *UploadQueueMock {
+	if mmRetry.mock.funcRetry != nil {
+		mmRetry.mock.t.Fatalf("UploadQueueMock.Retry mock is already set by Set")
+	}
+
+	if mmRetry.defaultExpectation == nil {
+		mmRetry.defaultExpectation = &UploadQueueMockRetryExpectation{mock: mmRetry.mock}
+	}
+	mmRetry.defaultExpectation.results = &UploadQueueMockRetryResults{err}
+	return mmRetry.mock
+}
+
+//Set uses given function f to mock the UploadQueue.Retry method
+func (mmRetry *mUploadQueueMockRetry) Set(f func(ctx context.Context, id string) (err error)) *UploadQueueMock {
+	if mmRetry.defaultExpectation != nil {
+		mmRetry.mock.t.Fatalf("Default expectation is already set for the UploadQueue.Retry method")
+	}
+
+	if len(mmRetry.expectations) > 0 {
+		mmRetry.mock.t.Fatalf("Some expectations are already set for the UploadQueue.Retry method")
+	}
+
+	mmRetry.mock.funcRetry = f
+	return mmRetry.mock
+}
+
+// When sets expectation for the UploadQueue.Retry which will trigger the result defined by the following
+// Then helper
+func (mmRetry *mUploadQueueMockRetry) When(ctx context.Context, id string) *UploadQueueMockRetryExpectation {
+	if mmRetry.mock.funcRetry != nil {
+		mmRetry.mock.t.Fatalf("UploadQueueMock.Retry mock is already set by Set")
+	}
+
+	expectation := &UploadQueueMockRetryExpectation{
+		mock:   mmRetry.mock,
+		params: &UploadQueueMockRetryParams{ctx, id},
+	}
+	mmRetry.expectations = append(mmRetry.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UploadQueue.Retry return parameters for the expectation previously defined by the When method
+func (e *UploadQueueMockRetryExpectation) Then(err error) *UploadQueueMock {
+	e.results = &UploadQueueMockRetryResults{err}
+	return e.mock
+}
+
+// Retry implements admin.UploadQueue
+func (mmRetry *UploadQueueMock) Retry(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRetry.beforeRetryCounter, 1)
+	defer mm_atomic.AddUint64(&mmRetry.afterRetryCounter, 1)
+
+	if mmRetry.inspectFuncRetry != nil {
+		mmRetry.inspectFuncRetry(ctx, id)
+	}
+
+	mm_params := &UploadQueueMockRetryParams{ctx, id}
+
+	// Record call args
+	mmRetry.RetryMock.mutex.Lock()
+	mmRetry.RetryMock.callArgs = append(mmRetry.RetryMock.callArgs, mm_params)
+	mmRetry.RetryMock.mutex.Unlock()
+
+	for _, e := range mmRetry.RetryMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRetry.RetryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRetry.RetryMock.defaultExpectation.Counter, 1)
+		mm_want := mmRetry.RetryMock.defaultExpectation.params
+		mm_got := UploadQueueMockRetryParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRetry.t.Errorf("UploadQueueMock.Retry got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRetry.RetryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRetry.t.Fatal("No results are set for the UploadQueueMock.Retry")
+		}
+		return (*mm_results).err
+	}
+	if mmRetry.funcRetry != nil {
+		return mmRetry.funcRetry(ctx, id)
+	}
+	mmRetry.t.Fatalf("Unexpected call to UploadQueueMock.Retry. %v %v", ctx, id)
+	return
+}
+
+// RetryAfterCounter returns a count of finished UploadQueueMock.Retry invocations
+func (mmRetry *UploadQueueMock) RetryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetry.afterRetryCounter)
+}
+
+// RetryBeforeCounter returns a count of UploadQueueMock.Retry invocations
+func (mmRetry *UploadQueueMock) RetryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetry.beforeRetryCounter)
+}
+
+// Calls returns a list of arguments used in each call to UploadQueueMock.Retry.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRetry *mUploadQueueMockRetry) Calls() []*UploadQueueMockRetryParams {
+	mmRetry.mutex.RLock()
+
+	argCopy := make([]*UploadQueueMockRetryParams, len(mmRetry.callArgs))
+	copy(argCopy, mmRetry.callArgs)
+
+	mmRetry.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRetryDone returns true if the count of the Retry invocations corresponds
+// the number of defined expectations
+func (m *UploadQueueMock) MinimockRetryDone() bool {
+	for _, e := range m.RetryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRetryCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetry != nil && mm_atomic.LoadUint64(&m.afterRetryCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRetryInspect logs each unmet expectation
+func (m *UploadQueueMock) MinimockRetryInspect() {
+	for _, e := range m.RetryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UploadQueueMock.Retry with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRetryCounter) < 1 {
+		if m.RetryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UploadQueueMock.Retry")
+		} else {
+			m.t.Errorf("Expected call to UploadQueueMock.Retry with params: %#v", *m.RetryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetry != nil && mm_atomic.LoadUint64(&m.afterRetryCounter) < 1 {
+		m.t.Error("Expected call to UploadQueueMock.Retry")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UploadQueueMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockDropInspect()
+
+		m.MinimockListInspect()
+
+		m.MinimockRetryInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UploadQueueMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UploadQueueMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDropDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRetryDone()
+}
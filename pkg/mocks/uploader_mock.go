@@ -0,0 +1,517 @@
+package mocks
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i LogWatcher/pkg/outbox.Uploader -o ./pkg/mocks/uploader_mock.go
+
+import (
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UploaderMock implements outbox.Uploader
+type UploaderMock struct {
+	t minimock.Tester
+
+	funcMakeMultipartMap          func(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader)
+	inspectFuncMakeMultipartMap   func(title string, gameMap string, buf bytes.Buffer)
+	afterMakeMultipartMapCounter  uint64
+	beforeMakeMultipartMapCounter uint64
+	MakeMultipartMapMock          mUploaderMockMakeMultipartMap
+
+	funcUploadLogFile          func(ctx context.Context, payload map[string]io.Reader) (up1 *requests.UploadResult, err error)
+	inspectFuncUploadLogFile   func(ctx context.Context, payload map[string]io.Reader)
+	afterUploadLogFileCounter  uint64
+	beforeUploadLogFileCounter uint64
+	UploadLogFileMock          mUploaderMockUploadLogFile
+}
+
+// NewUploaderMock returns a mock for outbox.Uploader
+func NewUploaderMock(t minimock.Tester) *UploaderMock {
+	m := &UploaderMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.MakeMultipartMapMock = mUploaderMockMakeMultipartMap{mock: m}
+	m.MakeMultipartMapMock.callArgs = []*UploaderMockMakeMultipartMapParams{}
+
+	m.UploadLogFileMock = mUploaderMockUploadLogFile{mock: m}
+	m.UploadLogFileMock.callArgs = []*UploaderMockUploadLogFileParams{}
+
+	return m
+}
+
+type mUploaderMockMakeMultipartMap struct {
+	mock               *UploaderMock
+	defaultExpectation *UploaderMockMakeMultipartMapExpectation
+	expectations       []*UploaderMockMakeMultipartMapExpectation
+
+	callArgs []*UploaderMockMakeMultipartMapParams
+	mutex    sync.RWMutex
+}
+
+// UploaderMockMakeMultipartMapExpectation specifies expectation struct of the Uploader.MakeMultipartMap
+type UploaderMockMakeMultipartMapExpectation struct {
+	mock    *UploaderMock
+	params  *UploaderMockMakeMultipartMapParams
+	results *UploaderMockMakeMultipartMapResults
+	Counter uint64
+}
+
+// UploaderMockMakeMultipartMapParams contains parameters of the Uploader.MakeMultipartMap
+type UploaderMockMakeMultipartMapParams struct {
+	title   string
+	gameMap string
+	buf     bytes.Buffer
+}
+
+// UploaderMockMakeMultipartMapResults contains results of the Uploader.MakeMultipartMap
+type UploaderMockMakeMultipartMapResults struct {
+	m1 map[string]io.Reader
+}
+
+// Expect sets up expected params for Uploader.MakeMultipartMap
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) Expect(title string, gameMap string, buf bytes.Buffer) *mUploaderMockMakeMultipartMap {
+	if mmMakeMultipartMap.mock.funcMakeMultipartMap != nil {
+		mmMakeMultipartMap.mock.t.Fatalf("UploaderMock.MakeMultipartMap mock is already set by Set")
+	}
+
+	if mmMakeMultipartMap.defaultExpectation == nil {
+		mmMakeMultipartMap.defaultExpectation = &UploaderMockMakeMultipartMapExpectation{}
+	}
+
+	mmMakeMultipartMap.defaultExpectation.params = &UploaderMockMakeMultipartMapParams{title, gameMap, buf}
+	for _, e := range mmMakeMultipartMap.expectations {
+		if minimock.Equal(e.params, mmMakeMultipartMap.defaultExpectation.params) {
+			mmMakeMultipartMap.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMakeMultipartMap.defaultExpectation.params)
+		}
+	}
+
+	return mmMakeMultipartMap
+}
+
+// Inspect accepts an inspector function that has same arguments as the Uploader.MakeMultipartMap
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) Inspect(f func(title string, gameMap string, buf bytes.Buffer)) *mUploaderMockMakeMultipartMap {
+	if mmMakeMultipartMap.mock.inspectFuncMakeMultipartMap != nil {
+		mmMakeMultipartMap.mock.t.Fatalf("Inspect function is already set for UploaderMock.MakeMultipartMap")
+	}
+
+	mmMakeMultipartMap.mock.inspectFuncMakeMultipartMap = f
+
+	return mmMakeMultipartMap
+}
+
+// Return sets up results that will be returned by Uploader.MakeMultipartMap
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) Return(m1 map[string]io.Reader) *UploaderMock {
+	if mmMakeMultipartMap.mock.funcMakeMultipartMap != nil {
+		mmMakeMultipartMap.mock.t.Fatalf("UploaderMock.MakeMultipartMap mock is already set by Set")
+	}
+
+	if mmMakeMultipartMap.defaultExpectation == nil {
+		mmMakeMultipartMap.defaultExpectation = &UploaderMockMakeMultipartMapExpectation{mock: mmMakeMultipartMap.mock}
+	}
+	mmMakeMultipartMap.defaultExpectation.results = &UploaderMockMakeMultipartMapResults{m1}
+	return mmMakeMultipartMap.mock
+}
+
+//Set uses given function f to mock the Uploader.MakeMultipartMap method
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) Set(f func(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader)) *UploaderMock {
+	if mmMakeMultipartMap.defaultExpectation != nil {
+		mmMakeMultipartMap.mock.t.Fatalf("Default expectation is already set for the Uploader.MakeMultipartMap method")
+	}
+
+	if len(mmMakeMultipartMap.expectations) > 0 {
+		mmMakeMultipartMap.mock.t.Fatalf("Some expectations are already set for the Uploader.MakeMultipartMap method")
+	}
+
+	mmMakeMultipartMap.mock.funcMakeMultipartMap = f
+	return mmMakeMultipartMap.mock
+}
+
+// When sets expectation for the Uploader.MakeMultipartMap which will trigger the result defined by the following
+// Then helper
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) When(title string, gameMap string, buf bytes.Buffer) *UploaderMockMakeMultipartMapExpectation {
+	if mmMakeMultipartMap.mock.funcMakeMultipartMap != nil {
+		mmMakeMultipartMap.mock.t.Fatalf("UploaderMock.MakeMultipartMap mock is already set by Set")
+	}
+
+	expectation := &UploaderMockMakeMultipartMapExpectation{
+		mock:   mmMakeMultipartMap.mock,
+		params: &UploaderMockMakeMultipartMapParams{title, gameMap, buf},
+	}
+	mmMakeMultipartMap.expectations = append(mmMakeMultipartMap.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Uploader.MakeMultipartMap return parameters for the expectation previously defined by the When method
+func (e *UploaderMockMakeMultipartMapExpectation) Then(m1 map[string]io.Reader) *UploaderMock {
+	e.results = &UploaderMockMakeMultipartMapResults{m1}
+	return e.mock
+}
+
+// MakeMultipartMap implements outbox.Uploader
+func (mmMakeMultipartMap *UploaderMock) MakeMultipartMap(title string, gameMap string, buf bytes.Buffer) (m1 map[string]io.Reader) {
+	mm_atomic.AddUint64(&mmMakeMultipartMap.beforeMakeMultipartMapCounter, 1)
+	defer mm_atomic.AddUint64(&mmMakeMultipartMap.afterMakeMultipartMapCounter, 1)
+
+	if mmMakeMultipartMap.inspectFuncMakeMultipartMap != nil {
+		mmMakeMultipartMap.inspectFuncMakeMultipartMap(title, gameMap, buf)
+	}
+
+	mm_params := &UploaderMockMakeMultipartMapParams{title, gameMap, buf}
+
+	// Record call args
+	mmMakeMultipartMap.MakeMultipartMapMock.mutex.Lock()
+	mmMakeMultipartMap.MakeMultipartMapMock.callArgs = append(mmMakeMultipartMap.MakeMultipartMapMock.callArgs, mm_params)
+	mmMakeMultipartMap.MakeMultipartMapMock.mutex.Unlock()
+
+	for _, e := range mmMakeMultipartMap.MakeMultipartMapMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1
+		}
+	}
+
+	if mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation.Counter, 1)
+		mm_want := mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation.params
+		mm_got := UploaderMockMakeMultipartMapParams{title, gameMap, buf}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMakeMultipartMap.t.Errorf("UploaderMock.MakeMultipartMap got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMakeMultipartMap.MakeMultipartMapMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMakeMultipartMap.t.Fatal("No results are set for the UploaderMock.MakeMultipartMap")
+		}
+		return (*mm_results).m1
+	}
+	if mmMakeMultipartMap.funcMakeMultipartMap != nil {
+		return mmMakeMultipartMap.funcMakeMultipartMap(title, gameMap, buf)
+	}
+	mmMakeMultipartMap.t.Fatalf("Unexpected call to UploaderMock.MakeMultipartMap. %v %v %v", title, gameMap, buf)
+	return
+}
+
+// MakeMultipartMapAfterCounter returns a count of finished UploaderMock.MakeMultipartMap invocations
+func (mmMakeMultipartMap *UploaderMock) MakeMultipartMapAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeMultipartMap.afterMakeMultipartMapCounter)
+}
+
+// MakeMultipartMapBeforeCounter returns a count of UploaderMock.MakeMultipartMap invocations
+func (mmMakeMultipartMap *UploaderMock) MakeMultipartMapBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMakeMultipartMap.beforeMakeMultipartMapCounter)
+}
+
+// Calls returns a list of arguments used in each call to UploaderMock.MakeMultipartMap.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMakeMultipartMap *mUploaderMockMakeMultipartMap) Calls() []*UploaderMockMakeMultipartMapParams {
+	mmMakeMultipartMap.mutex.RLock()
+
+	argCopy := make([]*UploaderMockMakeMultipartMapParams, len(mmMakeMultipartMap.callArgs))
+	copy(argCopy, mmMakeMultipartMap.callArgs)
+
+	mmMakeMultipartMap.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMakeMultipartMapDone returns true if the count of the MakeMultipartMap invocations corresponds
+// the number of defined expectations
+func (m *UploaderMock) MinimockMakeMultipartMapDone() bool {
+	for _, e := range m.MakeMultipartMapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MakeMultipartMapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMakeMultipartMapCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMakeMultipartMap != nil && mm_atomic.LoadUint64(&m.afterMakeMultipartMapCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMakeMultipartMapInspect logs each unmet expectation
+func (m *UploaderMock) MinimockMakeMultipartMapInspect() {
+	for _, e := range m.MakeMultipartMapMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UploaderMock.MakeMultipartMap with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MakeMultipartMapMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMakeMultipartMapCounter) < 1 {
+		if m.MakeMultipartMapMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UploaderMock.MakeMultipartMap")
+		} else {
+			m.t.Errorf("Expected call to UploaderMock.MakeMultipartMap with params: %#v", *m.MakeMultipartMapMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMakeMultipartMap != nil && mm_atomic.LoadUint64(&m.afterMakeMultipartMapCounter) < 1 {
+		m.t.Error("Expected call to UploaderMock.MakeMultipartMap")
+	}
+}
+
+type mUploaderMockUploadLogFile struct {
+	mock               *UploaderMock
+	defaultExpectation *UploaderMockUploadLogFileExpectation
+	expectations       []*UploaderMockUploadLogFileExpectation
+
+	callArgs []*UploaderMockUploadLogFileParams
+	mutex    sync.RWMutex
+}
+
+// UploaderMockUploadLogFileExpectation specifies expectation struct of the Uploader.UploadLogFile
+type UploaderMockUploadLogFileExpectation struct {
+	mock    *UploaderMock
+	params  *UploaderMockUploadLogFileParams
+	results *UploaderMockUploadLogFileResults
+	Counter uint64
+}
+
+// UploaderMockUploadLogFileParams contains parameters of the Uploader.UploadLogFile
+type UploaderMockUploadLogFileParams struct {
+	ctx     context.Context
+	payload map[string]io.Reader
+}
+
+// UploaderMockUploadLogFileResults contains results of the Uploader.UploadLogFile
+type UploaderMockUploadLogFileResults struct {
+	up1 *requests.UploadResult
+	err error
+}
+
+// Expect sets up expected params for Uploader.UploadLogFile
+func (mmUploadLogFile *mUploaderMockUploadLogFile) Expect(ctx context.Context, payload map[string]io.Reader) *mUploaderMockUploadLogFile {
+	if mmUploadLogFile.mock.funcUploadLogFile != nil {
+		mmUploadLogFile.mock.t.Fatalf("UploaderMock.UploadLogFile mock is already set by Set")
+	}
+
+	if mmUploadLogFile.defaultExpectation == nil {
+		mmUploadLogFile.defaultExpectation = &UploaderMockUploadLogFileExpectation{}
+	}
+
+	mmUploadLogFile.defaultExpectation.params = &UploaderMockUploadLogFileParams{ctx, payload}
+	for _, e := range mmUploadLogFile.expectations {
+		if minimock.Equal(e.params, mmUploadLogFile.defaultExpectation.params) {
+			mmUploadLogFile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadLogFile.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadLogFile
+}
+
+// Inspect accepts an inspector function that has same arguments as the Uploader.UploadLogFile
+func (mmUploadLogFile *mUploaderMockUploadLogFile) Inspect(f func(ctx context.Context, payload map[string]io.Reader)) *mUploaderMockUploadLogFile {
+	if mmUploadLogFile.mock.inspectFuncUploadLogFile != nil {
+		mmUploadLogFile.mock.t.Fatalf("Inspect function is already set for UploaderMock.UploadLogFile")
+	}
+
+	mmUploadLogFile.mock.inspectFuncUploadLogFile = f
+
+	return mmUploadLogFile
+}
+
+// Return sets up results that will be returned by Uploader.UploadLogFile
+func (mmUploadLogFile *mUploaderMockUploadLogFile) Return(up1 *requests.UploadResult, err error) *UploaderMock {
+	if mmUploadLogFile.mock.funcUploadLogFile != nil {
+		mmUploadLogFile.mock.t.Fatalf("UploaderMock.UploadLogFile mock is already set by Set")
+	}
+
+	if mmUploadLogFile.defaultExpectation == nil {
+		mmUploadLogFile.defaultExpectation = &UploaderMockUploadLogFileExpectation{mock: mmUploadLogFile.mock}
+	}
+	mmUploadLogFile.defaultExpectation.results = &UploaderMockUploadLogFileResults{up1, err}
+	return mmUploadLogFile.mock
+}
+
+//Set uses given function f to mock the Uploader.UploadLogFile method
+func (mmUploadLogFile *mUploaderMockUploadLogFile) Set(f func(ctx context.Context, payload map[string]io.Reader) (up1 *requests.UploadResult, err error)) *UploaderMock {
+	if mmUploadLogFile.defaultExpectation != nil {
+		mmUploadLogFile.mock.t.Fatalf("Default expectation is already set for the Uploader.UploadLogFile method")
+	}
+
+	if len(mmUploadLogFile.expectations) > 0 {
+		mmUploadLogFile.mock.t.Fatalf("Some expectations are already set for the Uploader.UploadLogFile method")
+	}
+
+	mmUploadLogFile.mock.funcUploadLogFile = f
+	return mmUploadLogFile.mock
+}
+
+// When sets expectation for the Uploader.UploadLogFile which will trigger the result defined by the following
+// Then helper
+func (mmUploadLogFile *mUploaderMockUploadLogFile) When(ctx context.Context, payload map[string]io.Reader) *UploaderMockUploadLogFileExpectation {
+	if mmUploadLogFile.mock.funcUploadLogFile != nil {
+		mmUploadLogFile.mock.t.Fatalf("UploaderMock.UploadLogFile mock is already set by Set")
+	}
+
+	expectation := &UploaderMockUploadLogFileExpectation{
+		mock:   mmUploadLogFile.mock,
+		params: &UploaderMockUploadLogFileParams{ctx, payload},
+	}
+	mmUploadLogFile.expectations = append(mmUploadLogFile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Uploader.UploadLogFile return parameters for the expectation previously defined by the When method
+func (e *UploaderMockUploadLogFileExpectation) Then(up1 *requests.UploadResult, err error) *UploaderMock {
+	e.results = &UploaderMockUploadLogFileResults{up1, err}
+	return e.mock
+}
+
+// UploadLogFile implements outbox.Uploader
+func (mmUploadLogFile *UploaderMock) UploadLogFile(ctx context.Context, payload map[string]io.Reader) (up1 *requests.UploadResult, err error) {
+	mm_atomic.AddUint64(&mmUploadLogFile.beforeUploadLogFileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadLogFile.afterUploadLogFileCounter, 1)
+
+	if mmUploadLogFile.inspectFuncUploadLogFile != nil {
+		mmUploadLogFile.inspectFuncUploadLogFile(ctx, payload)
+	}
+
+	mm_params := &UploaderMockUploadLogFileParams{ctx, payload}
+
+	// Record call args
+	mmUploadLogFile.UploadLogFileMock.mutex.Lock()
+	mmUploadLogFile.UploadLogFileMock.callArgs = append(mmUploadLogFile.UploadLogFileMock.callArgs, mm_params)
+	mmUploadLogFile.UploadLogFileMock.mutex.Unlock()
+
+	for _, e := range mmUploadLogFile.UploadLogFileMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUploadLogFile.UploadLogFileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadLogFile.UploadLogFileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadLogFile.UploadLogFileMock.defaultExpectation.params
+		mm_got := UploaderMockUploadLogFileParams{ctx, payload}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadLogFile.t.Errorf("UploaderMock.UploadLogFile got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadLogFile.UploadLogFileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadLogFile.t.Fatal("No results are set for the UploaderMock.UploadLogFile")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUploadLogFile.funcUploadLogFile != nil {
+		return mmUploadLogFile.funcUploadLogFile(ctx, payload)
+	}
+	mmUploadLogFile.t.Fatalf("Unexpected call to UploaderMock.UploadLogFile. %v %v", ctx, payload)
+	return
+}
+
+// UploadLogFileAfterCounter returns a count of finished UploaderMock.UploadLogFile invocations
+func (mmUploadLogFile *UploaderMock) UploadLogFileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadLogFile.afterUploadLogFileCounter)
+}
+
+// UploadLogFileBeforeCounter returns a count of UploaderMock.UploadLogFile invocations
+func (mmUploadLogFile *UploaderMock) UploadLogFileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadLogFile.beforeUploadLogFileCounter)
+}
+
+// Calls returns a list of arguments used in each call to UploaderMock.UploadLogFile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadLogFile *mUploaderMockUploadLogFile) Calls() []*UploaderMockUploadLogFileParams {
+	mmUploadLogFile.mutex.RLock()
+
+	argCopy := make([]*UploaderMockUploadLogFileParams, len(mmUploadLogFile.callArgs))
+	copy(argCopy, mmUploadLogFile.callArgs)
+
+	mmUploadLogFile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadLogFileDone returns true if the count of the UploadLogFile invocations corresponds
+// the number of defined expectations
+func (m *UploaderMock) MinimockUploadLogFileDone() bool {
+	for _, e := range m.UploadLogFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadLogFileMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUploadLogFileCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadLogFile != nil && mm_atomic.LoadUint64(&m.afterUploadLogFileCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUploadLogFileInspect logs each unmet expectation
+func (m *UploaderMock) MinimockUploadLogFileInspect() {
+	for _, e := range m.UploadLogFileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UploaderMock.UploadLogFile with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadLogFileMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUploadLogFileCounter) < 1 {
+		if m.UploadLogFileMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UploaderMock.UploadLogFile")
+		} else {
+			m.t.Errorf("Expected call to UploaderMock.UploadLogFile with params: %#v", *m.UploadLogFileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadLogFile != nil && mm_atomic.LoadUint64(&m.afterUploadLogFileCounter) < 1 {
+		m.t.Error("Expected call to UploaderMock.UploadLogFile")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UploaderMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockMakeMultipartMapInspect()
+
+		m.MinimockUploadLogFileInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UploaderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UploaderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockMakeMultipartMapDone() &&
+		m.MinimockUploadLogFileDone()
+}
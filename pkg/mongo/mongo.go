@@ -1,39 +1,163 @@
 package mongo
 
 import (
+	"LogWatcher/pkg/metrics"
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// DefaultMatchCollection is used for match documents if collection is not configured
+const DefaultMatchCollection = "matches"
+
+// indexTimeout limits index creation on startup, so unreachable db doesn't block it
+const indexTimeout = 10 * time.Second
+
+const (
+	// statsIndexName is a name of unique index which makes player's stats document unique within pickup
+	statsIndexName = "domain_pickupid_player_steam_id"
+	// matchIndexName is a name of unique index which makes match document unique
+	matchIndexName = "domain_pickupid_launchedat"
+)
+
+var (
+	// statsKeyFields are paths of fields which identify player's stats document
+	statsKeyFields = [][]string{{"domain"}, {"pickupid"}, {"player", "steam_id"}}
+	// matchKeyFields are paths of fields which identify match document,
+	// launch time is needed as pickup id is zero if pickup wasn't found
+	matchKeyFields = [][]string{{"domain"}, {"pickupid"}, {"launchedat"}}
 )
 
 type Mongo struct {
-	database, collection string
-	ctx                  context.Context
-	conn                 *mongo.Client
+	database, collection, matchCollection string
+	conn                                  *mongo.Client
 }
 
 type Inserter interface {
-	InsertGameStats(documents []interface{}) error
+	InsertGameStats(ctx context.Context, documents []interface{}) error
+	InsertMatch(ctx context.Context, document interface{}) error
 }
 
-func NewMongo(ctx context.Context, dsn, database, collection string) (*Mongo, error) {
+// NewMongo is a factory for Mongo, empty matchCollection means DefaultMatchCollection
+func NewMongo(ctx context.Context, dsn, database, collection, matchCollection string) (*Mongo, error) {
 	conn, err := mongo.Connect(ctx, options.Client().ApplyURI(dsn))
 	if err != nil {
 		return nil, err
 	}
+	if matchCollection == "" {
+		matchCollection = DefaultMatchCollection
+	}
 	return &Mongo{
-		database:   database,
-		collection: collection,
-		ctx:        ctx,
-		conn:       conn,
+		database:        database,
+		collection:      collection,
+		matchCollection: matchCollection,
+		conn:            conn,
 	}, nil
 }
 
-func (m *Mongo) InsertGameStats(documents []interface{}) error {
+// InsertGameStats writes player's stats with upserts, so re-processing same match is safe
+func (m *Mongo) InsertGameStats(ctx context.Context, documents []interface{}) error {
+	return m.UpsertGameStats(ctx, documents)
+}
+
+// InsertMatch writes match document with upsert, so re-processing same match is safe
+func (m *Mongo) InsertMatch(ctx context.Context, document interface{}) error {
+	key, err := MatchKey(document)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	_, err = m.conn.
+		Database(m.database).
+		Collection(m.matchCollection).
+		ReplaceOne(ctx, key, document, options.Replace().SetUpsert(true))
+	metrics.ObserveMongo("insert_match", start, err)
+	return err
+}
+
+// Ping checks that primary is reachable
+func (m *Mongo) Ping(ctx context.Context) error {
+	return m.conn.Ping(ctx, readpref.Primary())
+}
+
+// EnsureIndexes creates unique indexes on stats and match keys if they don't exist.
+// It fails if collection already has duplicated documents
+func (m *Mongo) EnsureIndexes(ctx context.Context) error {
+	if err := m.createUniqueIndex(ctx, m.collection, statsIndexName, statsKeyFields); err != nil {
+		return err
+	}
+	return m.createUniqueIndex(ctx, m.matchCollection, matchIndexName, matchKeyFields)
+}
+
+func (m *Mongo) createUniqueIndex(ctx context.Context, collection, name string, fields [][]string) error {
+	keys := bson.D{}
+	for _, path := range fields {
+		keys = append(keys, bson.E{Key: strings.Join(path, "."), Value: 1})
+	}
+	ctx, cancel := context.WithTimeout(ctx, indexTimeout)
+	defer cancel()
+	_, err := m.conn.
+		Database(m.database).
+		Collection(collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{
+			Keys:    keys,
+			Options: options.Index().SetName(name).SetUnique(true),
+		})
+	return err
+}
+
+// UpsertGameStats replaces documents with same domain, pickup id and player's steam id or inserts them,
+// so writing same documents twice doesn't duplicate stats
+func (m *Mongo) UpsertGameStats(ctx context.Context, documents []interface{}) error {
+	models := make([]mongo.WriteModel, 0, len(documents))
+	for _, doc := range documents {
+		key, err := DocumentKey(doc)
+		if err != nil {
+			return err
+		}
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(key).SetReplacement(doc).SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	start := time.Now()
 	_, err := m.conn.
 		Database(m.database).
 		Collection(m.collection).
-		InsertMany(m.ctx, documents)
+		BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	metrics.ObserveMongo("upsert_stats", start, err)
 	return err
 }
+
+// DocumentKey returns filter identifying player's stats document: domain, pickup id and player's steam id
+func DocumentKey(doc interface{}) (bson.D, error) {
+	return documentKey(doc, statsKeyFields)
+}
+
+// MatchKey returns filter identifying match document: domain, pickup id and launch time
+func MatchKey(doc interface{}) (bson.D, error) {
+	return documentKey(doc, matchKeyFields)
+}
+
+func documentKey(doc interface{}, fields [][]string) (bson.D, error) {
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	key := bson.D{}
+	for _, path := range fields {
+		value, err := bson.Raw(raw).LookupErr(path...)
+		if err != nil {
+			return nil, fmt.Errorf("document has no %v field: %w", path, err)
+		}
+		key = append(key, bson.E{Key: strings.Join(path, "."), Value: value})
+	}
+	return key, nil
+}
@@ -0,0 +1,61 @@
+package mongo
+
+import (
+	"LogWatcher/pkg/stats"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDocumentKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     interface{}
+		want    bson.D
+		wantErr bool
+	}{
+		{
+			name: "player info",
+			doc: stats.MongoPlayerInfo{
+				Player:   &stats.PickupPlayer{SteamID: "76561198439712695", Name: "test"},
+				Stats:    stats.PlayerStats{Kills: 1},
+				Domain:   "test",
+				PickupID: 12,
+			},
+			want: bson.D{
+				{Key: "domain", Value: "test"},
+				{Key: "pickupid", Value: int32(12)},
+				{Key: "player.steam_id", Value: "76561198439712695"},
+			},
+		},
+		{
+			name:    "no player",
+			doc:     stats.MongoPlayerInfo{Domain: "test", PickupID: 12},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DocumentKey(tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DocumentKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			// raw values hold encoding details, so key is compared decoded
+			raw, err := bson.Marshal(got)
+			if err != nil {
+				t.Fatalf("failed to marshal key: %v", err)
+			}
+			decoded := bson.D{}
+			if err = bson.Unmarshal(raw, &decoded); err != nil {
+				t.Fatalf("failed to unmarshal key: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, decoded); diff != "" {
+				t.Errorf("DocumentKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
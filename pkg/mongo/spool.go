@@ -0,0 +1,201 @@
+package mongo
+
+import (
+	"LogWatcher/pkg/internal/fileutil"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	DefaultReplayInterval = 30 * time.Second
+	spoolExtension        = ".bson"
+)
+
+// Store is a database which can write stats both fast and idempotently
+type Store interface {
+	Inserter
+	UpsertGameStats(ctx context.Context, documents []interface{}) error
+}
+
+// spooledMatch is a kind of batch holding single match document, batches without kind hold player's stats
+const spooledMatch = "match"
+
+// spooledBatch is a file format of spooled documents
+type spooledBatch struct {
+	Kind      string     `bson:"kind,omitempty"`
+	Documents []bson.Raw `bson:"documents"`
+}
+
+// Spool is Inserter which saves batches failed to insert to disk
+// and replays them with upserts when database is reachable again
+type Spool struct {
+	mu       sync.Mutex
+	store    Store
+	dir      string
+	interval time.Duration
+	log      *logrus.Logger
+}
+
+// NewSpool creates spool in dir, creating it if needed, zero interval means DefaultReplayInterval
+func NewSpool(store Store, dir string, interval time.Duration, log *logrus.Logger) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		interval = DefaultReplayInterval
+	}
+	return &Spool{
+		store:    store,
+		dir:      dir,
+		interval: interval,
+		log:      log,
+	}, nil
+}
+
+// InsertGameStats inserts documents into store, spooling them if insert fails or ctx is done.
+// Error is returned only if documents are lost
+func (s *Spool) InsertGameStats(ctx context.Context, documents []interface{}) error {
+	insertErr := s.store.InsertGameStats(ctx, documents)
+	if insertErr == nil {
+		return nil
+	}
+	if err := s.write("", documents); err != nil {
+		return fmt.Errorf("failed to spool documents: %s, insert error: %w", err, insertErr)
+	}
+	s.log.Warnf("Failed to insert stats to db, %d documents were spooled for replay: %s", len(documents), insertErr)
+	return nil
+}
+
+// InsertMatch inserts match document into store, spooling it if insert fails or ctx is done.
+// Error is returned only if document is lost
+func (s *Spool) InsertMatch(ctx context.Context, document interface{}) error {
+	insertErr := s.store.InsertMatch(ctx, document)
+	if insertErr == nil {
+		return nil
+	}
+	if err := s.write(spooledMatch, []interface{}{document}); err != nil {
+		return fmt.Errorf("failed to spool match: %s, insert error: %w", err, insertErr)
+	}
+	s.log.Warnf("Failed to insert match to db, it was spooled for replay: %s", insertErr)
+	return nil
+}
+
+// Pending returns number of spooled batches waiting for replay
+func (s *Spool) Pending() int {
+	files, _ := s.files()
+	return len(files)
+}
+
+// Run replays spooled batches every interval until ctx is done
+func (s *Spool) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.Replay(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Replay upserts spooled batches oldest first, it stops on first failure as database is likely still unreachable
+func (s *Spool) Replay(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := s.files()
+	if err != nil {
+		s.log.Errorf("Failed to read stats spool: %s", err)
+		return
+	}
+	for _, file := range files {
+		batch, err := readBatch(file)
+		if err != nil {
+			s.log.Errorf("Failed to read spooled stats %s, leaving it for manual recovery: %s", file, err)
+			continue
+		}
+		if err = s.replay(ctx, batch); err != nil {
+			s.log.Debugf("Failed to replay spooled stats: %s", err)
+			return
+		}
+		if err = os.Remove(file); err != nil {
+			s.log.Errorf("Failed to remove replayed stats %s: %s", file, err)
+			return
+		}
+		s.log.Infof("Replayed %d spooled documents to db", len(batch.Documents))
+	}
+}
+
+// replay writes batch to store, match document is written with InsertMatch as it is idempotent itself
+func (s *Spool) replay(ctx context.Context, batch *spooledBatch) error {
+	if batch.Kind == spooledMatch {
+		for _, doc := range batch.Documents {
+			if err := s.store.InsertMatch(ctx, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	documents := make([]interface{}, 0, len(batch.Documents))
+	for _, doc := range batch.Documents {
+		documents = append(documents, doc)
+	}
+	return s.store.UpsertGameStats(ctx, documents)
+}
+
+// files returns spooled batches sorted by time they were spooled
+func (s *Spool) files() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, "*"+spoolExtension))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// write saves batch atomically, file name starts with timestamp to keep replay order
+func (s *Spool) write(kind string, documents []interface{}) error {
+	batch := spooledBatch{Kind: kind, Documents: make([]bson.Raw, 0, len(documents))}
+	for _, doc := range documents {
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		batch.Documents = append(batch.Documents, raw)
+	}
+	content, err := bson.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err = rand.Read(suffix); err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("%020d_%s%s", time.Now().UnixNano(), hex.EncodeToString(suffix), spoolExtension))
+	return fileutil.WriteFile(path, content)
+}
+
+func readBatch(path string) (*spooledBatch, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var batch spooledBatch
+	if err = bson.Unmarshal(content, &batch); err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
@@ -0,0 +1,135 @@
+package mongo_test
+
+import (
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/mongo"
+	"LogWatcher/pkg/stats"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSpool_InsertGameStats(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	documents := []interface{}{
+		stats.MongoPlayerInfo{
+			Player:        &stats.PickupPlayer{SteamID: "76561198439712695"},
+			Stats:         stats.PlayerStats{Kills: 1},
+			Domain:        "test",
+			PickupID:      1,
+			SchemaVersion: 1,
+		},
+	}
+	tests := []struct {
+		name        string
+		insertErr   error
+		replayErr   error
+		wantPending int
+	}{
+		{
+			name:        "inserted",
+			wantPending: 0,
+		},
+		{
+			name:        "spooled and replayed",
+			insertErr:   errors.New("test error"),
+			wantPending: 0,
+		},
+		{
+			name:        "spooled and db is still unreachable",
+			insertErr:   errors.New("test error"),
+			replayErr:   errors.New("test error"),
+			wantPending: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var replayed []interface{}
+			store := mocks.NewStoreMock(mc).InsertGameStatsMock.Expect(context.Background(), documents).Return(tt.insertErr)
+			if tt.insertErr != nil {
+				store.UpsertGameStatsMock.Set(func(_ context.Context, documents []interface{}) error {
+					replayed = documents
+					return tt.replayErr
+				})
+			}
+			s, err := mongo.NewSpool(store, t.TempDir(), 0, log)
+			if err != nil {
+				t.Fatalf("NewSpool() error = %v", err)
+			}
+
+			if err = s.InsertGameStats(context.Background(), documents); err != nil {
+				t.Errorf("InsertGameStats() error = %v", err)
+			}
+			s.Replay(context.Background())
+
+			if got := s.Pending(); got != tt.wantPending {
+				t.Errorf("Pending() = %v, want %v", got, tt.wantPending)
+			}
+			if tt.insertErr == nil {
+				return
+			}
+			if len(replayed) != 1 {
+				t.Fatalf("UpsertGameStats() got %v documents, want 1", len(replayed))
+			}
+			var got stats.MongoPlayerInfo
+			if err = bson.Unmarshal(replayed[0].(bson.Raw), &got); err != nil {
+				t.Fatalf("replayed document is not valid bson: %v", err)
+			}
+			if diff := cmp.Diff(documents[0], got); diff != "" {
+				t.Errorf("replayed document mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSpool_InsertMatch(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	document := stats.MongoMatchInfo{Server: "test#1", Domain: "test", PickupID: 1, Map: "cp_process_f9a", SchemaVersion: 1}
+	var replayed interface{}
+	// direct insert fails, replayed raw document is accepted
+	store := mocks.NewStoreMock(mc).InsertMatchMock.Set(func(_ context.Context, doc interface{}) error {
+		if _, ok := doc.(bson.Raw); !ok {
+			return errors.New("test error")
+		}
+		replayed = doc
+		return nil
+	})
+	s, err := mongo.NewSpool(store, t.TempDir(), 0, log)
+	if err != nil {
+		t.Fatalf("NewSpool() error = %v", err)
+	}
+
+	if err = s.InsertMatch(context.Background(), document); err != nil {
+		t.Errorf("InsertMatch() error = %v", err)
+	}
+	if got := s.Pending(); got != 1 {
+		t.Fatalf("Pending() = %v, want 1", got)
+	}
+	s.Replay(context.Background())
+	if got := s.Pending(); got != 0 {
+		t.Errorf("Pending() after Replay() = %v, want 0", got)
+	}
+
+	var got stats.MongoMatchInfo
+	if err = bson.Unmarshal(replayed.(bson.Raw), &got); err != nil {
+		t.Fatalf("replayed document is not valid bson: %v", err)
+	}
+	if diff := cmp.Diff(document, got); diff != "" {
+		t.Errorf("replayed document mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,348 @@
+package notify
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/internal/backoff"
+	"LogWatcher/pkg/metrics"
+	"LogWatcher/pkg/requests"
+	"LogWatcher/pkg/stats"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Event is a kind of match lifecycle notification
+type Event string
+
+const (
+	MatchStart   Event = "match_start"
+	MatchEnd     Event = "match_end"
+	UploadFailed Event = "upload_failed"
+)
+
+const (
+	SignatureHeader = "X-LogWatcher-Signature"
+	EventHeader     = "X-LogWatcher-Event"
+	DeliveryHeader  = "X-LogWatcher-Delivery"
+)
+
+const (
+	DefaultMaxAttempts = 5
+	DefaultBaseDelay   = 5 * time.Second
+	maxDelay           = 5 * time.Minute
+	// queueSize is a number of deliveries waiting for worker, notifications are dropped when it is full
+	queueSize = 1024
+	workers   = 4
+)
+
+// errPermanent marks responses which won't change on retry
+var errPermanent = errors.New("webhook rejected notification")
+
+// Payload is a JSON body of notification
+type Payload struct {
+	Event      Event               `json:"event"`
+	Server     string              `json:"server"`
+	Domain     string              `json:"domain"`
+	PickupID   int                 `json:"pickup_id"`
+	Map        string              `json:"map"`
+	Scores     stats.CurrentScores `json:"scores"`
+	Length     int                 `json:"length"`
+	LogsURL    string              `json:"logs_url,omitempty"`
+	Incomplete bool                `json:"incomplete"`
+	Error      string              `json:"error,omitempty"`
+	Time       time.Time           `json:"time"`
+}
+
+// Notifier accepts notifications of state machines, its methods must never block
+type Notifier interface {
+	Notify(p Payload)
+	Post(name, url string, event Event, body []byte)
+}
+
+type hook struct {
+	name        string
+	url         string
+	secret      string
+	events      map[Event]bool
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+type delivery struct {
+	hook     *hook
+	id       string
+	event    Event
+	body     []byte
+	attempts int
+}
+
+// Dispatcher delivers notifications to configured webhooks in background,
+// failed deliveries are retried with exponential backoff
+type Dispatcher struct {
+	hooks  []*hook
+	client requests.HTTPDoer
+	log    *logrus.Logger
+	queue  chan *delivery
+
+	// mu guards deliveries which aren't finished yet, Close waits for them
+	mu      sync.Mutex
+	pending int
+	retries map[*delivery]*time.Timer
+	closing bool
+	idle    chan struct{}
+	cancel  context.CancelFunc
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewDispatcher validates webhooks config, Run must be called to deliver notifications
+func NewDispatcher(cfg []config.Webhook, client requests.HTTPDoer, log *logrus.Logger) (*Dispatcher, error) {
+	d := &Dispatcher{
+		client:  client,
+		log:     log,
+		queue:   make(chan *delivery, queueSize),
+		retries: make(map[*delivery]*time.Timer),
+		idle:    make(chan struct{}),
+		stop:    make(chan struct{}),
+	}
+	names := make(map[string]bool, len(cfg))
+	for _, c := range cfg {
+		if c.Name == "" || c.URL == "" {
+			return nil, errors.New("webhook must have name and url")
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicated webhook %s", c.Name)
+		}
+		names[c.Name] = true
+		h := &hook{
+			name:        c.Name,
+			url:         c.URL,
+			secret:      c.Secret,
+			events:      make(map[Event]bool),
+			maxAttempts: c.MaxAttempts,
+			baseDelay:   c.BaseDelay,
+		}
+		for _, e := range c.Events {
+			switch Event(e) {
+			case MatchStart, MatchEnd, UploadFailed:
+				h.events[Event(e)] = true
+			default:
+				return nil, fmt.Errorf("unknown event %s of webhook %s", e, c.Name)
+			}
+		}
+		if h.maxAttempts <= 0 {
+			h.maxAttempts = DefaultMaxAttempts
+		}
+		if h.baseDelay <= 0 {
+			h.baseDelay = DefaultBaseDelay
+		}
+		d.hooks = append(d.hooks, h)
+	}
+	return d, nil
+}
+
+// Notify queues payload for every webhook subscribed to its event
+func (d *Dispatcher) Notify(p Payload) {
+	if p.Time.IsZero() {
+		p.Time = time.Now()
+	}
+	body, _ := json.Marshal(p) // err is always nil for Payload
+	for _, h := range d.hooks {
+		if len(h.events) != 0 && !h.events[p.Event] {
+			continue
+		}
+		d.enqueue(&delivery{hook: h, id: newID(), event: p.Event, body: body})
+	}
+}
+
+// Post queues JSON body to be posted to url, it is used by integrations with their own payload format
+// like Discord, deliveries are retried same way as webhooks, but they aren't signed
+func (d *Dispatcher) Post(name, url string, event Event, body []byte) {
+	h := &hook{name: name, url: url, maxAttempts: DefaultMaxAttempts, baseDelay: DefaultBaseDelay}
+	d.enqueue(&delivery{hook: h, id: newID(), event: event, body: body})
+}
+
+func (d *Dispatcher) enqueue(del *delivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pending++
+	d.pushLocked(del)
+}
+
+// pushLocked queues delivery for worker, delivery is finished if queue is full
+func (d *Dispatcher) pushLocked(del *delivery) {
+	select {
+	case d.queue <- del:
+	default:
+		metrics.WebhookDeliveries.Inc(del.hook.name, "dropped")
+		d.log.WithFields(logrus.Fields{"webhook": del.hook.name, "delivery": del.id}).
+			Errorf("Dropped %s notification, queue is full", del.event)
+		d.finishLocked()
+	}
+}
+
+// finishLocked marks delivery as finished, it is delivered, given up or dropped
+func (d *Dispatcher) finishLocked() {
+	d.pending--
+	if d.pending != 0 || !d.closing {
+		return
+	}
+	select {
+	case <-d.idle:
+		// notifications sent after Close aren't waited for
+	default:
+		close(d.idle)
+	}
+}
+
+// Run delivers queued notifications until ctx is done or Close has drained queue
+func (d *Dispatcher) Run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	d.mu.Lock()
+	d.cancel = cancel
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-d.stop:
+					return
+				case del := <-d.queue:
+					d.attempt(ctx, del)
+				}
+			}
+		}()
+	}
+	for i := 0; i < workers; i++ {
+		<-done
+	}
+}
+
+// Close delivers queued notifications and retries waiting for their delay right away, then stops Run.
+// Deliveries failing meanwhile are retried without delay, ones in flight are cancelled once ctx is done
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closing {
+		d.closing = true
+		// retries which have already fired push their deliveries themselves
+		for del, timer := range d.retries {
+			if timer.Stop() {
+				delete(d.retries, del)
+				d.pushLocked(del)
+			}
+		}
+		if d.pending == 0 {
+			close(d.idle)
+		}
+	}
+	cancel := d.cancel
+	d.mu.Unlock()
+
+	select {
+	case <-d.idle:
+		d.stopOnce.Do(func() { close(d.stop) })
+		return nil
+	case <-ctx.Done():
+		if cancel != nil {
+			cancel()
+		}
+		return ctx.Err()
+	}
+}
+
+// attempt makes single delivery attempt and schedules retry on failure
+func (d *Dispatcher) attempt(ctx context.Context, del *delivery) {
+	logger := d.log.WithFields(logrus.Fields{"webhook": del.hook.name, "delivery": del.id})
+	del.attempts++
+	err := d.deliver(ctx, del)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch {
+	case err == nil:
+		metrics.WebhookDeliveries.Inc(del.hook.name, "delivered")
+		logger.Debugf("Delivered %s notification", del.event)
+	case errors.Is(err, errPermanent) || del.attempts >= del.hook.maxAttempts:
+		metrics.WebhookDeliveries.Inc(del.hook.name, "failed")
+		logger.Errorf("Giving up on %s notification after %d attempts: %s", del.event, del.attempts, err)
+	case d.closing:
+		metrics.WebhookDeliveries.Inc(del.hook.name, "retried")
+		logger.Warnf("Failed to deliver %s notification, retrying before shutdown: %s", del.event, err)
+		d.pushLocked(del)
+		return
+	default:
+		metrics.WebhookDeliveries.Inc(del.hook.name, "retried")
+		delay := backoff.Delay(del.hook.baseDelay, maxDelay, del.attempts)
+		logger.Warnf("Failed to deliver %s notification, retrying in %s: %s", del.event, delay, err)
+		d.retries[del] = time.AfterFunc(delay, func() {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			if _, ok := d.retries[del]; ok {
+				delete(d.retries, del)
+				d.pushLocked(del)
+			}
+		})
+		// delivery is still pending until retry is made
+		return
+	}
+	d.finishLocked()
+}
+
+// deliver posts signed notification, server errors, 408 and 429 responses are retried
+func (d *Dispatcher) deliver(ctx context.Context, del *delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, del.hook.url, bytes.NewReader(del.body))
+	if err != nil {
+		return fmt.Errorf("%w: %s", errPermanent, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(del.event))
+	req.Header.Set(DeliveryHeader, del.id)
+	if del.hook.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(del.hook.secret, del.body))
+	}
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return nil
+	}
+	body, _ := ioutil.ReadAll(res.Body) // err is almost always nil
+	err = fmt.Errorf("webhook returned code: %d, body: %s", res.StatusCode, string(body))
+	if res.StatusCode < 500 && res.StatusCode != http.StatusRequestTimeout && res.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %s", errPermanent, err)
+	}
+	return err
+}
+
+// Sign returns value of signature header: hex encoded HMAC-SHA256 of body prefixed with "sha256="
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newID() string {
+	b := make([]byte, 8)
+	rand.Read(b) // err is always nil on supported platforms
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,186 @@
+package notify_test
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/notify"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestSign(t *testing.T) {
+	got := notify.Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+	if want := "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"; got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+func TestNewDispatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     []config.Webhook
+		wantErr bool
+	}{
+		{name: "ok", cfg: []config.Webhook{{Name: "a", URL: "http://a", Events: []string{"match_end", "upload_failed"}}}},
+		{name: "no url", cfg: []config.Webhook{{Name: "a"}}, wantErr: true},
+		{name: "duplicated name", cfg: []config.Webhook{{Name: "a", URL: "http://a"}, {Name: "a", URL: "http://b"}}, wantErr: true},
+		{name: "unknown event", cfg: []config.Webhook{{Name: "a", URL: "http://a", Events: []string{"kill"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := notify.NewDispatcher(tt.cfg, http.DefaultClient, logrus.New())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewDispatcher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	var retried, rejected int32
+	received := make(chan *http.Request, 10)
+	bodies := make(chan []byte, 10)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&retried, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	})
+	mux.HandleFunc("/rejecting", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&rejected, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	d, err := notify.NewDispatcher([]config.Webhook{
+		{Name: "flaky", URL: srv.URL + "/flaky", Secret: "secret", Events: []string{"match_end"}, BaseDelay: time.Millisecond},
+		{Name: "rejecting", URL: srv.URL + "/rejecting", BaseDelay: time.Millisecond},
+	}, srv.Client(), log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+
+	d.Notify(notify.Payload{Event: notify.MatchStart, Server: "test#1"})
+	d.Notify(notify.Payload{Event: notify.MatchEnd, Server: "test#1", PickupID: 5, LogsURL: "https://logs.tf/1"})
+
+	var r *http.Request
+	select {
+	case r = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification was not delivered")
+	}
+	body := <-bodies
+	if got := r.Header.Get(notify.SignatureHeader); got != notify.Sign("secret", body) {
+		t.Errorf("signature = %s, want %s", got, notify.Sign("secret", body))
+	}
+	var p notify.Payload
+	if err = json.Unmarshal(body, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Event != notify.MatchEnd || p.PickupID != 5 || p.LogsURL != "https://logs.tf/1" || r.Header.Get(notify.EventHeader) != "match_end" {
+		t.Errorf("delivered %+v, want match end only", p)
+	}
+	if got := atomic.LoadInt32(&retried); got != 2 {
+		t.Errorf("flaky webhook got %d requests, want 2", got)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&rejected); got != 2 {
+		t.Errorf("rejecting webhook got %d requests, want 1 per event without retries", got)
+	}
+	select {
+	case <-received:
+		t.Error("match start was delivered to webhook subscribed to match end only")
+	default:
+	}
+}
+
+func TestDispatcher_Close(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	var requests int32
+	first := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(first)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	// retry would be made only in an hour, Close makes it right away
+	d, err := notify.NewDispatcher([]config.Webhook{
+		{Name: "flaky", URL: srv.URL, BaseDelay: time.Hour},
+	}, srv.Client(), log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopped := make(chan struct{})
+	go func() {
+		d.Run(context.Background())
+		close(stopped)
+	}()
+	d.Notify(notify.Payload{Event: notify.MatchEnd, Server: "test#1"})
+	<-first
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err = d.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("webhook got %d requests, want 2", got)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Error("Run() hasn't returned after Close()")
+	}
+}
+
+func TestDispatcher_Close_Timeout(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	d, err := notify.NewDispatcher([]config.Webhook{{Name: "slow", URL: srv.URL}}, srv.Client(), log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go d.Run(context.Background())
+	d.Notify(notify.Payload{Event: notify.MatchEnd, Server: "test#1"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err = d.Close(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
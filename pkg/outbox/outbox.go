@@ -0,0 +1,357 @@
+package outbox
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/internal/backoff"
+	"LogWatcher/pkg/internal/fileutil"
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultMaxAttempts = 10
+	DefaultBaseDelay   = 30 * time.Second
+	DefaultMaxDelay    = time.Hour
+	// DefaultUploadTimeout limits single upload attempt if Timeouts.Upload isn't configured
+	DefaultUploadTimeout = 40 * time.Second
+	// minRateLimitDelay is used when logs.tf responds with 429 without Retry-After
+	minRateLimitDelay = time.Minute
+	pollInterval      = 10 * time.Second
+	metaExtension     = ".json"
+	logExtension      = ".log"
+)
+
+var (
+	ErrEntryNotFound = errors.New("upload is not queued")
+	// ErrEntryInFlight is returned for entry which is being uploaded right now
+	ErrEntryInFlight = errors.New("upload is in progress")
+)
+
+// Entry is metadata of queued upload, log itself is stored in separate file
+type Entry struct {
+	ID          string    `json:"id"`
+	Server      string    `json:"server"`
+	Domain      string    `json:"domain"`
+	PickupID    int       `json:"pickup_id"`
+	Map         string    `json:"map"`
+	Title       string    `json:"title"`
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"next_attempt"`
+	LastError   string    `json:"last_error,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Dead entries have exhausted their attempts and are retried only manually
+	Dead bool `json:"dead"`
+}
+
+// Enqueuer is implemented by queues accepting failed uploads
+type Enqueuer interface {
+	Enqueue(entry Entry, log bytes.Buffer) error
+}
+
+// Uploader is a part of requests.LogUploader needed to retry uploads
+type Uploader interface {
+	MakeMultipartMap(title, gameMap string, buf bytes.Buffer) map[string]io.Reader
+	UploadLogFile(ctx context.Context, payload map[string]io.Reader) (*requests.UploadResult, error)
+}
+
+// Reporter links uploaded logs to pickups on pickup site of server
+type Reporter interface {
+	ReportLogsURL(ctx context.Context, server string, pickupID int, logsURL string) error
+}
+
+// Queue is a directory based outbox of failed logs.tf uploads,
+// each entry is stored as <id>.json with metadata and <id>.log with log content
+type Queue struct {
+	// Reporter receives logs urls of retried uploads, they aren't reported if it is nil
+	Reporter Reporter
+	// Timeouts limit single upload attempt, zero Upload means DefaultUploadTimeout
+	Timeouts config.Timeouts
+	// mu guards files of queue, it isn't held during uploads, entries being uploaded are in inFlight instead
+	mu          sync.Mutex
+	inFlight    map[string]bool
+	dir         string
+	uploader    Uploader
+	log         *logrus.Logger
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	now         func() time.Time
+}
+
+// NewQueue creates queue in configured dir, creating it if needed
+func NewQueue(cfg config.Outbox, uploader Uploader, log *logrus.Logger) (*Queue, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	q := &Queue{
+		inFlight:    make(map[string]bool),
+		dir:         cfg.Dir,
+		uploader:    uploader,
+		log:         log,
+		maxAttempts: cfg.MaxAttempts,
+		baseDelay:   cfg.BaseDelay,
+		maxDelay:    cfg.MaxDelay,
+		now:         time.Now,
+	}
+	if q.maxAttempts <= 0 {
+		q.maxAttempts = DefaultMaxAttempts
+	}
+	if q.baseDelay <= 0 {
+		q.baseDelay = DefaultBaseDelay
+	}
+	if q.maxDelay <= 0 {
+		q.maxDelay = DefaultMaxDelay
+	}
+	return q, nil
+}
+
+// Enqueue stores failed upload, it will be retried by Run after base delay
+func (q *Queue) Enqueue(entry Entry, log bytes.Buffer) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	id, err := newID()
+	if err != nil {
+		return err
+	}
+	entry.ID = id
+	entry.CreatedAt = q.now()
+	entry.Attempts = 1
+	entry.NextAttempt = entry.CreatedAt.Add(backoff.Delay(q.baseDelay, q.maxDelay, entry.Attempts))
+	if err = fileutil.WriteFile(q.path(id, logExtension), log.Bytes()); err != nil {
+		return err
+	}
+	if err = q.save(entry); err != nil {
+		os.Remove(q.path(id, logExtension))
+		return err
+	}
+	q.log.WithFields(logrus.Fields{"server": entry.Server, "upload": id}).
+		Infof("Queued upload for retry at %s", entry.NextAttempt.Format(time.RFC3339))
+	return nil
+}
+
+// List returns all queued uploads ordered by creation time
+func (q *Queue) List() ([]Entry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.list()
+}
+
+// Retry uploads entry immediately regardless of its schedule, including dead ones
+func (q *Queue) Retry(ctx context.Context, id string) error {
+	return q.attempt(ctx, id)
+}
+
+// Drop removes entry from queue without uploading it
+func (q *Queue) Drop(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, err := q.load(id); err != nil {
+		return err
+	}
+	if q.inFlight[id] {
+		return ErrEntryInFlight
+	}
+	return q.remove(id)
+}
+
+// Run retries due uploads until ctx is done
+func (q *Queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		q.RetryDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RetryDue makes an attempt for every alive entry which next attempt time has come
+func (q *Queue) RetryDue(ctx context.Context) {
+	entries, err := q.List()
+	if err != nil {
+		q.log.Errorf("Failed to read outbox: %s", err)
+		return
+	}
+	now := q.now()
+	for _, entry := range entries {
+		if entry.Dead || entry.NextAttempt.After(now) {
+			continue
+		}
+		var statusErr *requests.StatusError
+		if err = q.attempt(ctx, entry.ID); errors.As(err, &statusErr) && statusErr.RateLimited() {
+			// no point in hammering logs.tf with the rest of queue
+			return
+		}
+	}
+}
+
+// attempt uploads entry, removes it on success and reschedules it on failure.
+// Queue isn't locked during upload, entry is claimed instead so that it isn't uploaded twice
+func (q *Queue) attempt(ctx context.Context, id string) error {
+	entry, content, err := q.claim(id)
+	if err != nil {
+		return err
+	}
+	logger := q.log.WithFields(logrus.Fields{"server": entry.Server, "upload": entry.ID})
+	payload := q.uploader.MakeMultipartMap(entry.Title, entry.Map, *bytes.NewBuffer(content))
+	timeout := q.Timeouts.Upload
+	if timeout <= 0 {
+		timeout = DefaultUploadTimeout
+	}
+	uploadCtx, cancel := context.WithTimeout(ctx, timeout)
+	result, uploadErr := q.uploader.UploadLogFile(uploadCtx, payload)
+	cancel()
+	if uploadErr == nil {
+		logger.WithField("logs_url", result.LogURL()).Info("Queued upload has been uploaded to logs.tf")
+		if entry.PickupID != 0 && q.Reporter != nil {
+			err = q.Reporter.ReportLogsURL(ctx, entry.Server, entry.PickupID, result.LogURL())
+			if err != nil && !errors.Is(err, requests.ErrNoPickupToken) {
+				logger.Errorf("Failed to report logs url to API: %s", err)
+			}
+		}
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		delete(q.inFlight, id)
+		return q.remove(entry.ID)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.inFlight, id)
+
+	if ctx.Err() != nil {
+		// cancelled upload isn't counted as attempt, entry is retried on next run,
+		// upload which exceeded its own timeout is counted as ctx of attempt isn't done
+		return uploadErr
+	}
+	var rejected *requests.UploadError
+	entry.Attempts++
+	entry.LastError = uploadErr.Error()
+	entry.NextAttempt = q.now().Add(q.retryDelay(entry.Attempts, uploadErr))
+	if entry.Attempts >= q.maxAttempts || errors.As(uploadErr, &rejected) {
+		entry.Dead = true
+		logger.Errorf("Giving up on upload after %d attempts: %s", entry.Attempts, uploadErr)
+	} else {
+		logger.Warnf("Failed to upload queued log, next attempt at %s: %s",
+			entry.NextAttempt.Format(time.RFC3339), uploadErr)
+	}
+	if err = q.save(entry); err != nil {
+		return err
+	}
+	return uploadErr
+}
+
+// claim loads entry with its log and marks it as in flight
+func (q *Queue) claim(id string) (Entry, []byte, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	entry, err := q.load(id)
+	if err != nil {
+		return entry, nil, err
+	}
+	if q.inFlight[id] {
+		return entry, nil, ErrEntryInFlight
+	}
+	content, err := os.ReadFile(q.path(id, logExtension))
+	if err != nil {
+		return entry, nil, err
+	}
+	q.inFlight[id] = true
+	return entry, content, nil
+}
+
+// retryDelay honors logs.tf rate limits and falls back to backoff
+func (q *Queue) retryDelay(attempts int, err error) time.Duration {
+	var statusErr *requests.StatusError
+	if errors.As(err, &statusErr) && statusErr.RateLimited() {
+		if statusErr.RetryAfter > minRateLimitDelay {
+			return statusErr.RetryAfter
+		}
+		return minRateLimitDelay
+	}
+	return backoff.Delay(q.baseDelay, q.maxDelay, attempts)
+}
+
+func (q *Queue) list() ([]Entry, error) {
+	files, err := filepath.Glob(filepath.Join(q.dir, "*"+metaExtension))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0, len(files))
+	for _, file := range files {
+		entry, err := q.load(strings.TrimSuffix(filepath.Base(file), metaExtension))
+		if err != nil {
+			q.log.Errorf("Failed to read outbox entry %s: %s", file, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
+
+func (q *Queue) load(id string) (Entry, error) {
+	var entry Entry
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return entry, ErrEntryNotFound
+	}
+	content, err := os.ReadFile(q.path(id, metaExtension))
+	if errors.Is(err, os.ErrNotExist) {
+		return entry, ErrEntryNotFound
+	}
+	if err != nil {
+		return entry, err
+	}
+	err = json.Unmarshal(content, &entry)
+	return entry, err
+}
+
+func (q *Queue) save(entry Entry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFile(q.path(entry.ID, metaExtension), content)
+}
+
+func (q *Queue) remove(id string) error {
+	if err := os.Remove(q.path(id, metaExtension)); err != nil {
+		return err
+	}
+	if err := os.Remove(q.path(id, logExtension)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (q *Queue) path(id, ext string) string {
+	return filepath.Join(q.dir, id+ext)
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,324 @@
+package outbox_test
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/outbox"
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestQueue(t *testing.T, uploader outbox.Uploader) *outbox.Queue {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	q, err := outbox.NewQueue(config.Outbox{
+		Dir:         t.TempDir(),
+		MaxAttempts: 3,
+		BaseDelay:   time.Nanosecond,
+		MaxDelay:    time.Nanosecond,
+	}, uploader, log)
+	if err != nil {
+		t.Fatalf("NewQueue() error = %v", err)
+	}
+	return q
+}
+
+func enqueue(t *testing.T, q *outbox.Queue) outbox.Entry {
+	err := q.Enqueue(outbox.Entry{
+		Server: "test#1",
+		Domain: "test",
+		Map:    "cp_process_f9a",
+		Title:  "tf2pickup.test #1",
+	}, *bytes.NewBufferString("L 10/17/2021 - 22:09:51: log line\n"))
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	entries, err := q.List()
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("List() = %v, %v, want 1 entry", entries, err)
+	}
+	return entries[0]
+}
+
+func TestQueue_RetryDue(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	payload := map[string]io.Reader{}
+	tests := []struct {
+		name         string
+		uploadErrors []error
+		wantEntries  int
+		wantAttempts int
+		wantDead     bool
+	}{
+		{
+			name:         "uploaded",
+			uploadErrors: []error{nil},
+			wantEntries:  0,
+		},
+		{
+			name:         "failed",
+			uploadErrors: []error{errors.New("test error")},
+			wantEntries:  1,
+			wantAttempts: 2,
+		},
+		{
+			name:         "rejected by logs.tf",
+			uploadErrors: []error{&requests.UploadError{Message: "Invalid log file"}},
+			wantEntries:  1,
+			wantAttempts: 2,
+			wantDead:     true,
+		},
+		{
+			name:         "attempts exhausted",
+			uploadErrors: []error{errors.New("test error"), errors.New("test error"), errors.New("not called")},
+			wantEntries:  1,
+			wantAttempts: 3,
+			wantDead:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			uploader := mocks.NewUploaderMock(mc).
+				MakeMultipartMapMock.
+				Expect("tf2pickup.test #1", "cp_process_f9a", *bytes.NewBufferString("L 10/17/2021 - 22:09:51: log line\n")).
+				Return(payload).
+				UploadLogFileMock.Set(func(context.Context, map[string]io.Reader) (*requests.UploadResult, error) {
+				calls++
+				if err := tt.uploadErrors[calls-1]; err != nil {
+					return nil, err
+				}
+				return &requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil
+			})
+			q := newTestQueue(t, uploader)
+			enqueue(t, q)
+
+			for i := 0; i < len(tt.uploadErrors); i++ {
+				time.Sleep(time.Millisecond)
+				q.RetryDue(context.Background())
+			}
+
+			entries, err := q.List()
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if len(entries) != tt.wantEntries {
+				t.Fatalf("List() len = %v, want %v", len(entries), tt.wantEntries)
+			}
+			if tt.wantEntries == 0 {
+				return
+			}
+			if entries[0].Attempts != tt.wantAttempts {
+				t.Errorf("Attempts = %v, want %v", entries[0].Attempts, tt.wantAttempts)
+			}
+			if entries[0].Dead != tt.wantDead {
+				t.Errorf("Dead = %v, want %v", entries[0].Dead, tt.wantDead)
+			}
+		})
+	}
+}
+
+func TestQueue_RetryDue_RateLimited(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Return(nil, &requests.StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Minute})
+	q := newTestQueue(t, uploader)
+	enqueue(t, q)
+	if err := q.Enqueue(outbox.Entry{Server: "test#2"}, bytes.Buffer{}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	time.Sleep(time.Millisecond)
+	q.RetryDue(context.Background())
+
+	if got := uploader.UploadLogFileAfterCounter(); got != 1 {
+		t.Errorf("UploadLogFile() called %v times, want 1", got)
+	}
+	entries, _ := q.List()
+	if next := time.Until(entries[0].NextAttempt); next < time.Minute {
+		t.Errorf("NextAttempt in %v, want Retry-After to be honored", next)
+	}
+}
+
+func TestQueue_RetryDue_Cancelled(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Set(func(ctx context.Context, _ map[string]io.Reader) (*requests.UploadResult, error) {
+			return nil, ctx.Err()
+		})
+	q := newTestQueue(t, uploader)
+	entry := enqueue(t, q)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	time.Sleep(time.Millisecond)
+	q.RetryDue(ctx)
+
+	entries, _ := q.List()
+	if len(entries) != 1 || entries[0].Attempts != entry.Attempts {
+		t.Errorf("List() = %+v, want cancelled upload not to be counted as attempt", entries)
+	}
+}
+
+func TestQueue_RetryDue_TimedOut(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Set(func(ctx context.Context, _ map[string]io.Reader) (*requests.UploadResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	q := newTestQueue(t, uploader)
+	q.Timeouts.Upload = time.Millisecond
+	entry := enqueue(t, q)
+
+	time.Sleep(time.Millisecond)
+	q.RetryDue(context.Background())
+
+	entries, _ := q.List()
+	if len(entries) != 1 || entries[0].Attempts != entry.Attempts+1 {
+		t.Errorf("List() = %+v, want timed out upload to be counted as attempt", entries)
+	}
+}
+
+func TestQueue_RetryDue_Reporter(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil)
+	q := newTestQueue(t, uploader)
+	q.Reporter = mocks.NewReporterMock(mc).ReportLogsURLMock.Inspect(
+		func(_ context.Context, server string, pickupID int, logsURL string) {
+			if server != "test#1" || pickupID != 5 || logsURL != "https://logs.tf/1" {
+				t.Errorf("ReportLogsURL() got %v, %v, %v", server, pickupID, logsURL)
+			}
+		}).Return(nil)
+	err := q.Enqueue(outbox.Entry{Server: "test#1", Domain: "test", PickupID: 5}, *bytes.NewBufferString("log line\n"))
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	time.Sleep(time.Millisecond)
+	q.RetryDue(context.Background())
+
+	if entries, _ := q.List(); len(entries) != 0 {
+		t.Errorf("List() = %v, want empty queue", entries)
+	}
+}
+
+func TestQueue_RetryAndDrop(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil)
+	q := newTestQueue(t, uploader)
+
+	if err := q.Retry(context.Background(), "unknown"); !errors.Is(err, outbox.ErrEntryNotFound) {
+		t.Errorf("Retry() error = %v, want %v", err, outbox.ErrEntryNotFound)
+	}
+	if err := q.Drop("../unknown"); !errors.Is(err, outbox.ErrEntryNotFound) {
+		t.Errorf("Drop() error = %v, want %v", err, outbox.ErrEntryNotFound)
+	}
+
+	entry := enqueue(t, q)
+	if err := q.Retry(context.Background(), entry.ID); err != nil {
+		t.Errorf("Retry() error = %v", err)
+	}
+
+	entry = enqueue(t, q)
+	if err := q.Drop(entry.ID); err != nil {
+		t.Errorf("Drop() error = %v", err)
+	}
+	if entries, _ := q.List(); len(entries) != 0 {
+		t.Errorf("List() = %v, want empty queue", entries)
+	}
+}
+
+func TestQueue_RetryInFlight(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	uploader := mocks.NewUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Set(func(context.Context, map[string]io.Reader) (*requests.UploadResult, error) {
+			close(started)
+			<-release
+			return &requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil
+		})
+	q := newTestQueue(t, uploader)
+	entry := enqueue(t, q)
+
+	done := make(chan error)
+	go func() {
+		done <- q.Retry(context.Background(), entry.ID)
+	}()
+	<-started
+
+	// queue isn't locked while upload is in progress
+	if entries, err := q.List(); err != nil || len(entries) != 1 {
+		t.Errorf("List() = %v, %v, want entry being uploaded", entries, err)
+	}
+	if err := q.Retry(context.Background(), entry.ID); !errors.Is(err, outbox.ErrEntryInFlight) {
+		t.Errorf("Retry() error = %v, want %v", err, outbox.ErrEntryInFlight)
+	}
+	if err := q.Drop(entry.ID); !errors.Is(err, outbox.ErrEntryInFlight) {
+		t.Errorf("Drop() error = %v, want %v", err, outbox.ErrEntryInFlight)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Retry() error = %v", err)
+	}
+	if entries, _ := q.List(); len(entries) != 0 {
+		t.Errorf("List() = %v, want empty queue", entries)
+	}
+}
+
+func TestNewQueue_KeepsEntries(t *testing.T) {
+	dir := t.TempDir()
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	q, _ := outbox.NewQueue(config.Outbox{Dir: dir}, nil, log)
+	if err := q.Enqueue(outbox.Entry{Server: "test#1"}, *bytes.NewBufferString("log")); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	if len(files) != 2 {
+		t.Errorf("queue dir has %v files, want metadata and log", len(files))
+	}
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "*.tmp")); len(tmp) != 0 {
+		t.Errorf("temporary files are left in queue dir: %v", tmp)
+	}
+
+	q, _ = outbox.NewQueue(config.Outbox{Dir: dir}, nil, log)
+	entries, err := q.List()
+	if err != nil || len(entries) != 1 || entries[0].Server != "test#1" || entries[0].Dead {
+		t.Errorf("List() = %v, %v, want entry to survive restart", entries, err)
+	}
+}
@@ -0,0 +1,120 @@
+package packet
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+)
+
+// MaxSize is the largest UDP datagram, buffers for reading packets should be this long
+const MaxSize = 65535
+
+// Type is a packet type byte going right after the header
+type Type byte
+
+const (
+	// Log is a packet from server without sv_logsecret
+	Log Type = 'R'
+	// SecretLog is a packet from server with sv_logsecret set, secret goes before log line
+	SecretLog Type = 'S'
+)
+
+// header is a prefix of every out-of-band Source engine packet
+var header = []byte{0xFF, 0xFF, 0xFF, 0xFF}
+
+var logLineRegexp = regexp.MustCompile(`^L \d{2}/\d{2}/\d{4} - \d{2}:\d{2}:\d{2}: `)
+
+// ErrorKind describes why packet can't be decoded
+type ErrorKind int
+
+const (
+	ShortPacket ErrorKind = iota
+	BadHeader
+	UnknownType
+	EmptySecret
+	BadLogLine
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ShortPacket:
+		return "short packet"
+	case BadHeader:
+		return "bad header"
+	case UnknownType:
+		return "unknown packet type"
+	case EmptySecret:
+		return "empty secret"
+	case BadLogLine:
+		return "bad log line"
+	default:
+		return "unknown error"
+	}
+}
+
+// DecodeError is returned for malformed packets
+type DecodeError struct {
+	Kind ErrorKind
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("malformed packet: %s", e.Kind)
+}
+
+// Packet is a single decoded log line from game server
+type Packet struct {
+	Type   Type
+	Secret string
+	Line   string
+}
+
+// Decode parses Source engine UDP log packet:
+// 0xFFFFFFFF header, packet type, optional secret and log line ending with newline and NUL
+func Decode(data []byte) (*Packet, error) {
+	if len(data) <= len(header) {
+		return nil, &DecodeError{Kind: ShortPacket}
+	}
+	if !bytes.Equal(data[:len(header)], header) {
+		return nil, &DecodeError{Kind: BadHeader}
+	}
+
+	p := &Packet{Type: Type(data[len(header)])}
+	body := bytes.TrimRight(data[len(header)+1:], "\x00\r\n")
+
+	switch p.Type {
+	case Log:
+	case SecretLog:
+		end := bytes.Index(body, []byte("L "))
+		if end < 0 {
+			return nil, &DecodeError{Kind: BadLogLine}
+		}
+		if end == 0 {
+			return nil, &DecodeError{Kind: EmptySecret}
+		}
+		p.Secret = string(body[:end])
+		body = body[end:]
+	default:
+		return nil, &DecodeError{Kind: UnknownType}
+	}
+
+	if !logLineRegexp.Match(body) {
+		return nil, &DecodeError{Kind: BadLogLine}
+	}
+	p.Line = string(body)
+	return p, nil
+}
+
+// Encode builds packet in the same format as game server does, used for testing and e2e client
+func Encode(secret, line string) []byte {
+	var b bytes.Buffer
+	b.Write(header)
+	if secret != "" {
+		b.WriteByte(byte(SecretLog))
+		b.WriteString(secret)
+	} else {
+		b.WriteByte(byte(Log))
+	}
+	b.WriteString(line)
+	b.WriteString("\n\x00")
+	return b.Bytes()
+}
@@ -0,0 +1,130 @@
+package packet_test
+
+import (
+	"LogWatcher/pkg/packet"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const logLine = `L 10/01/2021 - 21:38:46: World triggered "Round_Start"`
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		want     *packet.Packet
+		wantKind packet.ErrorKind
+		wantErr  bool
+	}{
+		{
+			name: "log packet",
+			data: []byte("\xFF\xFF\xFF\xFFR" + logLine + "\n\x00"),
+			want: &packet.Packet{Type: packet.Log, Line: logLine},
+		},
+		{
+			name: "secret log packet",
+			data: []byte("\xFF\xFF\xFF\xFFS1234" + logLine + "\n\x00"),
+			want: &packet.Packet{Type: packet.SecretLog, Secret: "1234", Line: logLine},
+		},
+		{
+			name: "no trailing bytes",
+			data: []byte("\xFF\xFF\xFF\xFFR" + logLine),
+			want: &packet.Packet{Type: packet.Log, Line: logLine},
+		},
+		{
+			name: "long line",
+			data: []byte("\xFF\xFF\xFF\xFFR" + logLine + strings.Repeat("a", 2000) + "\n\x00"),
+			want: &packet.Packet{Type: packet.Log, Line: logLine + strings.Repeat("a", 2000)},
+		},
+		{
+			name:     "short packet",
+			data:     []byte("\xFF\xFF"),
+			wantKind: packet.ShortPacket,
+			wantErr:  true,
+		},
+		{
+			name:     "no header",
+			data:     []byte(logLine),
+			wantKind: packet.BadHeader,
+			wantErr:  true,
+		},
+		{
+			name:     "unknown type",
+			data:     []byte("\xFF\xFF\xFF\xFFX" + logLine),
+			wantKind: packet.UnknownType,
+			wantErr:  true,
+		},
+		{
+			name:     "empty secret",
+			data:     []byte("\xFF\xFF\xFF\xFFS" + logLine),
+			wantKind: packet.EmptySecret,
+			wantErr:  true,
+		},
+		{
+			name:     "empty line",
+			data:     []byte("\xFF\xFF\xFF\xFFR\n\x00"),
+			wantKind: packet.BadLogLine,
+			wantErr:  true,
+		},
+		{
+			name:     "secret without line",
+			data:     []byte("\xFF\xFF\xFF\xFFS1234"),
+			wantKind: packet.BadLogLine,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := packet.Decode(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var decodeErr *packet.DecodeError
+				if !errors.As(err, &decodeErr) || decodeErr.Kind != tt.wantKind {
+					t.Errorf("Decode() error = %v, want kind %v", err, tt.wantKind)
+				}
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("Decode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, secret := range []string{"", "1234"} {
+		got, err := packet.Decode(packet.Encode(secret, logLine))
+		if err != nil {
+			t.Fatalf("Decode(Encode()) error = %v", err)
+		}
+		if got.Secret != secret || got.Line != logLine {
+			t.Errorf("Decode(Encode()) got = %v", got)
+		}
+	}
+}
+
+func TestErrorKind_String(t *testing.T) {
+	tests := []struct {
+		kind packet.ErrorKind
+		want string
+	}{
+		{kind: packet.ShortPacket, want: "short packet"},
+		{kind: packet.BadHeader, want: "bad header"},
+		{kind: packet.UnknownType, want: "unknown packet type"},
+		{kind: packet.EmptySecret, want: "empty secret"},
+		{kind: packet.BadLogLine, want: "bad log line"},
+		{kind: packet.ErrorKind(10), want: "unknown error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
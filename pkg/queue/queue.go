@@ -0,0 +1,261 @@
+package queue
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/metrics"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// PolicyBlock makes router wait for free space, it stalls all servers while queue is full
+	PolicyBlock = "block"
+	// PolicyDropOldest discards oldest queued line to make room for new one
+	PolicyDropOldest = "drop_oldest"
+	// PolicySpill appends lines which don't fit in memory to per-server file on disk
+	PolicySpill = "spill"
+)
+
+const (
+	DefaultSize       = 1024
+	DefaultMaxSpillMB = 64
+	spillExtension    = ".spill"
+	// lengthSize is a size of line length prefix in spill file
+	lengthSize = 4
+)
+
+// Queue is a bounded FIFO of log lines between router and worker of single server,
+// Push never blocks unless block policy is used, drop oldest policy is used by default
+type Queue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	name   string
+	policy string
+	buf    []string
+	head   int
+	count  int
+	closed bool
+
+	spillPath  string
+	spill      *os.File
+	maxSpill   int64
+	readOff    int64
+	writeOff   int64
+	spillCount int
+
+	dropped uint64
+}
+
+// New creates queue of server with given name, spill file is created in cfg.SpillDir if spill policy is used,
+// lines left in spill file by previous run are discarded
+func New(cfg config.Queue, name string) (*Queue, error) {
+	q := &Queue{name: name, policy: cfg.Policy}
+	q.cond = sync.NewCond(&q.mu)
+	size := cfg.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	q.buf = make([]string, size)
+
+	switch q.policy {
+	case "":
+		q.policy = PolicyDropOldest
+	case PolicyBlock, PolicyDropOldest:
+	case PolicySpill:
+		if cfg.SpillDir == "" {
+			return nil, fmt.Errorf("spill policy requires spill dir")
+		}
+		if err := os.MkdirAll(cfg.SpillDir, 0o755); err != nil {
+			return nil, err
+		}
+		q.spillPath = filepath.Join(cfg.SpillDir, SpillName(name))
+		file, err := os.OpenFile(q.spillPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		q.spill = file
+		q.maxSpill = cfg.MaxSpillMB << 20
+		if q.maxSpill <= 0 {
+			q.maxSpill = DefaultMaxSpillMB << 20
+		}
+	default:
+		return nil, fmt.Errorf("unknown queue policy: %s", q.policy)
+	}
+	return q, nil
+}
+
+// SpillName returns name of spill file of server, e.g. ru_1.spill for ru#1
+func SpillName(name string) string {
+	return strings.Replace(name, "#", "_", 1) + spillExtension
+}
+
+// Push adds line to queue, false is returned if line was dropped
+func (q *Queue) Push(line string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.policy == PolicyBlock {
+		for q.count == len(q.buf) && !q.closed {
+			q.cond.Wait()
+		}
+	}
+	if q.closed {
+		q.drop("closed")
+		return false
+	}
+
+	switch {
+	// once spilling has started lines go to disk until it is drained, so their order is kept
+	case q.spillCount > 0 || (q.count == len(q.buf) && q.policy == PolicySpill):
+		if !q.pushSpill(line) {
+			return false
+		}
+	case q.count == len(q.buf):
+		// drop oldest, block policy never gets here
+		q.buf[q.head] = ""
+		q.head = (q.head + 1) % len(q.buf)
+		q.count--
+		q.drop("overflow")
+		q.pushMemory(line)
+	default:
+		q.pushMemory(line)
+	}
+	metrics.QueueLength.Set(float64(q.len()), q.name)
+	q.cond.Broadcast()
+	return true
+}
+
+func (q *Queue) pushMemory(line string) {
+	q.buf[(q.head+q.count)%len(q.buf)] = line
+	q.count++
+}
+
+// pushSpill appends line to spill file as length prefixed record
+func (q *Queue) pushSpill(line string) bool {
+	if q.writeOff+lengthSize+int64(len(line)) > q.maxSpill {
+		q.drop("spill_full")
+		return false
+	}
+	record := make([]byte, lengthSize+len(line))
+	binary.BigEndian.PutUint32(record, uint32(len(line)))
+	copy(record[lengthSize:], line)
+	if _, err := q.spill.WriteAt(record, q.writeOff); err != nil {
+		q.drop("spill_error")
+		return false
+	}
+	q.writeOff += int64(len(record))
+	q.spillCount++
+	metrics.QueueSpilled.Inc(q.name)
+	return true
+}
+
+// Pop returns next line, it blocks until line is available.
+// False is returned once queue is closed and drained
+func (q *Queue) Pop() (string, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		for q.count == 0 && q.spillCount == 0 && !q.closed {
+			q.cond.Wait()
+		}
+
+		var line string
+		switch {
+		case q.count > 0:
+			line = q.buf[q.head]
+			q.buf[q.head] = ""
+			q.head = (q.head + 1) % len(q.buf)
+			q.count--
+		case q.spillCount > 0:
+			var ok bool
+			if line, ok = q.popSpill(); !ok {
+				continue
+			}
+		default:
+			q.removeSpill()
+			return "", false
+		}
+		metrics.QueueLength.Set(float64(q.len()), q.name)
+		q.cond.Broadcast()
+		return line, true
+	}
+}
+
+// popSpill reads oldest spilled line, spill is reset on read error as the rest of it can't be trusted
+func (q *Queue) popSpill() (string, bool) {
+	header := make([]byte, lengthSize)
+	_, err := q.spill.ReadAt(header, q.readOff)
+	var record []byte
+	if err == nil {
+		record = make([]byte, binary.BigEndian.Uint32(header))
+		_, err = q.spill.ReadAt(record, q.readOff+lengthSize)
+	}
+	if err != nil {
+		for ; q.spillCount > 0; q.spillCount-- {
+			q.drop("spill_error")
+		}
+		q.resetSpill()
+		return "", false
+	}
+	q.readOff += lengthSize + int64(len(record))
+	q.spillCount--
+	if q.spillCount == 0 {
+		q.resetSpill()
+	}
+	return string(record), true
+}
+
+func (q *Queue) resetSpill() {
+	q.readOff, q.writeOff = 0, 0
+	q.spill.Truncate(0) // file is only reused from the start, so failed truncate just wastes space
+}
+
+func (q *Queue) removeSpill() {
+	if q.spill == nil {
+		return
+	}
+	q.spill.Close()
+	os.Remove(q.spillPath)
+	q.spill = nil
+}
+
+// Close makes Pop return remaining lines and then false, lines pushed after Close are dropped
+func (q *Queue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// Len returns number of queued lines including spilled ones
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.len()
+}
+
+// Dropped returns number of lines dropped since queue was created
+func (q *Queue) Dropped() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dropped
+}
+
+// DeleteMetrics removes metrics series of queue
+func (q *Queue) DeleteMetrics() {
+	metrics.QueueLength.Delete(q.name)
+	metrics.QueueSpilled.Delete(q.name)
+	metrics.QueueDropped.DeletePartial("server", q.name)
+}
+
+func (q *Queue) len() int {
+	return q.count + q.spillCount
+}
+
+func (q *Queue) drop(reason string) {
+	q.dropped++
+	metrics.QueueDropped.Inc(q.name, reason)
+}
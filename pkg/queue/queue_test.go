@@ -0,0 +1,163 @@
+package queue_test
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/queue"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// drain closes queue and pops everything left in it
+func drain(q *queue.Queue) []string {
+	q.Close()
+	var lines []string
+	for {
+		line, ok := q.Pop()
+		if !ok {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func numbered(from, to int) []string {
+	var lines []string
+	for i := from; i < to; i++ {
+		lines = append(lines, strconv.Itoa(i))
+	}
+	return lines
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.Queue
+		wantErr bool
+	}{
+		{name: "default", cfg: config.Queue{}},
+		{name: "block", cfg: config.Queue{Size: 10, Policy: queue.PolicyBlock}},
+		{name: "drop oldest", cfg: config.Queue{Size: 10, Policy: queue.PolicyDropOldest}},
+		{name: "spill", cfg: config.Queue{Policy: queue.PolicySpill, SpillDir: t.TempDir()}},
+		{name: "spill without dir", cfg: config.Queue{Policy: queue.PolicySpill}, wantErr: true},
+		{name: "unknown policy", cfg: config.Queue{Policy: "ignore"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := queue.New(tt.cfg, "test#1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQueue_DropOldest(t *testing.T) {
+	// drop oldest is the default policy
+	for _, policy := range []string{queue.PolicyDropOldest, ""} {
+		t.Run(policy, func(t *testing.T) {
+			q, _ := queue.New(config.Queue{Size: 3, Policy: policy}, "test#1")
+			for _, line := range numbered(0, 5) {
+				if !q.Push(line) {
+					t.Errorf("Push(%s) = false, want new line kept", line)
+				}
+			}
+			if got := q.Dropped(); got != 2 {
+				t.Errorf("Dropped() = %d, want 2", got)
+			}
+			if diff := cmp.Diff(numbered(2, 5), drain(q)); diff != "" {
+				t.Errorf("queue mismatch (-want +got):\n%s", diff)
+			}
+			if q.Push("late") {
+				t.Error("Push() after Close = true")
+			}
+		})
+	}
+}
+
+func TestQueue_Spill(t *testing.T) {
+	dir := t.TempDir()
+	q, err := queue.New(config.Queue{Size: 2, Policy: queue.PolicySpill, SpillDir: dir}, "test#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spill := filepath.Join(dir, "test_1.spill")
+
+	for _, line := range numbered(0, 5) {
+		q.Push(line)
+	}
+	// line popped from memory makes room, but new lines still go after spilled ones
+	if line, _ := q.Pop(); line != "0" {
+		t.Errorf("Pop() = %s, want 0", line)
+	}
+	q.Push("line with\nnewline")
+	if got := q.Len(); got != 5 {
+		t.Errorf("Len() = %d, want 5", got)
+	}
+	if info, err := os.Stat(spill); err != nil || info.Size() == 0 {
+		t.Errorf("spill file is empty: %v", err)
+	}
+
+	want := append(numbered(1, 5), "line with\nnewline")
+	if diff := cmp.Diff(want, drain(q)); diff != "" {
+		t.Errorf("queue mismatch (-want +got):\n%s", diff)
+	}
+	if _, err = os.Stat(spill); !os.IsNotExist(err) {
+		t.Errorf("spill file was not removed: %v", err)
+	}
+}
+
+func TestQueue_SpillFull(t *testing.T) {
+	q, _ := queue.New(config.Queue{Size: 1, Policy: queue.PolicySpill, SpillDir: t.TempDir(), MaxSpillMB: 1}, "test#1")
+	big := strings.Repeat("a", 600<<10)
+	q.Push("first")
+	if !q.Push(big) {
+		t.Error("Push() of line fitting spill = false")
+	}
+	if q.Push(big) {
+		t.Error("Push() over spill limit = true")
+	}
+	if got := q.Dropped(); got != 1 {
+		t.Errorf("Dropped() = %d, want 1", got)
+	}
+	if got := drain(q); len(got) != 2 || got[1] != big {
+		t.Errorf("queue has %d lines, want first and spilled one", len(got))
+	}
+}
+
+func TestQueue_Block(t *testing.T) {
+	q, _ := queue.New(config.Queue{Size: 1, Policy: queue.PolicyBlock}, "test#1")
+	q.Push("0")
+	pushed := make(chan bool)
+	go func() {
+		pushed <- q.Push("1")
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("Push() to full queue hasn't blocked")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if line, _ := q.Pop(); line != "0" {
+		t.Errorf("Pop() = %s, want 0", line)
+	}
+	if !<-pushed {
+		t.Error("blocked Push() = false after Pop")
+	}
+
+	go func() {
+		pushed <- q.Push("2")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	if <-pushed {
+		t.Error("blocked Push() = true after Close")
+	}
+	if diff := cmp.Diff([]string{"1"}, drain(q)); diff != "" {
+		t.Errorf("queue mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -54,3 +54,20 @@ type GamesResponse struct {
 	Results   []Result `json:"results"`
 	ItemCount int      `json:"itemCount"`
 }
+
+// UploadResult represents response from logs.tf/upload
+type UploadResult struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	LogID   int    `json:"log_id"`
+	// URL is log's path relative to logs.tf, e.g. "/3080112"
+	URL string `json:"url"`
+}
+
+// LogURL returns absolute url of uploaded log
+func (r *UploadResult) LogURL() string {
+	if r.URL == "" {
+		return ""
+	}
+	return DefaultLogsTFURL + r.URL
+}
@@ -0,0 +1,250 @@
+package requests
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/metrics"
+	"LogWatcher/pkg/stats"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ProviderTF2Pickup is a provider of tf2pickup.* sites, it is used if client has no provider
+	ProviderTF2Pickup = "tf2pickup"
+	// ProviderNone is a provider of servers not tied to any pickup site
+	ProviderNone = "none"
+	// PickupAPITemplateUrl is a default base URL of tf2pickup API of domain
+	PickupAPITemplateUrl = "https://api.tf2pickup.%s"
+)
+
+const StartedState = "started"
+
+var (
+	// ErrNoPickupToken is returned by ReportLogsURL when API token of pickup site is not configured
+	ErrNoPickupToken   = errors.New("pickup API token is not configured")
+	ErrUnknownProvider = errors.New("unknown pickup provider")
+)
+
+type Pickup struct {
+	Players []*stats.PickupPlayer
+	ID      int
+}
+
+// PickupProvider finds pickup of started match on pickup site and links uploaded log to it
+type PickupProvider interface {
+	FindMatchingPickup(ctx context.Context, gameMap string) (*Pickup, error)
+	ResolvePlayers(ctx context.Context, players []*stats.PickupPlayer) error
+	ReportLogsURL(ctx context.Context, pickupID int, logsURL string) error
+	CheckAPI(ctx context.Context) error
+	// String returns name of pickup API, e.g. api.tf2pickup.ru
+	String() string
+	// Site returns name of pickup site used in match titles, e.g. tf2pickup.ru, it is empty without site
+	Site() string
+}
+
+// NewPickupProvider creates provider of client, tokens by domain are used if client has no own token
+func NewPickupProvider(client config.Client, tokens map[string]string, doer HTTPDoer, log *logrus.Logger) (PickupProvider, error) {
+	switch client.Pickup.Provider {
+	case "", ProviderTF2Pickup:
+		url := client.Pickup.URL
+		if url == "" {
+			url = fmt.Sprintf(PickupAPITemplateUrl, client.Domain)
+		}
+		token := client.Pickup.Token
+		if token == "" {
+			token = tokens[client.Domain]
+		}
+		return &TF2Pickup{BaseURL: strings.TrimSuffix(url, "/"), Token: token, Client: doer, Log: log}, nil
+	case ProviderNone:
+		return NoPickup{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, client.Pickup.Provider)
+	}
+}
+
+// TF2Pickup is a client of tf2pickup API at BaseURL, Token is needed to report logs urls
+type TF2Pickup struct {
+	BaseURL string
+	Token   string
+	Client  HTTPDoer
+	Log     *logrus.Logger
+}
+
+func (p *TF2Pickup) String() string {
+	return strings.TrimPrefix(strings.TrimPrefix(p.BaseURL, "https://"), "http://")
+}
+
+// Site returns host of API without api. prefix, e.g. tf2pickup.ru for https://api.tf2pickup.ru
+func (p *TF2Pickup) Site() string {
+	u, err := url.Parse(p.BaseURL)
+	if err != nil || u.Hostname() == "" {
+		return p.String()
+	}
+	return strings.TrimPrefix(u.Hostname(), "api.")
+}
+
+// ResolvePlayers populates PickupPlayer entries with correct SteamIDs and names
+func (p *TF2Pickup) ResolvePlayers(ctx context.Context, players []*stats.PickupPlayer) error {
+	var responses []PlayersResponse
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.BaseURL+"/Players", nil)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	resp, err := p.Client.Do(req)
+	metrics.ObserveRequest("pickup_players", start, resp, err)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s/Players returned bad status: %d", p, resp.StatusCode)
+	}
+	defer resp.Body.Close()
+
+	if err = json.NewDecoder(resp.Body).Decode(&responses); err != nil {
+		return err
+	}
+	for _, pickupPlayer := range players {
+		for _, pr := range responses {
+			if pickupPlayer.PlayerID == pr.Id {
+				pickupPlayer.SteamID = pr.SteamId
+				pickupPlayer.Name = pr.Name
+			}
+		}
+	}
+	return nil
+}
+
+// FindMatchingPickup is used for finding current game on tf2pickup API
+// and loading to LogFile list of its Players and pickup ID
+func (p *TF2Pickup) FindMatchingPickup(ctx context.Context, gameMap string) (*Pickup, error) {
+	var pickup = &Pickup{}
+	players := make([]*stats.PickupPlayer, 0)
+
+	gamesResponse, err := p.Games(ctx)
+	if err != nil {
+		return pickup, err
+	}
+	for _, game := range gamesResponse.Results {
+		p.Log.WithFields(logrus.Fields{
+			"state": game.State,
+			"map":   game.Map,
+			"id":    game.ID,
+		}).Infof("looking for pickup...")
+		if game.State == StartedState && game.Map == gameMap {
+			for _, player := range game.Slots {
+				p := &stats.PickupPlayer{
+					PlayerID: player.Player, Class: player.GameClass, Team: player.Team,
+				}
+				players = append(players, p)
+			}
+			pickup.Players = players
+			pickup.ID = game.Number
+			break
+		}
+	}
+	return pickup, nil
+}
+
+// ReportLogsURL sets logs.tf url of pickup game on tf2pickup API, API token is required
+func (p *TF2Pickup) ReportLogsURL(ctx context.Context, pickupID int, logsURL string) error {
+	if p.Token == "" {
+		return ErrNoPickupToken
+	}
+	body, _ := json.Marshal(map[string]string{"logsUrl": logsURL}) // err is always nil
+	url := fmt.Sprintf("%s/games/%d", p.BaseURL, pickupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.Token)
+	start := time.Now()
+	resp, err := p.Client.Do(req)
+	metrics.ObserveRequest("pickup_report_logs_url", start, resp, err)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s/games/%d returned bad status: %d", p, pickupID, resp.StatusCode)
+	}
+	return nil
+}
+
+// CheckAPI checks that tf2pickup API responds, any response except server error is accepted
+func (p *TF2Pickup) CheckAPI(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.BaseURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%s returned bad status: %d", p, resp.StatusCode)
+	}
+	return nil
+}
+
+// Games makes http request to pickup API and returns GamesResponse, containing list of games
+func (p *TF2Pickup) Games(ctx context.Context) (GamesResponse, error) {
+	var gr GamesResponse
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.BaseURL+"/games", nil)
+	if err != nil {
+		return gr, err
+	}
+	start := time.Now()
+	resp, err := p.Client.Do(req)
+	metrics.ObserveRequest("pickup_games", start, resp, err)
+	if err != nil {
+		return gr, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return gr, fmt.Errorf("%s/games returned bad status: %d", p, resp.StatusCode)
+	}
+	defer resp.Body.Close()
+
+	if err = json.NewDecoder(resp.Body).Decode(&gr); err != nil {
+		return gr, err
+	}
+	return gr, nil
+}
+
+// NoPickup is a provider of servers not tied to any pickup site, matches never have pickup
+type NoPickup struct{}
+
+func (NoPickup) FindMatchingPickup(context.Context, string) (*Pickup, error) {
+	return &Pickup{}, nil
+}
+
+func (NoPickup) ResolvePlayers(context.Context, []*stats.PickupPlayer) error {
+	return nil
+}
+
+func (NoPickup) ReportLogsURL(context.Context, int, string) error {
+	return nil
+}
+
+func (NoPickup) CheckAPI(context.Context) error {
+	return nil
+}
+
+func (NoPickup) String() string {
+	return ProviderNone
+}
+
+func (NoPickup) Site() string {
+	return ""
+}
@@ -0,0 +1,352 @@
+package requests_test
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/requests"
+	"LogWatcher/pkg/stats"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+)
+
+func TestNewPickupProvider(t *testing.T) {
+	tokens := map[string]string{"ru": "ru-token"}
+	tests := []struct {
+		name    string
+		pickup  config.Pickup
+		want    requests.PickupProvider
+		wantErr error
+	}{
+		{
+			name: "default",
+			want: &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.ru", Token: "ru-token"},
+		},
+		{
+			name:   "custom url and token",
+			pickup: config.Pickup{Provider: requests.ProviderTF2Pickup, URL: "https://api.pickup.example.com/", Token: "own"},
+			want:   &requests.TF2Pickup{BaseURL: "https://api.pickup.example.com", Token: "own"},
+		},
+		{
+			name:   "none",
+			pickup: config.Pickup{Provider: requests.ProviderNone},
+			want:   requests.NoPickup{},
+		},
+		{
+			name:    "unknown",
+			pickup:  config.Pickup{Provider: "faceit"},
+			wantErr: requests.ErrUnknownProvider,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := config.Client{Server: 1, Domain: "ru", Pickup: tt.pickup}
+			got, err := requests.NewPickupProvider(client, tokens, nil, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewPickupProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("NewPickupProvider() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_String(t *testing.T) {
+	p := &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.ru"}
+	if got := p.String(); got != "api.tf2pickup.ru" {
+		t.Errorf("String() = %s, want api.tf2pickup.ru", got)
+	}
+	if got := (requests.NoPickup{}).String(); got != requests.ProviderNone {
+		t.Errorf("String() = %s, want %s", got, requests.ProviderNone)
+	}
+}
+
+func TestTF2Pickup_Site(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider requests.PickupProvider
+		want     string
+	}{
+		{name: "default api", provider: &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.ru"}, want: "tf2pickup.ru"},
+		{name: "custom api", provider: &requests.TF2Pickup{BaseURL: "https://pickup.example.com:8443/api"}, want: "pickup.example.com"},
+		{name: "none", provider: requests.NoPickup{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.provider.Site(); got != tt.want {
+				t.Errorf("Site() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_ResolvePlayers(t *testing.T) {
+	mc := minimock.NewController(t)
+	tests := []struct {
+		name    string
+		client  requests.HTTPDoer
+		players []*stats.PickupPlayer
+		want    []*stats.PickupPlayer
+		wantErr bool
+	}{
+		{
+			name: "default",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(playersRawJSON))}, nil),
+			players: []*stats.PickupPlayer{
+				{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"},
+			},
+			want: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Name: "supra", Class: "soldier", SteamID: "76561198011558250"}},
+		},
+		{
+			name: "non 200 http response",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: 404, Body: nil}, nil),
+			players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			wantErr: true,
+		},
+		{
+			name:    "error on Client.Do",
+			client:  mocks.NewHTTPDoerMock(mc).DoMock.Return(nil, errors.New("test error")),
+			players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			wantErr: true,
+		},
+		{
+			name: "invalid json response",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"bad: `))}, nil),
+			players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.test", Client: tt.client}
+			if err := p.ResolvePlayers(context.Background(), tt.players); (err != nil) != tt.wantErr {
+				t.Errorf("ResolvePlayers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(tt.players, tt.want) {
+				t.Errorf("ResolvePlayers() got = %#v, want = %#v", tt.players, tt.want)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_Games(t *testing.T) {
+	mc := minimock.NewController(t)
+	ts, _ := time.Parse("2006-01-02T15:04:05.999Z", "2021-09-29T21:42:54.745Z")
+
+	tests := []struct {
+		name    string
+		client  requests.HTTPDoer
+		want    requests.GamesResponse
+		wantErr bool
+	}{
+		{
+			name: "default",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Set(func(r *http.Request) (*http.Response, error) {
+				if r.URL.String() != "https://api.pickup.example.com/games" {
+					return nil, fmt.Errorf("unexpected url %s", r.URL)
+				}
+				return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(gamesRawJSON))}, nil
+			}),
+			want: requests.GamesResponse{
+				Results: []requests.Result{
+					{
+						ConnectInfoVersion: 1,
+						State:              "started",
+						Number:             391,
+						Map:                "cp_granary_pro_rc8",
+						Slots: []requests.Slot{
+							{
+								GameClass: "soldier",
+								Team:      "red",
+								Player:    "6133487c4573f9001cdc0abb",
+							},
+						},
+						LaunchedAt: ts,
+						ID:         "6154dddef56b5b0013b269a3",
+					},
+				},
+				ItemCount: 0,
+			},
+		},
+		{
+			name: "err on client.Do",
+			client: mocks.NewHTTPDoerMock(mc).
+				DoMock.Return(nil, errors.New("test err")),
+			want:    requests.GamesResponse{},
+			wantErr: true,
+		},
+		{
+			name: "non-2xx status code",
+			client: mocks.NewHTTPDoerMock(mc).
+				DoMock.Return(&http.Response{StatusCode: 500}, nil),
+			want:    requests.GamesResponse{},
+			wantErr: true,
+		},
+		{
+			name: "invalid json response",
+			client: mocks.NewHTTPDoerMock(mc).
+				DoMock.Return(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"bad: "json"`))}, nil),
+			want:    requests.GamesResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &requests.TF2Pickup{BaseURL: "https://api.pickup.example.com", Client: tt.client}
+			got, err := p.Games(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Games() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Games() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_FindMatchingPickup(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name    string
+		client  requests.HTTPDoer
+		gameMap string
+		want    *requests.Pickup
+		wantErr bool
+	}{
+		{
+			name: "default",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(gamesRawJSON))}, nil),
+			gameMap: "cp_granary_pro_rc8",
+			want: &requests.Pickup{Players: []*stats.PickupPlayer{
+				{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier", Team: "red"},
+			},
+				ID: 391,
+			},
+		},
+		{
+			name: "error on Games",
+			client: mocks.NewHTTPDoerMock(mc).
+				DoMock.Return(&http.Response{StatusCode: 500}, nil),
+			gameMap: "cp_granary_pro_rc8",
+			want:    &requests.Pickup{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.test", Client: tt.client, Log: logrus.New()}
+			got, err := p.FindMatchingPickup(context.Background(), tt.gameMap)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindMatchingPickup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("FindMatchingPickup() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_ReportLogsURL(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	checkRequest := func(r *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPatch || r.URL.String() != "https://api.tf2pickup.test/games/123" ||
+			r.Header.Get("Authorization") != "Bearer token" || string(body) != `{"logsUrl":"https://logs.tf/1"}` {
+			return nil, errors.New("unexpected request")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	tests := []struct {
+		name    string
+		client  requests.HTTPDoer
+		token   string
+		wantErr error
+	}{
+		{
+			name:   "default",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Set(checkRequest),
+			token:  "token",
+		},
+		{
+			name:    "no token",
+			client:  mocks.NewHTTPDoerMock(mc),
+			wantErr: requests.ErrNoPickupToken,
+		},
+		{
+			name: "bad status",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(strings.NewReader(``))}, nil),
+			token:   "token",
+			wantErr: errors.New("api.tf2pickup.test/games/123 returned bad status: 401"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.test", Token: tt.token, Client: tt.client}
+			err := p.ReportLogsURL(context.Background(), 123, "https://logs.tf/1")
+			if fmt.Sprint(err) != fmt.Sprint(tt.wantErr) {
+				t.Errorf("ReportLogsURL() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTF2Pickup_CheckAPI(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tests := []struct {
+		name    string
+		client  requests.HTTPDoer
+		wantErr error
+	}{
+		{
+			name: "reachable",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(strings.NewReader(``))}, nil),
+		},
+		{
+			name: "server error",
+			client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
+				&http.Response{StatusCode: http.StatusBadGateway, Body: ioutil.NopCloser(strings.NewReader(``))}, nil),
+			wantErr: errors.New("api.tf2pickup.test returned bad status: 502"),
+		},
+		{
+			name:    "unreachable",
+			client:  mocks.NewHTTPDoerMock(mc).DoMock.Return(nil, errors.New("test error")),
+			wantErr: errors.New("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &requests.TF2Pickup{BaseURL: "https://api.tf2pickup.test", Client: tt.client}
+			err := p.CheckAPI(context.Background())
+			if fmt.Sprint(err) != fmt.Sprint(tt.wantErr) {
+				t.Errorf("CheckAPI() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,27 +1,29 @@
 package requests
 
 import (
+	"LogWatcher/pkg/metrics"
 	"LogWatcher/pkg/stats"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
-const (
-	logsTFURL            = "http://logs.tf/upload"
-	PickupAPITemplateUrl = "https://api.tf2pickup.%s"
-)
+// DefaultLogsTFURL is base url of logs.tf, logs are uploaded to its /upload endpoint
+const DefaultLogsTFURL = "https://logs.tf"
 
 const uploaderSignTemplate = "LogWatcher %s"
 
-const StartedState = "started"
+const incompleteTitleSuffix = " (incomplete)"
 
 // Version is build version, used in logs.tf uploader field
 var Version = "dev"
@@ -31,20 +33,41 @@ type Client struct {
 	Client HTTPDoer
 	ApiKey string
 	Log    *logrus.Logger
+	// URL is base url of logs.tf compatible service, DefaultLogsTFURL is used if it is empty
+	URL string
+}
+
+// StatusError is returned when logs.tf responds with non-200 status
+type StatusError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is value of Retry-After header, zero if it is absent
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("logs.tf returned code: %d, body: %s", e.StatusCode, e.Body)
 }
 
-type Pickup struct {
-	Players []*stats.PickupPlayer
-	ID      int
+// UploadError is returned when logs.tf responds with 200 status but reports failure,
+// e.g. log is invalid, so retrying same upload is pointless
+type UploadError struct {
+	Message string
 }
 
-// LogUploader provides methods for processing logs
-// and interacting with logs.tf and tf2pickup APIs
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("logs.tf rejected log: %s", e.Message)
+}
+
+// RateLimited reports whether request was rejected because of too many requests
+func (e *StatusError) RateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// LogUploader provides methods for processing logs and uploading them to logs.tf
 type LogUploader interface {
-	MakeMultipartMap(matcher stats.Matcher, buf bytes.Buffer) map[string]io.Reader
-	UploadLogFile(payload map[string]io.Reader) error
-	ResolvePlayers(domain string, players []*stats.PickupPlayer) error
-	FindMatchingPickup(domain, Map string) (*Pickup, error)
+	MakeMultipartMap(title, gameMap string, buf bytes.Buffer) map[string]io.Reader
+	UploadLogFile(ctx context.Context, payload map[string]io.Reader) (*UploadResult, error)
 }
 
 // HTTPDoer is interface for doing http requests
@@ -61,19 +84,40 @@ func NewClient(apiKey string, client HTTPDoer, log *logrus.Logger) *Client {
 	}
 }
 
-// MakeMultipartMap constructs logs.tf/upload multipart payload from provided values
-func (c *Client) MakeMultipartMap(matcher stats.Matcher, buf bytes.Buffer) map[string]io.Reader {
+// MakeMultipartMap constructs logs.tf/upload multipart payload from provided values, see MakeTitle for title
+func (c *Client) MakeMultipartMap(title, gameMap string, buf bytes.Buffer) map[string]io.Reader {
 	m := make(map[string]io.Reader)
-	m["title"] = strings.NewReader(fmt.Sprintf("tf2pickup.%s #%d", matcher.Domain(), matcher.PickupID()))
-	m["map"] = strings.NewReader(matcher.Map())
+	m["title"] = strings.NewReader(title)
+	m["map"] = strings.NewReader(gameMap)
 	m["key"] = strings.NewReader(c.ApiKey)
 	m["logfile"] = &buf
 	m["uploader"] = strings.NewReader(fmt.Sprintf(uploaderSignTemplate, Version))
 	return m
 }
 
+// MakeTitle returns title of match, e.g. tf2pickup.ru #1234, or name of server
+// if match has no pickup on site
+func MakeTitle(site string, matcher stats.Matcher) string {
+	title := fmt.Sprintf("Match on %s", matcher.String())
+	if site != "" && matcher.PickupID() != 0 {
+		title = fmt.Sprintf("%s #%d", site, matcher.PickupID())
+	}
+	if matcher.Incomplete() {
+		title += incompleteTitleSuffix
+	}
+	return title
+}
+
+// LogsTFURL returns base url of logs.tf compatible service without trailing slash, empty url means logs.tf
+func LogsTFURL(url string) string {
+	if url == "" {
+		return DefaultLogsTFURL
+	}
+	return strings.TrimSuffix(url, "/")
+}
+
 // UploadLogFile is used for uploading multipart payload to logs.tf/upload endpoint
-func (c *Client) UploadLogFile(payload map[string]io.Reader) error {
+func (c *Client) UploadLogFile(ctx context.Context, payload map[string]io.Reader) (*UploadResult, error) {
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
 	for key, reader := range payload {
@@ -87,96 +131,37 @@ func (c *Client) UploadLogFile(payload map[string]io.Reader) error {
 	}
 	w.Close()
 
-	req, _ := http.NewRequest(http.MethodPost, logsTFURL, &b) // err is always nil
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, LogsTFURL(c.URL)+"/upload", &b)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", w.FormDataContentType())
 
+	start := time.Now()
 	res, err := c.Client.Do(req)
+	metrics.ObserveRequest("logstf_upload", start, res, err)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
 		bodyBytes, _ := ioutil.ReadAll(res.Body) // err is almost always nil
-		return fmt.Errorf("logs.tf returned code: %d, body: %s", res.StatusCode, string(bodyBytes))
-	}
-	return nil
-}
-
-// ResolvePlayers populates PickupPlayer entries with correct SteamIDs and names
-func (c *Client) ResolvePlayers(domain string, players []*stats.PickupPlayer) error {
-	var responses []PlayersResponse
-	url := fmt.Sprintf(PickupAPITemplateUrl+"/Players", domain)
-	req, _ := http.NewRequest(http.MethodGet, url, nil) // err is always nil
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("api.tf2pickup.%s/Players returned bad status: %d", domain, resp.StatusCode)
-	}
-	defer resp.Body.Close()
-
-	if err = json.NewDecoder(resp.Body).Decode(&responses); err != nil {
-		return err
-	}
-	for _, pickupPlayer := range players {
-		for _, pr := range responses {
-			if pickupPlayer.PlayerID == pr.Id {
-				pickupPlayer.SteamID = pr.SteamId
-				pickupPlayer.Name = pr.Name
-			}
-		}
-	}
-	return nil
-}
-
-// FindMatchingPickup is used for finding current game on tf2pickup API
-// and loading to LogFile list of its Players and pickup ID
-func (c *Client) FindMatchingPickup(domain, gameMap string) (*Pickup, error) {
-	var pickup = &Pickup{}
-	players := make([]*stats.PickupPlayer, 0)
-
-	gamesResponse, err := GetPickupGames(domain, c.Client)
-	if err != nil {
-		return pickup, err
-	}
-	for _, game := range gamesResponse.Results {
-		c.Log.WithFields(logrus.Fields{
-			"state": game.State,
-			"map":   game.Map,
-			"id":    game.ID,
-		}).Infof("looking for pickup...")
-		if game.State == StartedState && game.Map == gameMap {
-			for _, player := range game.Slots {
-				p := &stats.PickupPlayer{
-					PlayerID: player.Player, Class: player.GameClass, Team: player.Team,
-				}
-				players = append(players, p)
-			}
-			pickup.Players = players
-			pickup.ID = game.Number
-			break
+		// zero if header is absent or is a date
+		retryAfter, _ := strconv.Atoi(res.Header.Get("Retry-After"))
+		return nil, &StatusError{
+			StatusCode: res.StatusCode,
+			Body:       string(bodyBytes),
+			RetryAfter: time.Duration(retryAfter) * time.Second,
 		}
 	}
-	return pickup, nil
-}
 
-// GetPickupGames makes http request to pickup API and returns GamesResponse, containing list of games
-func GetPickupGames(domain string, client HTTPDoer) (GamesResponse, error) {
-	var gr GamesResponse
-	url := fmt.Sprintf(PickupAPITemplateUrl+"/games", domain)
-	req, _ := http.NewRequest(http.MethodGet, url, nil) // err is always nil
-	resp, err := client.Do(req)
-	if err != nil {
-		return gr, err
+	var result UploadResult
+	if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode logs.tf response: %w", err)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return gr, fmt.Errorf("api.tf2pickup.%s/games returned bad status: %d", domain, resp.StatusCode)
-	}
-	defer resp.Body.Close()
-
-	if err = json.NewDecoder(resp.Body).Decode(&gr); err != nil {
-		return gr, err
+	if !result.Success {
+		return nil, &UploadError{Message: result.Error}
 	}
-	return gr, nil
+	return &result, nil
 }
@@ -5,6 +5,7 @@ import (
 	"LogWatcher/pkg/requests"
 	"LogWatcher/pkg/stats"
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -59,179 +60,150 @@ func TestNewRequestManager(t *testing.T) {
 }
 
 func TestRequester_MakeMultipartMap(t *testing.T) {
+	r := &requests.Client{ApiKey: "test"}
+	got := r.MakeMultipartMap("tf2pickup.test #123", "cp_granary_rc8", bytes.Buffer{})
+	want := map[string]io.Reader{
+		"title":    strings.NewReader("tf2pickup.test #123"),
+		"map":      strings.NewReader("cp_granary_rc8"),
+		"key":      strings.NewReader("test"),
+		"logfile":  &bytes.Buffer{},
+		"uploader": strings.NewReader("LogWatcher dev"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MakeMultipartMap() = %v, want %v", got, want)
+	}
+}
+
+func TestMakeTitle(t *testing.T) {
 	mc := minimock.NewController(t)
 
-	type fields struct {
-		client requests.HTTPDoer
-		apiKey string
-	}
-	type args struct {
-		match stats.Matcher
-		buf   bytes.Buffer
-	}
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   map[string]io.Reader
+		name  string
+		site  string
+		match stats.Matcher
+		want  string
 	}{
 		{
-			name:   "default",
-			fields: fields{apiKey: "test"},
-			args: args{
-				match: mocks.NewMatcherMock(mc).
-					MapMock.Return("cp_granary_rc8").
-					DomainMock.Return("test").
-					PickupIDMock.Return(123),
-				buf: bytes.Buffer{},
-			},
-			want: map[string]io.Reader{
-				"title":    strings.NewReader("tf2pickup.test #123"),
-				"map":      strings.NewReader("cp_granary_rc8"),
-				"key":      strings.NewReader("test"),
-				"logfile":  &bytes.Buffer{},
-				"uploader": strings.NewReader("LogWatcher dev"),
-			},
+			name: "default",
+			site: "tf2pickup.test",
+			match: mocks.NewMatcherMock(mc).
+				StringMock.Return("test#1").
+				PickupIDMock.Return(123).
+				IncompleteMock.Return(false),
+			want: "tf2pickup.test #123",
+		},
+		{
+			name: "incomplete match",
+			site: "tf2pickup.test",
+			match: mocks.NewMatcherMock(mc).
+				StringMock.Return("test#1").
+				PickupIDMock.Return(123).
+				IncompleteMock.Return(true),
+			want: "tf2pickup.test #123 (incomplete)",
+		},
+		{
+			name: "without pickup site",
+			match: mocks.NewMatcherMock(mc).
+				StringMock.Return("test#1").
+				IncompleteMock.Return(false),
+			want: "Match on test#1",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := &requests.Client{
-				Client: tt.fields.client,
-				ApiKey: tt.fields.apiKey,
-			}
-			if got := r.MakeMultipartMap(tt.args.match, tt.args.buf); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("MakeMultipartMap() = %v, want %v", got, tt.want)
+			if got := requests.MakeTitle(tt.site, tt.match); got != tt.want {
+				t.Errorf("MakeTitle() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestRequester_ResolvePlayers(t *testing.T) {
+func TestRequester_UploadLogFile(t *testing.T) {
 	mc := minimock.NewController(t)
 	type fields struct {
 		client requests.HTTPDoer
 		apiKey string
 	}
 	type args struct {
-		domain  string
-		players []*stats.PickupPlayer
+		payload map[string]io.Reader
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []*stats.PickupPlayer
-		wantErr bool
+		name           string
+		fields         fields
+		args           args
+		want           *requests.UploadResult
+		wantErr        bool
+		wantRetryAfter time.Duration
 	}{
 		{
 			name: "default",
 			fields: fields{
-				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
-					&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(playersRawJSON))}, nil),
+				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(&http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"success":true,"log_id":3080112,"url":"/3080112"}`)),
+				}, nil),
 				apiKey: "test",
 			},
 			args: args{
-				domain: "test",
-				players: []*stats.PickupPlayer{
-					{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"},
+				payload: map[string]io.Reader{
+					"logfile": strings.NewReader("file"),
+					"map":     strings.NewReader("map"),
 				},
 			},
-			want: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Name: "supra", Class: "soldier", SteamID: "76561198011558250"}},
+			want: &requests.UploadResult{Success: true, LogID: 3080112, URL: "/3080112"},
 		},
 		{
-			name: "non 200 http response",
+			name: "log rejected",
 			fields: fields{
-				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
-					&http.Response{StatusCode: 404, Body: nil}, nil),
+				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(&http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"success":false,"error":"Invalid log file"}`)),
+				}, nil),
 				apiKey: "test",
 			},
 			args: args{
-				domain:  "test",
-				players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+				payload: map[string]io.Reader{},
 			},
-			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
 			wantErr: true,
 		},
 		{
-			name: "error on Client.Do",
+			name: "bad json",
 			fields: fields{
-				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(nil, errors.New("test error")),
+				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(&http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"success":`)),
+				}, nil),
 				apiKey: "test",
 			},
 			args: args{
-				domain:  "test",
-				players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+				payload: map[string]io.Reader{},
 			},
-			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
 			wantErr: true,
 		},
 		{
-			name: "invalid json response",
+			name: "non 200 http status",
 			fields: fields{
 				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
-					&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"bad: `))}, nil),
+					&http.Response{
+						StatusCode: 404,
+						Body:       ioutil.NopCloser(strings.NewReader(`{"error": "yes"}`)),
+					}, nil,
+				),
 				apiKey: "test",
 			},
 			args: args{
-				domain:  "test",
-				players: []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
+				payload: map[string]io.Reader{},
 			},
-			want:    []*stats.PickupPlayer{{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier"}},
 			wantErr: true,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			r := &requests.Client{
-				Client: tt.fields.client,
-				ApiKey: tt.fields.apiKey,
-			}
-			if err := r.ResolvePlayers(tt.args.domain, tt.args.players); (err != nil) != tt.wantErr {
-				t.Errorf("ResolvePlayers() error = %v, wantErr %v", err, tt.wantErr)
-			}
-			if !cmp.Equal(tt.args.players, tt.want) {
-				t.Errorf("resolvePlayers() got = %#v, want = %#v", tt.args.players, tt.want)
-			}
-		})
-	}
-}
-
-func TestRequester_UploadLogFile(t *testing.T) {
-	mc := minimock.NewController(t)
-	type fields struct {
-		client requests.HTTPDoer
-		apiKey string
-	}
-	type args struct {
-		payload map[string]io.Reader
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-	}{
-		{
-			name: "default",
-			fields: fields{
-				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(&http.Response{StatusCode: 200}, nil),
-				apiKey: "test",
-			},
-			args: args{
-				payload: map[string]io.Reader{
-					"logfile": strings.NewReader("file"),
-					"map":     strings.NewReader("map"),
-				},
-			},
-		},
 		{
-			name: "non 200 http status",
+			name: "rate limited",
 			fields: fields{
 				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
 					&http.Response{
-						StatusCode: 404,
-						Body:       ioutil.NopCloser(strings.NewReader(`{"error": "yes"}`)),
+						StatusCode: 429,
+						Header:     http.Header{"Retry-After": []string{"120"}},
+						Body:       ioutil.NopCloser(strings.NewReader(`{"error": "rate limited"}`)),
 					}, nil,
 				),
 				apiKey: "test",
@@ -239,7 +211,8 @@ func TestRequester_UploadLogFile(t *testing.T) {
 			args: args{
 				payload: map[string]io.Reader{},
 			},
-			wantErr: true,
+			wantErr:        true,
+			wantRetryAfter: 2 * time.Minute,
 		},
 		{
 			name: "error on http.Do",
@@ -259,163 +232,17 @@ func TestRequester_UploadLogFile(t *testing.T) {
 				Client: tt.fields.client,
 				ApiKey: tt.fields.apiKey,
 			}
-			if err := r.UploadLogFile(tt.args.payload); (err != nil) != tt.wantErr {
-				t.Errorf("UploadLogFile() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
-	}
-}
-
-func Test_GetPickupGames(t *testing.T) {
-	mc := minimock.NewController(t)
-	ts, _ := time.Parse("2006-01-02T15:04:05.999Z", "2021-09-29T21:42:54.745Z")
-
-	type args struct {
-		domain string
-		client requests.HTTPDoer
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    requests.GamesResponse
-		wantErr bool
-	}{
-		{
-			name: "default",
-			args: args{
-				domain: "test",
-				client: mocks.NewHTTPDoerMock(mc).DoMock.Return(
-					&http.Response{
-						StatusCode: 200,
-						Body:       ioutil.NopCloser(strings.NewReader(gamesRawJSON)),
-					}, nil),
-			},
-			want: requests.GamesResponse{
-				Results: []requests.Result{
-					{
-						ConnectInfoVersion: 1,
-						State:              "started",
-						Number:             391,
-						Map:                "cp_granary_pro_rc8",
-						Slots: []requests.Slot{
-							{
-								GameClass: "soldier",
-								Team:      "red",
-								Player:    "6133487c4573f9001cdc0abb",
-							},
-						},
-						LaunchedAt: ts,
-						ID:         "6154dddef56b5b0013b269a3",
-					},
-				},
-				ItemCount: 0,
-			},
-		},
-		{
-			name: "err on client.Do",
-			args: args{
-				domain: "test",
-				client: mocks.NewHTTPDoerMock(mc).
-					DoMock.Return(nil, errors.New("test err")),
-			},
-			want:    requests.GamesResponse{},
-			wantErr: true,
-		},
-		{
-			name: "non-2xx status code",
-			args: args{
-				domain: "test",
-				client: mocks.NewHTTPDoerMock(mc).
-					DoMock.Return(&http.Response{StatusCode: 500}, nil),
-			},
-			want:    requests.GamesResponse{},
-			wantErr: true,
-		},
-		{
-			name: "invalid json response",
-			args: args{
-				domain: "test",
-				client: mocks.NewHTTPDoerMock(mc).
-					DoMock.Return(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(`{"bad: "json"`))}, nil),
-			},
-			want:    requests.GamesResponse{},
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := requests.GetPickupGames(tt.args.domain, tt.args.client)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GetPickupGames() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetPickupGames() got = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestClient_FindMatchingPickup(t *testing.T) {
-	mc := minimock.NewController(t)
-
-	httoDoerMock := mocks.NewHTTPDoerMock(mc)
-	httoDoerMock.DoMock.Return(
-		&http.Response{
-			StatusCode: 200,
-			Body:       ioutil.NopCloser(strings.NewReader(gamesRawJSON)),
-		}, nil)
-	type fields struct {
-		Client requests.HTTPDoer
-		ApiKey string
-	}
-	type args struct {
-		domain  string
-		gameMap string
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    *requests.Pickup
-		wantErr bool
-	}{
-		{
-			name: "default",
-			fields: fields{
-				Client: httoDoerMock,
-			},
-			args: args{domain: "test", gameMap: "cp_granary_pro_rc8"},
-			want: &requests.Pickup{Players: []*stats.PickupPlayer{
-				{PlayerID: "6133487c4573f9001cdc0abb", Class: "soldier", Team: "red"},
-			},
-				ID: 391,
-			},
-		},
-		{
-			name: "error on GetPickupGames",
-			fields: fields{Client: mocks.NewHTTPDoerMock(mc).
-				DoMock.Return(&http.Response{StatusCode: 500}, nil),
-			},
-			args:    args{domain: "test", gameMap: "cp_granary_pro_rc8"},
-			want:    &requests.Pickup{},
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			c := &requests.Client{
-				Client: tt.fields.Client,
-				ApiKey: tt.fields.ApiKey,
-				Log:    logrus.New(),
-			}
-			got, err := c.FindMatchingPickup(tt.args.domain, tt.args.gameMap)
+			got, err := r.UploadLogFile(context.Background(), tt.args.payload)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("FindMatchingPickup() error = %v, wantErr %v", err, tt.wantErr)
-				return
+				t.Errorf("UploadLogFile() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if !cmp.Equal(got, tt.want) {
-				t.Errorf("FindMatchingPickup() got = %#v, want %#v", got, tt.want)
+				t.Errorf("UploadLogFile() got = %v, want %v", got, tt.want)
+			}
+			var statusErr *requests.StatusError
+			if tt.wantRetryAfter != 0 && (!errors.As(err, &statusErr) || !statusErr.RateLimited() ||
+				statusErr.RetryAfter != tt.wantRetryAfter) {
+				t.Errorf("UploadLogFile() error = %#v, want rate limit with retry after %v", err, tt.wantRetryAfter)
 			}
 		})
 	}
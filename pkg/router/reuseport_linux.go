@@ -0,0 +1,23 @@
+package router
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// reusePortSupported tells if several sockets can share address with load balancing between them
+const reusePortSupported = true
+
+// reusePort sets SO_REUSEPORT on socket, kernel spreads packets among sockets of the same address by
+// hash of source address, so packets of single server are always read by the same socket
+func reusePort(network, address string, c syscall.RawConn) error {
+	var sockErr error
+	err := c.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+	})
+	if err != nil {
+		return err
+	}
+	return sockErr
+}
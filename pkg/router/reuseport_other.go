@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package router
+
+import "syscall"
+
+// reusePortSupported tells if several sockets can share address with load balancing between them
+const reusePortSupported = false
+
+// reusePort is never called, single socket is used per address
+func reusePort(network, address string, c syscall.RawConn) error {
+	return nil
+}
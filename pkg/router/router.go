@@ -1,16 +1,30 @@
 package router
 
 import (
+	"LogWatcher/pkg/archive"
 	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/events"
+	"LogWatcher/pkg/finalizer"
+	"LogWatcher/pkg/metrics"
 	"LogWatcher/pkg/mongo"
+	"LogWatcher/pkg/notify"
+	"LogWatcher/pkg/outbox"
+	"LogWatcher/pkg/packet"
+	"LogWatcher/pkg/queue"
 	"LogWatcher/pkg/requests"
 	"LogWatcher/pkg/server"
+	"LogWatcher/pkg/sink"
 	sm "LogWatcher/pkg/stateMachine"
 	"LogWatcher/pkg/stats"
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
-	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -18,79 +32,736 @@ import (
 
 const timeout = 40 * time.Second
 
-var logLineRegexp = regexp.MustCompile(`L \d{2}/\d{2}/\d{4} - \d{2}:\d{2}:\d{2}: .+`)
+const defaultResumeWindow = 10 * time.Minute
 
-type AddressTable map[string]*sm.StateMachine
+// cancelGrace is time given to workers to save cancelled uploads and inserts once shutdown timeout is over
+const cancelGrace = 5 * time.Second
+
+var (
+	ErrClientExists   = errors.New("client already exists")
+	ErrClientNotFound = errors.New("client not found")
+	ErrAddressInUse   = errors.New("address is used by another client")
+	ErrSecretInUse    = errors.New("secret is used by another client")
+	ErrUnknownSink    = errors.New("unknown sink")
+	ErrNotListening   = errors.New("UDP socket is not bound")
+)
+
+// unbound is stored as local addresses after sockets are closed, atomic.Value can't hold nil
+var unbound = []net.Addr{}
+
+// Route binds game server's state machine to its client config
+type Route struct {
+	StateMachine *sm.StateMachine
+	Client       config.Client
+	// Pickups is pickup site of client, it is shared with state machine
+	Pickups requests.PickupProvider
+}
+
+// AddressTable is used for routing packets by their source address
+type AddressTable map[string]*Route
+
+// SecretTable is used for routing packets by their sv_logsecret value
+type SecretTable map[string]*Route
 
 type Router struct {
-	address      *net.UDPAddr
+	addresses    []string
+	readers      int
+	readBuffer   int
+	localAddrs   atomic.Value
+	mu           sync.RWMutex
+	routes       map[string]*Route
 	addressTable AddressTable
+	secretTable  SecretTable
 	log          *logrus.Logger
+	journal      config.Journal
+	queue        config.Queue
+	timeouts     config.Timeouts
+	// ctx is inherited by state machines, it is cancelled if shutdown takes too long
+	ctx             context.Context
+	cancel          context.CancelFunc
+	inserter        mongo.Inserter
+	db              *mongo.Mongo
+	uploader        requests.LogUploader
+	httpClient      requests.HTTPDoer
+	pickupTokens    map[string]string
+	outbox          *outbox.Queue
+	spool           *mongo.Spool
+	archive         *archive.Archive
+	events          *events.Bus
+	notifier        *notify.Dispatcher
+	finalizer       *finalizer.Pool
+	sinks           map[string]sink.UploadSink
+	unauthenticated uint64
+	malformed       uint64
+}
+
+// listenAddresses returns UDP.Hosts if they are set and Host otherwise, listening on both would bind
+// the same port twice, e.g. "[::]:27100" accepts IPv4 packets of ":27100" too
+func listenAddresses(cfg config.Server) []string {
+	if len(cfg.UDP.Hosts) > 0 {
+		return cfg.UDP.Hosts
+	}
+	return []string{cfg.Host}
 }
 
 func NewRouter(ctx context.Context, cfg *config.Config, log *logrus.Logger) (*Router, error) {
-	udpAddr, err := net.ResolveUDPAddr("udp4", cfg.Server.Host)
-	if err != nil {
-		return nil, err
+	addresses := listenAddresses(cfg.Server)
+	for _, address := range addresses {
+		if _, err := net.ResolveUDPAddr("udp", address); err != nil {
+			return nil, err
+		}
+	}
+	readers := cfg.Server.UDP.Readers
+	if readers <= 0 {
+		readers = 1
+	}
+	if readers > 1 && !reusePortSupported {
+		log.Warn("SO_REUSEPORT is not supported, every address is read by single socket")
+		readers = 1
 	}
 
-	mongoClient, err := mongo.NewMongo(ctx, cfg.Server.DSN, cfg.Server.MongoDatabase, cfg.Server.MongoCollection)
+	mongoClient, err := mongo.NewMongo(ctx, cfg.Server.DSN, cfg.Server.MongoDatabase, cfg.Server.MongoCollection, cfg.Server.MongoMatchCollection)
 	if err != nil {
 		return nil, err
 	}
 
-	client := &http.Client{Timeout: timeout}
-	r := requests.NewClient(cfg.Server.APIKey, client, log)
+	if err = mongoClient.EnsureIndexes(ctx); err != nil {
+		log.Errorf("Failed to create unique stats index, duplicated stats won't be rejected: %s", err)
+	}
 
-	addressTable := MakeAddressTable(cfg.Clients, log, mongoClient, r)
-	return &Router{
-		address:      udpAddr,
-		addressTable: addressTable,
+	client := &http.Client{Timeout: timeout}
+	uploader := requests.NewClient(cfg.Server.APIKey, client, log)
+	r := &Router{
+		addresses:    addresses,
+		readers:      readers,
+		readBuffer:   cfg.Server.UDP.ReadBufferKB << 10,
+		routes:       make(map[string]*Route),
+		addressTable: make(AddressTable),
+		secretTable:  make(SecretTable),
 		log:          log,
-	}, nil
+		journal:      cfg.Server.Journal,
+		queue:        cfg.Server.Queue,
+		timeouts:     cfg.Server.Timeouts,
+		inserter:     mongoClient,
+		db:           mongoClient,
+		uploader:     uploader,
+		httpClient:   client,
+		pickupTokens: cfg.Server.PickupTokens,
+		events:       events.NewBus(),
+		sinks:        make(map[string]sink.UploadSink, len(cfg.Sinks)),
+	}
+	r.sinks[sink.BuiltinLogsTF] = sink.NewLogsTF(sink.BuiltinLogsTF, "", uploader)
+	for _, c := range cfg.Sinks {
+		if c.Name == sink.BuiltinLogsTF {
+			return nil, fmt.Errorf("sink name %s is reserved", sink.BuiltinLogsTF)
+		}
+		if _, ok := r.sinks[c.Name]; ok {
+			return nil, fmt.Errorf("duplicated sink %s", c.Name)
+		}
+		if c.Type == sink.TypeLogsTF && c.APIKey == "" {
+			c.APIKey = cfg.Server.APIKey
+		}
+		if r.sinks[c.Name], err = sink.New(c, client); err != nil {
+			return nil, fmt.Errorf("failed to create sink %s: %w", c.Name, err)
+		}
+	}
+	// dispatcher is created even without webhooks, Discord webhooks of clients are delivered through it
+	if r.notifier, err = notify.NewDispatcher(cfg.Webhooks, client, log); err != nil {
+		return nil, fmt.Errorf("failed to create webhooks: %w", err)
+	}
+	r.finalizer = finalizer.NewPool(cfg.Server.Finalizers)
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	if cfg.Server.MongoSpool.Dir != "" {
+		r.spool, err = mongo.NewSpool(mongoClient, cfg.Server.MongoSpool.Dir, cfg.Server.MongoSpool.ReplayInterval, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mongo spool: %w", err)
+		}
+		r.inserter = r.spool
+	}
+	if cfg.Server.Outbox.Dir != "" {
+		if r.outbox, err = outbox.NewQueue(cfg.Server.Outbox, uploader, log); err != nil {
+			return nil, fmt.Errorf("failed to create outbox: %w", err)
+		}
+		r.outbox.Reporter = r
+		r.outbox.Timeouts = cfg.Server.Timeouts
+	}
+	if cfg.Server.Archive.Dir != "" {
+		if r.archive, err = archive.New(cfg.Server.Archive, log); err != nil {
+			return nil, fmt.Errorf("failed to create archive: %w", err)
+		}
+	}
+	for _, c := range cfg.Clients {
+		if err = r.AddClient(c); err != nil {
+			return nil, fmt.Errorf("failed to add client %s: %w", c.Name(), err)
+		}
+	}
+	return r, nil
+}
+
+// Outbox returns queue of failed uploads, it is nil if outbox is disabled
+func (r *Router) Outbox() *outbox.Queue {
+	return r.outbox
+}
+
+// Spool returns spool of failed stats inserts, it is nil if spooling is disabled
+func (r *Router) Spool() *mongo.Spool {
+	return r.spool
+}
+
+// Archive returns local archive of match logs, it is nil if archiving is disabled
+func (r *Router) Archive() *archive.Archive {
+	return r.archive
+}
+
+// Notifier returns dispatcher of webhook notifications
+func (r *Router) Notifier() *notify.Dispatcher {
+	return r.notifier
+}
+
+// Events returns bus of live match events of all servers
+func (r *Router) Events() *events.Bus {
+	return r.events
+}
+
+// CheckListener fails if UDP socket is not bound
+func (r *Router) CheckListener(context.Context) error {
+	if r.LocalAddr() == nil {
+		return ErrNotListening
+	}
+	return nil
+}
+
+// CheckMongo pings MongoDB
+func (r *Router) CheckMongo(ctx context.Context) error {
+	return r.db.Ping(ctx)
+}
+
+// CheckWorkers fails if worker of any running client has exited
+func (r *Router) CheckWorkers(context.Context) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var stopped []string
+	for name, route := range r.routes {
+		if !route.StateMachine.Running() {
+			stopped = append(stopped, name)
+		}
+	}
+	if len(stopped) != 0 {
+		sort.Strings(stopped)
+		return fmt.Errorf("workers are not running: %s", strings.Join(stopped, ", "))
+	}
+	return nil
+}
+
+// CheckPickupAPI checks pickup API of every running client, API shared by several clients is checked once
+func (r *Router) CheckPickupAPI(ctx context.Context) error {
+	r.mu.RLock()
+	providers := make(map[string]requests.PickupProvider)
+	for _, route := range r.routes {
+		providers[route.Pickups.String()] = route.Pickups
+	}
+	r.mu.RUnlock()
+
+	var failed []string
+	for name, p := range providers {
+		if err := p.CheckAPI(ctx); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", name, err))
+		}
+	}
+	if len(failed) != 0 {
+		sort.Strings(failed)
+		return errors.New(strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// ReportLogsURL links log uploaded from outbox to pickup on pickup site of server
+func (r *Router) ReportLogsURL(ctx context.Context, server string, pickupID int, logsURL string) error {
+	r.mu.RLock()
+	route, ok := r.routes[server]
+	r.mu.RUnlock()
+	if !ok {
+		return ErrClientNotFound
+	}
+	return route.Pickups.ReportLogsURL(ctx, pickupID, logsURL)
+}
+
+// Listen reads log packets from all configured addresses until ctx is done.
+// Every address is read by r.readers sockets concurrently, lines of single server keep their order
+// as kernel delivers all its packets to the same socket
+func (r *Router) Listen(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var conns []*net.UDPConn
+	localAddrs := make([]net.Addr, 0, len(r.addresses))
+	for _, address := range r.addresses {
+		for i := 0; i < r.readers; i++ {
+			conn, err := r.listenUDP(ctx, address)
+			if err != nil {
+				for _, c := range conns {
+					c.Close()
+				}
+				return fmt.Errorf("failed to listen UDP port: %w", err)
+			}
+			if i == 0 {
+				// port may be chosen by OS, rest of sockets join the bound one
+				address = conn.LocalAddr().String()
+				localAddrs = append(localAddrs, conn.LocalAddr())
+				r.log.Infof("LogWatcher is listening on %s", address)
+			}
+			conns = append(conns, conn)
+		}
+	}
+	r.localAddrs.Store(localAddrs)
+	go func() {
+		<-ctx.Done()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+
+	errs := make(chan error, len(conns))
+	for _, conn := range conns {
+		go func(conn *net.UDPConn) {
+			errs <- r.read(ctx, conn)
+		}(conn)
+	}
+	var err error
+	for range conns {
+		// failure of any socket stops the rest of them
+		if readErr := <-errs; readErr != nil && err == nil {
+			err = readErr
+			cancel()
+		}
+	}
+	r.localAddrs.Store(unbound)
+	if err != nil {
+		return err
+	}
+	r.log.Info("Stopped listening UDP socket")
+	return nil
 }
 
-func (r *Router) Listen() {
-	conn, err := net.ListenUDP("udp", r.address)
+// listenUDP binds socket to address, SO_REUSEPORT is set if address is shared by several readers
+func (r *Router) listenUDP(ctx context.Context, address string) (*net.UDPConn, error) {
+	var lc net.ListenConfig
+	if r.readers > 1 {
+		lc.Control = reusePort
+	}
+	conn, err := lc.ListenPacket(ctx, "udp", address)
 	if err != nil {
-		r.log.Fatalf("failed to listen UDP port: %s", err)
+		return nil, err
 	}
-	r.log.Infof("LogWatcher is listening on %s", r.address.String())
+	udpConn := conn.(*net.UDPConn)
+	if r.readBuffer > 0 {
+		if err = udpConn.SetReadBuffer(r.readBuffer); err != nil {
+			udpConn.Close()
+			return nil, err
+		}
+	}
+	return udpConn, nil
+}
+
+// read dispatches packets of single socket until it is closed, nil is returned if ctx is done
+func (r *Router) read(ctx context.Context, conn *net.UDPConn) error {
+	message := make([]byte, packet.MaxSize)
 	for {
-		message := make([]byte, 1024)
 		msgLen, clientAddr, err := conn.ReadFromUDP(message)
 		if err != nil {
-			r.log.Errorf("Failed to read from UDP socket: %s", err)
-			return
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read from UDP socket: %w", err)
 		}
 
-		cleanMsg := logLineRegexp.FindString(string(message[:msgLen]))
-
-		stateMachine, ok := r.addressTable[clientAddr.String()]
-		if !ok {
+		p, err := packet.Decode(message[:msgLen])
+		if err != nil {
+			atomic.AddUint64(&r.malformed, 1)
+			var decodeErr *packet.DecodeError
+			if errors.As(err, &decodeErr) {
+				metrics.MalformedPackets.Inc(decodeErr.Kind.String())
+			}
 			r.log.WithFields(logrus.Fields{
 				"address": clientAddr.String(),
-				"server":  "unknown",
-			}).Debugf(cleanMsg)
+			}).Debugf("Dropped packet: %s", err)
 			continue
 		}
+		r.dispatch(clientAddr.String(), p, msgLen)
+	}
+}
+
+// Shutdown stops all workers, matches in progress are kept in journal to be resumed after restart
+// or uploaded marked as incomplete if journaling is disabled.
+// It waits for workers and finalization of finished matches until ctx is done, then requests in flight are cancelled.
+// Should be called after Listen has returned
+func (r *Router) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	routes := r.routes
+	r.routes = make(map[string]*Route)
+	r.addressTable = make(AddressTable)
+	r.secretTable = make(SecretTable)
+	r.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for name, route := range routes {
+		wg.Add(1)
+		go func(name string, route *Route) {
+			defer wg.Done()
+			if r.journal.Dir != "" {
+				route.StateMachine.Suspend()
+			} else {
+				route.StateMachine.Stop(false)
+			}
+			r.log.Infof("Stopped worker for %s", name)
+		}(name, route)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		// stopped workers may have queued matches, they are finalized before shutdown completes
+		if r.finalizer != nil {
+			r.finalizer.Close()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+	// requests in flight are cancelled, failed uploads and inserts still get to outbox and spool if they are enabled
+	if r.cancel != nil {
+		r.cancel()
+	}
+	select {
+	case <-done:
+	case <-time.After(cancelGrace):
+	}
+	return ctx.Err()
+}
+
+// LocalAddr returns address of the first bound UDP socket, nil is returned if router is not listening
+func (r *Router) LocalAddr() net.Addr {
+	if addrs := r.LocalAddrs(); len(addrs) > 0 {
+		return addrs[0]
+	}
+	return nil
+}
+
+// LocalAddrs returns bound addresses in order of config, nil is returned if router is not listening
+func (r *Router) LocalAddrs() []net.Addr {
+	addrs, _ := r.localAddrs.Load().([]net.Addr)
+	if len(addrs) == 0 {
+		return nil
+	}
+	return addrs
+}
+
+// dispatch queues log line to the state machine of packet's client,
+// read lock is held only while route is looked up
+func (r *Router) dispatch(address string, p *packet.Packet, size int) {
+	r.mu.RLock()
+	route, ok := r.route(address, p.Secret)
+	r.mu.RUnlock()
+	if !ok {
 		r.log.WithFields(logrus.Fields{
-			"server": stateMachine.File.Name(),
-			"state":  stateMachine.State,
-		}).Debugf(cleanMsg)
-		stateMachine.Channel <- cleanMsg
+			"address": address,
+			"server":  "unknown",
+		}).Debugf(p.Line)
+		return
+	}
+	r.log.WithFields(logrus.Fields{
+		"server": route.StateMachine.File.Name(),
+		"state":  route.StateMachine.State,
+	}).Debugf(p.Line)
+	metrics.Packets.Inc(route.Client.Name())
+	metrics.PacketBytes.Add(float64(size), route.Client.Name())
+	// push may block with block policy, queue of client removed meanwhile is closed and drops the line
+	if !route.StateMachine.Queue.Push(p.Line) {
+		r.log.WithFields(logrus.Fields{"server": route.Client.Name()}).Debug("Dropped line, queue is full")
+	}
+}
+
+// route finds destination for packet by its secret or source address.
+// Packets with unknown secret and packets without secret
+// from clients that have one configured are dropped and counted as unauthenticated
+func (r *Router) route(address, secret string) (*Route, bool) {
+	if secret != "" {
+		route, ok := r.secretTable[secret]
+		if !ok {
+			r.dropUnauthenticated(address, "unknown secret")
+		}
+		return route, ok
+	}
+	route, ok := r.addressTable[address]
+	if !ok {
+		metrics.UnknownSourcePackets.Inc()
+		return nil, false
+	}
+	if route.Client.Secret != "" {
+		r.dropUnauthenticated(address, "missing secret")
+		return nil, false
+	}
+	return route, true
+}
+
+// Unauthenticated returns number of packets dropped due to failed secret check
+func (r *Router) Unauthenticated() uint64 {
+	return atomic.LoadUint64(&r.unauthenticated)
+}
+
+// Malformed returns number of packets dropped because they couldn't be decoded
+func (r *Router) Malformed() uint64 {
+	return atomic.LoadUint64(&r.malformed)
+}
+
+func (r *Router) dropUnauthenticated(address, reason string) {
+	atomic.AddUint64(&r.unauthenticated, 1)
+	metrics.UnauthenticatedPackets.Inc(reason)
+	r.log.WithFields(logrus.Fields{
+		"address": address,
+		"reason":  reason,
+	}).Debug("Dropped unauthenticated packet")
+}
+
+// Clients returns configs of all running clients sorted by name
+func (r *Router) Clients() []config.Client {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clients := make([]config.Client, 0, len(r.routes))
+	for _, route := range r.routes {
+		clients = append(clients, route.Client)
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Name() < clients[j].Name()
+	})
+	return clients
+}
+
+// States returns snapshots of all running servers sorted by name
+func (r *Router) States() []sm.Snapshot {
+	r.mu.RLock()
+	machines := make([]*sm.StateMachine, 0, len(r.routes))
+	for _, route := range r.routes {
+		machines = append(machines, route.StateMachine)
+	}
+	r.mu.RUnlock()
+
+	// snapshots are taken without router lock, they may wait for line being processed
+	states := make([]sm.Snapshot, 0, len(machines))
+	for _, m := range machines {
+		states = append(states, m.Snapshot())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Server < states[j].Server
+	})
+	return states
+}
+
+// AddClient starts worker for new client and adds it to routing tables
+func (r *Router) AddClient(client config.Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.routes[client.Name()]; ok {
+		return ErrClientExists
+	}
+	if err := r.checkConflicts(client); err != nil {
+		return err
+	}
+	sinks, err := r.resolveSinks(client)
+	if err != nil {
+		return err
+	}
+	pickups, err := requests.NewPickupProvider(client, r.pickupTokens, r.httpClient, r.log)
+	if err != nil {
+		return err
+	}
+
+	var file server.LogFiler = server.NewLogFile(client)
+	if r.journal.Dir != "" {
+		journal, err := server.NewJournalFile(client, r.journal, r.log)
+		if err != nil {
+			return fmt.Errorf("failed to open journal: %w", err)
+		}
+		file = journal
+	}
+	lines, err := queue.New(r.queue, client.Name())
+	if err != nil {
+		if journal, ok := file.(server.Journal); ok {
+			journal.Close()
+		}
+		return fmt.Errorf("failed to create queue: %w", err)
+	}
+	match := stats.NewMatch(client)
+	stateMachine := sm.NewStateMachine(r.log, file, r.uploader, match, r.inserter)
+	stateMachine.Queue = lines
+	if r.outbox != nil {
+		stateMachine.Outbox = r.outbox
+	}
+	if r.archive != nil {
+		stateMachine.Archive = r.archive
+	}
+	if r.events != nil {
+		stateMachine.Events = r.events
+	}
+	if r.notifier != nil {
+		stateMachine.Notifier = r.notifier
+	}
+	if r.finalizer != nil {
+		stateMachine.Finalizer = r.finalizer
+	}
+	if r.ctx != nil {
+		stateMachine.SetContext(r.ctx)
+	}
+	stateMachine.Timeouts = r.timeouts
+	stateMachine.SetSinks(sinks)
+	stateMachine.SetDiscordWebhook(client.Discord)
+	stateMachine.SetPickupProvider(pickups)
+	go func() {
+		if err := stateMachine.Recover(r.resumeWindow()); err != nil {
+			r.log.Errorf("Failed to recover %s from journal: %s", client.Name(), err)
+		}
+		stateMachine.StartWorker()
+	}()
+
+	r.addRoute(&Route{StateMachine: stateMachine, Client: client, Pickups: pickups})
+	r.log.Infof("Started worker for %s with host %s", client.Name(), client.Address)
+	return nil
+}
+
+// UpdateClient changes address, secret, sinks, Discord webhook and pickup site of running client,
+// worker keeps running so match in progress is not affected
+func (r *Router) UpdateClient(client config.Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route, ok := r.routes[client.Name()]
+	if !ok {
+		return ErrClientNotFound
+	}
+	if err := r.checkConflicts(client); err != nil {
+		return err
+	}
+	sinks, err := r.resolveSinks(client)
+	if err != nil {
+		return err
+	}
+	pickups, err := requests.NewPickupProvider(client, r.pickupTokens, r.httpClient, r.log)
+	if err != nil {
+		return err
+	}
+
+	route.StateMachine.SetSinks(sinks)
+	route.StateMachine.SetDiscordWebhook(client.Discord)
+	route.StateMachine.SetPickupProvider(pickups)
+	r.removeRoute(route)
+	r.addRoute(&Route{StateMachine: route.StateMachine, Client: client, Pickups: pickups})
+	r.log.Infof("Updated client %s with host %s", client.Name(), client.Address)
+	return nil
+}
+
+// RemoveClient stops client's worker, match in progress is uploaded unless discard is set
+func (r *Router) RemoveClient(name string, discard bool) error {
+	r.mu.Lock()
+	route, ok := r.routes[name]
+	if !ok {
+		r.mu.Unlock()
+		return ErrClientNotFound
+	}
+	r.removeRoute(route)
+	r.mu.Unlock()
+
+	route.StateMachine.Stop(discard)
+	route.StateMachine.Queue.DeleteMetrics()
+	metrics.Packets.Delete(name)
+	metrics.PacketBytes.Delete(name)
+	r.log.WithField("discard", discard).Infof("Stopped worker for %s", name)
+	return nil
+}
+
+// ApplyClients applies changes between old and new client lists of config:
+// new clients are started, missing ones are stopped with their matches uploaded
+// and changed ones get new address and secret without restarting the worker.
+// Clients which are in neither list, e.g. added with admin API, are left alone
+func (r *Router) ApplyClients(old, new []config.Client) {
+	added, removed, changed := config.DiffClients(old, new)
+	for _, c := range removed {
+		if err := r.RemoveClient(c.Name(), false); err != nil {
+			r.log.Errorf("Failed to remove client %s: %s", c.Name(), err)
+		}
+	}
+	for _, c := range changed {
+		if err := r.UpdateClient(c); err != nil {
+			r.log.Errorf("Failed to update client %s: %s", c.Name(), err)
+		}
+	}
+	for _, c := range added {
+		err := r.AddClient(c)
+		if errors.Is(err, ErrClientExists) {
+			// client was added with admin API before it got to config
+			err = r.UpdateClient(c)
+		}
+		if err != nil {
+			r.log.Errorf("Failed to add client %s: %s", c.Name(), err)
+		}
+	}
+}
+
+func (r *Router) resumeWindow() time.Duration {
+	if r.journal.ResumeWindow <= 0 {
+		return defaultResumeWindow
+	}
+	return r.journal.ResumeWindow
+}
+
+// checkConflicts validates that client's address and secret are not used by other clients
+func (r *Router) checkConflicts(client config.Client) error {
+	name := client.Name()
+	if route, ok := r.addressTable[client.Address]; ok && route.Client.Name() != name {
+		return ErrAddressInUse
+	}
+	if client.Secret == "" {
+		return nil
+	}
+	if route, ok := r.secretTable[client.Secret]; ok && route.Client.Name() != name {
+		return ErrSecretInUse
+	}
+	return nil
+}
+
+// resolveSinks finds client's sinks by names, built-in logs.tf upload is used if client has no sinks
+func (r *Router) resolveSinks(client config.Client) ([]sink.UploadSink, error) {
+	names := client.Sinks
+	if len(names) == 0 {
+		names = []string{sink.BuiltinLogsTF}
+	}
+	sinks := make([]sink.UploadSink, 0, len(names))
+	for _, name := range names {
+		s, ok := r.sinks[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownSink, name)
+		}
+		sinks = append(sinks, s)
+	}
+	return sinks, nil
+}
+
+func (r *Router) addRoute(route *Route) {
+	r.routes[route.Client.Name()] = route
+	r.addressTable[route.Client.Address] = route
+	if route.Client.Secret != "" {
+		r.secretTable[route.Client.Secret] = route
 	}
 }
 
-func MakeAddressTable(hosts []config.Client, log *logrus.Logger, inserter mongo.Inserter, uploader requests.LogUploader) AddressTable {
-	addressTable := make(AddressTable)
-	for _, host := range hosts {
-		file := server.NewLogFile(host)
-		match := stats.NewMatch(host)
-		stateMachine := sm.NewStateMachine(log, file, uploader, match, inserter)
-		go stateMachine.StartWorker()
-		addressTable[host.Address] = stateMachine
-		log.Infof("Started worker for %s#%d with host %s", host.Domain, host.Server, host.Address)
+func (r *Router) removeRoute(route *Route) {
+	delete(r.routes, route.Client.Name())
+	delete(r.addressTable, route.Client.Address)
+	if route.Client.Secret != "" {
+		delete(r.secretTable, route.Client.Secret)
 	}
-	return addressTable
 }
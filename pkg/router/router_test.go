@@ -0,0 +1,549 @@
+package router
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/metrics"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/packet"
+	"LogWatcher/pkg/queue"
+	"LogWatcher/pkg/requests"
+	"LogWatcher/pkg/server"
+	"LogWatcher/pkg/sink"
+	sm "LogWatcher/pkg/stateMachine"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRouter_Route(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	open := &Route{Client: config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150"}}
+	secured := &Route{Client: config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Secret: "1234"}}
+
+	type args struct {
+		address string
+		secret  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Route
+		wantOk  bool
+		dropped uint64
+		unknown float64
+	}{
+		{
+			name:   "known address",
+			args:   args{address: "127.0.0.1:27150"},
+			want:   open,
+			wantOk: true,
+		},
+		{
+			name:    "unknown address",
+			args:    args{address: "127.0.0.1:27152"},
+			unknown: 1,
+		},
+		{
+			name:   "valid secret",
+			args:   args{address: "127.0.0.1:27152", secret: "1234"},
+			want:   secured,
+			wantOk: true,
+		},
+		{
+			name:    "invalid secret",
+			args:    args{address: "127.0.0.1:27151", secret: "4321"},
+			dropped: 1,
+		},
+		{
+			name:    "missing secret",
+			args:    args{address: "127.0.0.1:27151"},
+			dropped: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{
+				addressTable: AddressTable{"127.0.0.1:27150": open, "127.0.0.1:27151": secured},
+				secretTable:  SecretTable{"1234": secured},
+				log:          log,
+			}
+			unknownBefore := testutil.ToFloat64(metrics.UnknownSourcePackets.WithLabelValues())
+			got, ok := r.route(tt.args.address, tt.args.secret)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("route() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+			if dropped := r.Unauthenticated(); dropped != tt.dropped {
+				t.Errorf("Unauthenticated() = %v, want %v", dropped, tt.dropped)
+			}
+			if unknown := testutil.ToFloat64(metrics.UnknownSourcePackets.WithLabelValues()) - unknownBefore; unknown != tt.unknown {
+				t.Errorf("route() counted %v packets from unknown source, want %v", unknown, tt.unknown)
+			}
+		})
+	}
+}
+
+func newTestRouter() *Router {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+	return &Router{
+		routes:       make(map[string]*Route),
+		addressTable: make(AddressTable),
+		secretTable:  make(SecretTable),
+		log:          log,
+		sinks:        map[string]sink.UploadSink{sink.BuiltinLogsTF: sink.NewLogsTF(sink.BuiltinLogsTF, "", nil)},
+	}
+}
+
+func TestRouter_AddClient(t *testing.T) {
+	existing := config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234"}
+	tests := []struct {
+		name    string
+		client  config.Client
+		wantErr error
+	}{
+		{
+			name:   "new client",
+			client: config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151"},
+		},
+		{
+			name:    "same name",
+			client:  config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27151"},
+			wantErr: ErrClientExists,
+		},
+		{
+			name:    "same address",
+			client:  config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27150"},
+			wantErr: ErrAddressInUse,
+		},
+		{
+			name:    "same secret",
+			client:  config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Secret: "1234"},
+			wantErr: ErrSecretInUse,
+		},
+		{
+			name:    "unknown sink",
+			client:  config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Sinks: []string{"s3"}},
+			wantErr: ErrUnknownSink,
+		},
+		{
+			name:    "unknown pickup provider",
+			client:  config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151", Pickup: config.Pickup{Provider: "faceit"}},
+			wantErr: requests.ErrUnknownProvider,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRouter()
+			if err := r.AddClient(existing); err != nil {
+				t.Fatalf("AddClient() error = %v", err)
+			}
+			if err := r.AddClient(tt.client); !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if _, ok := r.route(tt.client.Address, tt.client.Secret); !ok {
+				t.Errorf("AddClient() client is not routable")
+			}
+			want := []config.Client{existing, tt.client}
+			if got := r.Clients(); !cmp.Equal(got, want) {
+				t.Errorf("Clients() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRouter_ResolveSinks(t *testing.T) {
+	logsTF := sink.NewLogsTF(sink.BuiltinLogsTF, "", nil)
+	archive := sink.NewWebhook("archive", "http://localhost/logs", nil)
+	r := newTestRouter()
+	r.sinks = map[string]sink.UploadSink{sink.BuiltinLogsTF: logsTF, "archive": archive}
+	tests := []struct {
+		name    string
+		sinks   []string
+		want    []sink.UploadSink
+		wantErr error
+	}{
+		{name: "default", want: []sink.UploadSink{logsTF}},
+		{name: "logs.tf and archive", sinks: []string{"logstf", "archive"}, want: []sink.UploadSink{logsTF, archive}},
+		{name: "archive only", sinks: []string{"archive"}, want: []sink.UploadSink{archive}},
+		{name: "unknown", sinks: []string{"archive", "s3"}, wantErr: ErrUnknownSink},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.resolveSinks(config.Client{Sinks: tt.sinks})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveSinks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("resolveSinks() got = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("resolveSinks() got = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRouter_UpdateClient(t *testing.T) {
+	r := newTestRouter()
+	client := config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150", Secret: "1234"}
+	if err := r.AddClient(client); err != nil {
+		t.Fatalf("AddClient() error = %v", err)
+	}
+	stateMachine := r.routes[client.Name()].StateMachine
+
+	updated := config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27151", Secret: "4321"}
+	if err := r.UpdateClient(updated); err != nil {
+		t.Fatalf("UpdateClient() error = %v", err)
+	}
+	if _, ok := r.addressTable[client.Address]; ok {
+		t.Errorf("UpdateClient() old address is still routable")
+	}
+	if _, ok := r.secretTable[client.Secret]; ok {
+		t.Errorf("UpdateClient() old secret is still routable")
+	}
+	route, ok := r.route(updated.Address, updated.Secret)
+	if !ok || route.StateMachine != stateMachine {
+		t.Errorf("UpdateClient() route = %v, want same state machine", route)
+	}
+
+	unknown := config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27152"}
+	if err := r.UpdateClient(unknown); !errors.Is(err, ErrClientNotFound) {
+		t.Errorf("UpdateClient() error = %v, wantErr %v", err, ErrClientNotFound)
+	}
+}
+
+func TestRouter_RemoveClient(t *testing.T) {
+	r := newTestRouter()
+	client := config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150"}
+	if err := r.AddClient(client); err != nil {
+		t.Fatalf("AddClient() error = %v", err)
+	}
+	if err := r.RemoveClient(client.Name(), false); err != nil {
+		t.Fatalf("RemoveClient() error = %v", err)
+	}
+	if _, ok := r.route(client.Address, ""); ok {
+		t.Errorf("RemoveClient() client is still routable")
+	}
+	if err := r.RemoveClient(client.Name(), false); !errors.Is(err, ErrClientNotFound) {
+		t.Errorf("RemoveClient() error = %v, wantErr %v", err, ErrClientNotFound)
+	}
+}
+
+func TestRouter_ApplyClients(t *testing.T) {
+	r := newTestRouter()
+	for _, c := range []config.Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "test", Address: "127.0.0.1:27151"},
+	} {
+		if err := r.AddClient(c); err != nil {
+			t.Fatalf("AddClient() error = %v", err)
+		}
+	}
+	old := r.Clients()
+	// client added with admin API is not in config
+	admin := config.Client{Server: 4, Domain: "test", Address: "127.0.0.1:27170"}
+	if err := r.AddClient(admin); err != nil {
+		t.Fatalf("AddClient() error = %v", err)
+	}
+	clients := []config.Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27160", Secret: "1234"},
+		{Server: 3, Domain: "test", Address: "127.0.0.1:27151"},
+	}
+	r.ApplyClients(old, clients)
+	want := append(clients, admin)
+	if got := r.Clients(); !cmp.Equal(got, want) {
+		t.Errorf("Clients() = %v, want %v", got, want)
+	}
+}
+
+func TestRouter_Listen(t *testing.T) {
+	r := newTestRouter()
+	r.addresses, r.readers = []string{"127.0.0.1:0"}, 1
+	client := config.Client{Server: 1, Domain: "test", Secret: "1234"}
+	lines, _ := queue.New(config.Queue{}, client.Name())
+	stateMachine := &sm.StateMachine{File: server.NewLogFile(client), Queue: lines}
+	r.addRoute(&Route{StateMachine: stateMachine, Client: client})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		errs <- r.Listen(ctx)
+	}()
+
+	// wait for socket to be bound, port is chosen by OS
+	var conn *net.UDPConn
+	for i := 0; i < 100 && conn == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		if addr := r.LocalAddr(); addr != nil {
+			conn, _ = net.DialUDP("udp", nil, addr.(*net.UDPAddr))
+		}
+	}
+	if conn == nil {
+		t.Fatal("Listen() socket was not bound")
+	}
+	defer conn.Close()
+
+	line := `L 10/01/2021 - 21:38:46: World triggered "Round_Start"`
+	conn.Write([]byte("bad packet"))
+	conn.Write(packet.Encode("1234", line))
+	popped := make(chan string)
+	go func() {
+		got, _ := lines.Pop()
+		popped <- got
+	}()
+	select {
+	case got := <-popped:
+		if got != line {
+			t.Errorf("Listen() dispatched %v, want %v", got, line)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Listen() line was not dispatched")
+	}
+	if malformed := r.Malformed(); malformed != 1 {
+		t.Errorf("Malformed() = %v, want 1", malformed)
+	}
+	if err := r.CheckListener(ctx); err != nil {
+		t.Errorf("CheckListener() error = %v", err)
+	}
+
+	cancel()
+	select {
+	case err := <-errs:
+		if err != nil {
+			t.Errorf("Listen() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Listen() has not returned after ctx was done")
+	}
+	if addr := r.LocalAddr(); addr != nil {
+		t.Errorf("LocalAddr() after Listen() = %v, want nil", addr)
+	}
+	if err := r.CheckListener(ctx); !errors.Is(err, ErrNotListening) {
+		t.Errorf("CheckListener() after Listen() error = %v, want %v", err, ErrNotListening)
+	}
+}
+
+func TestListenAddresses(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Server
+		want []string
+	}{
+		{name: "host", cfg: config.Server{Host: ":27100"}, want: []string{":27100"}},
+		{
+			name: "hosts replace host",
+			cfg:  config.Server{Host: ":27100", UDP: config.UDP{Hosts: []string{"[::]:27100", "127.0.0.1:27101"}}},
+			want: []string{"[::]:27100", "127.0.0.1:27101"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, listenAddresses(tt.cfg)); diff != "" {
+				t.Errorf("listenAddresses() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouter_ListenReaders(t *testing.T) {
+	r := newTestRouter()
+	r.addresses, r.readers, r.readBuffer = []string{"127.0.0.1:0", "127.0.0.1:0"}, 4, 1<<20
+	client := config.Client{Server: 1, Domain: "test", Secret: "1234"}
+	lines, _ := queue.New(config.Queue{Size: 1000}, client.Name())
+	stateMachine := &sm.StateMachine{File: server.NewLogFile(client), Queue: lines}
+	r.addRoute(&Route{StateMachine: stateMachine, Client: client})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error)
+	go func() {
+		errs <- r.Listen(ctx)
+	}()
+
+	var addrs []net.Addr
+	for i := 0; i < 100 && addrs == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		addrs = r.LocalAddrs()
+	}
+	if len(addrs) != 2 {
+		t.Fatalf("LocalAddrs() = %v, want 2 addresses", addrs)
+	}
+	conn, err := net.DialUDP("udp", nil, addrs[1].(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// lines of single server are dispatched in order even with several readers
+	var want []string
+	for i := 0; i < 500; i++ {
+		line := fmt.Sprintf(`L 10/01/2021 - 21:38:46: "player<%d><[U:1:1]><Red>" say "hi"`, i)
+		want = append(want, line)
+		conn.Write(packet.Encode("1234", line))
+	}
+	popped := make(chan []string)
+	go func() {
+		got := make([]string, 0, len(want))
+		for len(got) < len(want) {
+			line, _ := lines.Pop()
+			got = append(got, line)
+		}
+		popped <- got
+	}()
+	select {
+	case got := <-popped:
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Listen() dispatched lines mismatch (-want +got):\n%s", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Listen() lines were not dispatched")
+	}
+
+	cancel()
+	if err := <-errs; err != nil {
+		t.Errorf("Listen() error = %v", err)
+	}
+	if addrs := r.LocalAddrs(); addrs != nil {
+		t.Errorf("LocalAddrs() after Listen() = %v, want nil", addrs)
+	}
+}
+
+func TestRouter_Shutdown(t *testing.T) {
+	r := newTestRouter()
+	for _, c := range []config.Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "test", Address: "127.0.0.1:27151"},
+	} {
+		if err := r.AddClient(c); err != nil {
+			t.Fatalf("AddClient() error = %v", err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := r.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+	if clients := r.Clients(); len(clients) != 0 {
+		t.Errorf("Clients() after Shutdown() = %v, want none", clients)
+	}
+}
+
+func TestRouter_CheckWorkers(t *testing.T) {
+	r := newTestRouter()
+	for _, c := range []config.Client{
+		{Server: 1, Domain: "test", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "test", Address: "127.0.0.1:27151"},
+	} {
+		if err := r.AddClient(c); err != nil {
+			t.Fatalf("AddClient() error = %v", err)
+		}
+	}
+	if err := r.CheckWorkers(context.Background()); err != nil {
+		t.Errorf("CheckWorkers() error = %v", err)
+	}
+
+	r.routes["test#2"].StateMachine.Suspend()
+	err := r.CheckWorkers(context.Background())
+	if err == nil || err.Error() != "workers are not running: test#2" {
+		t.Errorf("CheckWorkers() error = %v, want stopped test#2", err)
+	}
+}
+
+func TestRouter_CheckPickupAPI(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	r := newTestRouter()
+	for _, c := range []config.Client{
+		{Server: 1, Domain: "ru", Address: "127.0.0.1:27150"},
+		{Server: 2, Domain: "ru", Address: "127.0.0.1:27151"},
+		{Server: 1, Domain: "pl", Address: "127.0.0.1:27152"},
+	} {
+		if err := r.AddClient(c); err != nil {
+			t.Fatalf("AddClient() error = %v", err)
+		}
+	}
+	// clients of the same API share its check
+	ru := mocks.NewPickupProviderMock(mc).StringMock.Return("api.tf2pickup.ru").CheckAPIMock.Return(nil)
+	pl := mocks.NewPickupProviderMock(mc).StringMock.Return("api.tf2pickup.pl").CheckAPIMock.Return(errors.New("timeout"))
+	r.routes["ru#1"].Pickups = ru
+	r.routes["ru#2"].Pickups = ru
+	r.routes["pl#1"].Pickups = pl
+	err := r.CheckPickupAPI(context.Background())
+	if err == nil || err.Error() != "api.tf2pickup.pl: timeout" {
+		t.Errorf("CheckPickupAPI() error = %v, want failure of api.tf2pickup.pl", err)
+	}
+	if got := ru.CheckAPIAfterCounter(); got != 1 {
+		t.Errorf("CheckAPI() of api.tf2pickup.ru called %d times, want 1", got)
+	}
+}
+
+func TestRouter_ReportLogsURL(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	r := newTestRouter()
+	if err := r.AddClient(config.Client{Server: 1, Domain: "ru", Address: "127.0.0.1:27150"}); err != nil {
+		t.Fatalf("AddClient() error = %v", err)
+	}
+	r.routes["ru#1"].Pickups = mocks.NewPickupProviderMock(mc).ReportLogsURLMock.Set(
+		func(ctx context.Context, pickupID int, logsURL string) error {
+			if pickupID != 123 || logsURL != "https://logs.tf/1" {
+				t.Errorf("ReportLogsURL() got pickup %d and url %s", pickupID, logsURL)
+			}
+			return nil
+		})
+	if err := r.ReportLogsURL(context.Background(), "ru#1", 123, "https://logs.tf/1"); err != nil {
+		t.Errorf("ReportLogsURL() error = %v", err)
+	}
+	if err := r.ReportLogsURL(context.Background(), "pl#1", 123, "https://logs.tf/1"); !errors.Is(err, ErrClientNotFound) {
+		t.Errorf("ReportLogsURL() error = %v, want %v", err, ErrClientNotFound)
+	}
+}
+
+func TestRouter_DispatchSlowServer(t *testing.T) {
+	r := newTestRouter()
+	slow := config.Client{Server: 1, Domain: "test", Address: "127.0.0.1:27150"}
+	fast := config.Client{Server: 2, Domain: "test", Address: "127.0.0.1:27151"}
+	slowLines, _ := queue.New(config.Queue{Size: 2, Policy: queue.PolicyDropOldest}, slow.Name())
+	fastLines, _ := queue.New(config.Queue{Size: 2, Policy: queue.PolicyDropOldest}, fast.Name())
+	r.addRoute(&Route{StateMachine: &sm.StateMachine{File: server.NewLogFile(slow), Queue: slowLines}, Client: slow})
+	r.addRoute(&Route{StateMachine: &sm.StateMachine{File: server.NewLogFile(fast), Queue: fastLines}, Client: fast})
+
+	// worker of slow server doesn't pop anything, router must not wait for it
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 5; i++ {
+			r.dispatch(slow.Address, &packet.Packet{Line: "slow"}, 4)
+		}
+		r.dispatch(fast.Address, &packet.Packet{Line: "fast"}, 4)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatch() blocked on full queue of another server")
+	}
+	if line, _ := fastLines.Pop(); line != "fast" {
+		t.Errorf("fast server got %s, want fast", line)
+	}
+	if dropped := slowLines.Dropped(); dropped != 3 {
+		t.Errorf("slow server Dropped() = %d, want 3", dropped)
+	}
+}
@@ -0,0 +1,325 @@
+package server
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/requests"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SyncAlways makes journal fsync every written line
+	SyncAlways = "always"
+	// SyncInterval makes journal fsync at most once per sync interval
+	SyncInterval = "interval"
+	// SyncNever leaves flushing to OS
+	SyncNever = "never"
+)
+
+const (
+	DefaultSyncInterval = time.Second
+	journalExtension    = ".journal"
+	finishedExtension   = ".finished"
+	mapLinePrefix       = "#map "
+	pickupLinePrefix    = "#pickup "
+)
+
+// Journal is implemented by LogFilers which keep match log on disk to survive restarts
+type Journal interface {
+	// SaveMap persists map of match in progress
+	SaveMap(gameMap string)
+	// SavePickup persists pickup of match in progress, so it isn't looked up on pickup API again after restart
+	SavePickup(pickup *requests.Pickup)
+	// Detach moves journal of finished match aside, so the next match starts with empty journal.
+	// Returned release removes it once match is uploaded or queued for retry, until then it is recovered after restart
+	Detach() (release func())
+	// Recover returns matches left in journal before restart: finished matches which weren't released
+	// and match which was in progress. Journal isn't changed, so nothing is lost if replay is interrupted
+	Recover() ([]*Recovered, error)
+	// Replay starts replaying recovered match: its lines aren't written to journal again and Detach releases
+	// its journal. Returned done ends replay, journal of finished match which wasn't detached is removed
+	Replay(r *Recovered) (done func())
+	// Close closes journal file keeping its content on disk
+	Close() error
+}
+
+// Recovered is a match log read from journal, Finished is set for matches which were over but not released.
+// Pickups are pickups of matches started in journal in order
+type Recovered struct {
+	Map      string
+	Pickups  []*requests.Pickup
+	Lines    []string
+	ModTime  time.Time
+	Finished bool
+	path     string
+	detached bool
+}
+
+// JournalFile is LogFile which also appends every line to file on disk
+type JournalFile struct {
+	LogFile
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	policy   string
+	interval time.Duration
+	lastSync time.Time
+	log      *logrus.Logger
+	// replaying is recovered match being replayed, journal isn't written while it is set
+	replaying *Recovered
+}
+
+// NewJournalFile opens per-server journal in configured dir, creating it if needed
+func NewJournalFile(client config.Client, cfg config.Journal, log *logrus.Logger) (*JournalFile, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	policy := cfg.Sync
+	switch policy {
+	case "":
+		policy = SyncInterval
+	case SyncAlways, SyncInterval, SyncNever:
+	default:
+		return nil, fmt.Errorf("unknown journal sync policy: %s", policy)
+	}
+	interval := cfg.SyncInterval
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+
+	path := filepath.Join(cfg.Dir, JournalName(client))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &JournalFile{
+		LogFile:  *NewLogFile(client),
+		path:     path,
+		file:     file,
+		policy:   policy,
+		interval: interval,
+		log:      log,
+	}, nil
+}
+
+// JournalName returns file name of client's journal, e.g. "ru_1.journal"
+func JournalName(client config.Client) string {
+	return fmt.Sprintf("%s_%d%s", client.Domain, client.Server, journalExtension)
+}
+
+func (j *JournalFile) WriteLine(msg string) {
+	j.LogFile.WriteLine(msg)
+	j.append(msg)
+}
+
+func (j *JournalFile) SaveMap(gameMap string) {
+	j.append(mapLinePrefix + gameMap)
+}
+
+func (j *JournalFile) SavePickup(pickup *requests.Pickup) {
+	data, err := json.Marshal(pickup)
+	if err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to encode pickup for journal: %s", err)
+		return
+	}
+	j.append(pickupLinePrefix + string(data))
+}
+
+func (j *JournalFile) FlushBuffer() {
+	j.LogFile.FlushBuffer()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.replaying != nil {
+		// replay reaches the same state from the whole journal, so it is kept until replay is done
+		return
+	}
+	if err := j.file.Truncate(0); err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to truncate journal: %s", err)
+		return
+	}
+	j.syncLocked(true)
+}
+
+func (j *JournalFile) Detach() (release func()) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if r := j.replaying; r != nil && r.Finished {
+		r.detached = true
+		return j.remover(r.path)
+	}
+	// match in progress is detached as usual, lines after it are journaled again
+	j.replaying = nil
+	path := fmt.Sprintf("%s.%d%s", j.path, time.Now().UnixNano(), finishedExtension)
+	j.syncLocked(true)
+	if err := os.Rename(j.path, path); err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to detach journal of finished match: %s", err)
+		return func() {}
+	}
+	if err := j.reopenLocked(); err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to reopen journal: %s", err)
+	}
+	return j.remover(path)
+}
+
+func (j *JournalFile) Replay(r *Recovered) (done func()) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.replaying = r
+	return func() {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		if j.replaying == r {
+			j.replaying = nil
+		}
+		if r.Finished && !r.detached {
+			j.remover(r.path)()
+		}
+	}
+}
+
+// remover returns function removing journal of finished match at path
+func (j *JournalFile) remover(path string) func() {
+	return func() {
+		if err := os.Remove(path); err != nil {
+			j.log.WithField("server", j.name).Errorf("Failed to remove journal of finished match: %s", err)
+		}
+	}
+}
+
+func (j *JournalFile) Recover() ([]*Recovered, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	finished, err := j.finishedLocked()
+	if err != nil {
+		return nil, err
+	}
+	var recovered []*Recovered
+	for _, path := range finished {
+		r, err := readJournal(path)
+		if err != nil {
+			return nil, err
+		}
+		r.Finished = true
+		recovered = append(recovered, r)
+	}
+	r, err := readJournal(j.path)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Lines) != 0 {
+		recovered = append(recovered, r)
+	}
+	return recovered, nil
+}
+
+// finishedLocked returns journals of finished matches which weren't released, oldest first
+func (j *JournalFile) finishedLocked() ([]string, error) {
+	paths, err := filepath.Glob(j.path + ".*" + finishedExtension)
+	if err != nil {
+		return nil, err
+	}
+	seqs := make(map[string]int64, len(paths))
+	var finished []string
+	for _, path := range paths {
+		// glob also matches journals of other clients with this client's journal name as a prefix
+		seq, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(path, j.path+"."), finishedExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs[path] = seq
+		finished = append(finished, path)
+	}
+	sort.Slice(finished, func(a, b int) bool {
+		return seqs[finished[a]] < seqs[finished[b]]
+	})
+	return finished, nil
+}
+
+// readJournal parses journal file at path
+func readJournal(path string) (*Recovered, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recovered{ModTime: info.ModTime(), path: path}
+	for _, line := range strings.Split(string(content), "\n") {
+		switch {
+		case strings.HasPrefix(line, mapLinePrefix):
+			r.Map = strings.TrimPrefix(line, mapLinePrefix)
+		case strings.HasPrefix(line, pickupLinePrefix):
+			pickup := &requests.Pickup{}
+			if err = json.Unmarshal([]byte(strings.TrimPrefix(line, pickupLinePrefix)), pickup); err != nil {
+				return nil, fmt.Errorf("bad pickup in journal %s: %w", path, err)
+			}
+			r.Pickups = append(r.Pickups, pickup)
+		case line != "":
+			r.Lines = append(r.Lines, line)
+		}
+	}
+	return r, nil
+}
+
+// reopenLocked replaces file of journal with a new empty one
+func (j *JournalFile) reopenLocked() error {
+	j.file.Close()
+	file, err := os.OpenFile(j.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	j.file = file
+	return nil
+}
+
+func (j *JournalFile) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if err := j.file.Sync(); err != nil {
+		j.file.Close()
+		return err
+	}
+	return j.file.Close()
+}
+
+func (j *JournalFile) append(line string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.replaying != nil {
+		return
+	}
+	if _, err := j.file.WriteString(line + "\n"); err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to write journal: %s", err)
+		return
+	}
+	j.syncLocked(false)
+}
+
+// syncLocked fsyncs file according to sync policy, force skips interval check
+func (j *JournalFile) syncLocked(force bool) {
+	switch {
+	case j.policy == SyncNever:
+		return
+	case j.policy == SyncInterval && !force && time.Since(j.lastSync) < j.interval:
+		return
+	}
+	if err := j.file.Sync(); err != nil {
+		j.log.WithField("server", j.name).Errorf("Failed to sync journal: %s", err)
+		return
+	}
+	j.lastSync = time.Now()
+}
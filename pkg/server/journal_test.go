@@ -0,0 +1,227 @@
+package server
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/requests"
+	"LogWatcher/pkg/stats"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sirupsen/logrus"
+)
+
+func TestJournalFile_Recover(t *testing.T) {
+	client := config.Client{Server: 1, Domain: "test"}
+	cfg := config.Journal{Dir: t.TempDir(), Sync: SyncAlways}
+
+	j, err := NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	j.SaveMap("cp_granary_pro_rc8")
+	j.WriteLine("first")
+	j.SavePickup(&requests.Pickup{ID: 1, Players: []*stats.PickupPlayer{{PlayerID: "id", SteamID: "76561198011558250"}}})
+	j.WriteLine("second")
+	if got := j.Buffer(); got.String() != "first\nsecond\n" {
+		t.Errorf("Buffer() = %v, want %v", got.String(), "first\nsecond\n")
+	}
+	if err = j.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	j, err = NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	defer j.Close()
+	got, err := j.Recover()
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("Recover() got %d matches, want 1", len(got))
+	}
+	want := []*Recovered{{
+		Map:     "cp_granary_pro_rc8",
+		Pickups: []*requests.Pickup{{ID: 1, Players: []*stats.PickupPlayer{{PlayerID: "id", SteamID: "76561198011558250"}}}},
+		Lines:   []string{"first", "second"},
+		ModTime: got[0].ModTime,
+	}}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Recovered{})); diff != "" {
+		t.Errorf("Recover() mismatch (-want +got):\n%s", diff)
+	}
+
+	info, err := os.Stat(filepath.Join(cfg.Dir, "test_1.journal"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() == 0 {
+		t.Errorf("journal is empty after Recover(), want it kept until replay is done")
+	}
+}
+
+func TestJournalFile_Detach(t *testing.T) {
+	client := config.Client{Server: 1, Domain: "test"}
+	cfg := config.Journal{Dir: t.TempDir()}
+
+	j, err := NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	j.SaveMap("cp_granary_pro_rc8")
+	j.WriteLine("game over")
+	first := j.Detach()
+	j.FlushBuffer()
+	j.SaveMap("cp_process_final")
+	j.WriteLine("game over")
+	j.Detach()
+	j.FlushBuffer()
+	j.WriteLine("next match")
+	// first match is finalized, second one is lost with crash
+	first()
+	if err = j.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	j, err = NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	defer j.Close()
+	got, err := j.Recover()
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+	want := []*Recovered{
+		{Map: "cp_process_final", Lines: []string{"game over"}, Finished: true},
+		{Lines: []string{"next match"}},
+	}
+	if !cmp.Equal(got, want, cmpopts.IgnoreFields(Recovered{}, "ModTime"), cmpopts.IgnoreUnexported(Recovered{})) {
+		t.Errorf("Recover() got = %v, want %v", got, want)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(cfg.Dir, "*"+finishedExtension))
+	if len(files) != 1 {
+		t.Errorf("finished journals after Recover() = %v, want one", files)
+	}
+}
+
+func TestJournalFile_Replay(t *testing.T) {
+	client := config.Client{Server: 1, Domain: "test"}
+	cfg := config.Journal{Dir: t.TempDir()}
+	path := filepath.Join(cfg.Dir, JournalName(client))
+
+	j, err := NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	j.WriteLine("game over")
+	j.Detach()
+	j.FlushBuffer()
+	j.WriteLine("next match")
+	if err = j.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	j, err = NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	defer j.Close()
+	recovered, err := j.Recover()
+	if err != nil {
+		t.Fatalf("Recover() error = %v", err)
+	}
+	if len(recovered) != 2 {
+		t.Fatalf("Recover() got %d matches, want 2", len(recovered))
+	}
+
+	// finished match is finalized again while it is replayed
+	done := j.Replay(recovered[0])
+	j.WriteLine("game over")
+	release := j.Detach()
+	done()
+	if files, _ := filepath.Glob(path + ".*" + finishedExtension); len(files) != 1 {
+		t.Errorf("finished journals before release = %v, want one", files)
+	}
+	release()
+	if files, _ := filepath.Glob(path + ".*" + finishedExtension); len(files) != 0 {
+		t.Errorf("finished journals after release = %v, want none", files)
+	}
+
+	// match in progress is resumed, replayed lines aren't written twice
+	done = j.Replay(recovered[1])
+	j.WriteLine("next match")
+	j.FlushBuffer()
+	done()
+	j.WriteLine("resumed")
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "next match\nresumed\n" {
+		t.Errorf("journal content after Replay() = %q, want %q", content, "next match\nresumed\n")
+	}
+}
+
+func TestJournalFile_FlushBuffer(t *testing.T) {
+	client := config.Client{Server: 1, Domain: "test"}
+	cfg := config.Journal{Dir: t.TempDir()}
+
+	j, err := NewJournalFile(client, cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	defer j.Close()
+	j.WriteLine("first")
+	j.FlushBuffer()
+
+	content, err := os.ReadFile(filepath.Join(cfg.Dir, JournalName(client)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content) != 0 {
+		t.Errorf("journal content after FlushBuffer() = %q, want empty", content)
+	}
+	if got := j.Buffer(); got.Len() != 0 {
+		t.Errorf("Buffer() length after FlushBuffer() = %v, want 0", got.Len())
+	}
+}
+
+func TestNewJournalFile(t *testing.T) {
+	client := config.Client{Server: 1, Domain: "test"}
+	tests := []struct {
+		name    string
+		cfg     config.Journal
+		wantErr bool
+	}{
+		{
+			name: "default policy",
+			cfg:  config.Journal{Dir: t.TempDir()},
+		},
+		{
+			name: "never policy",
+			cfg:  config.Journal{Dir: t.TempDir(), Sync: SyncNever},
+		},
+		{
+			name:    "unknown policy",
+			cfg:     config.Journal{Dir: t.TempDir(), Sync: "sometimes"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j, err := NewJournalFile(client, tt.cfg, logrus.New())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewJournalFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if j != nil {
+				j.Close()
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package sink
+
+import (
+	"LogWatcher/pkg/internal/fileutil"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Directory stores logs in local directory as <base name>.log with <base name>.json metadata
+type Directory struct {
+	name string
+	dir  string
+}
+
+// NewDirectory creates sink storing logs in dir, creating it if needed
+func NewDirectory(name, dir string) (*Directory, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Directory{name: name, dir: dir}, nil
+}
+
+func (d *Directory) Name() string {
+	return d.name
+}
+
+func (d *Directory) Upload(_ context.Context, u *Upload) (*Result, error) {
+	metadata, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(d.dir, u.BaseName())
+	if err = fileutil.WriteFile(path+".log", u.Log); err != nil {
+		return nil, err
+	}
+	if err = fileutil.WriteFile(path+".json", metadata); err != nil {
+		return nil, err
+	}
+	return &Result{URL: path + ".log"}, nil
+}
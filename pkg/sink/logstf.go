@@ -0,0 +1,32 @@
+package sink
+
+import (
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+)
+
+// LogsTF uploads log to logs.tf or compatible service, result has id of uploaded log
+type LogsTF struct {
+	name     string
+	url      string
+	uploader requests.LogUploader
+}
+
+// NewLogsTF is a factory for LogsTF, url is base url of service which uploader posts to, empty url means logs.tf
+func NewLogsTF(name, url string, uploader requests.LogUploader) *LogsTF {
+	return &LogsTF{name: name, url: requests.LogsTFURL(url), uploader: uploader}
+}
+
+func (l *LogsTF) Name() string {
+	return l.name
+}
+
+func (l *LogsTF) Upload(ctx context.Context, u *Upload) (*Result, error) {
+	payload := l.uploader.MakeMultipartMap(u.Title, u.Map, *bytes.NewBuffer(u.Log))
+	result, err := l.uploader.UploadLogFile(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{URL: l.url + result.URL, LogID: result.LogID}, nil
+}
@@ -0,0 +1,175 @@
+package sink
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	amzDateFormat   = "20060102T150405Z"
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+)
+
+// S3 stores logs in S3-compatible object storage as <prefix><base name>.log with <base name>.json metadata,
+// requests are signed with AWS Signature Version 4 and bucket is addressed path-style, e.g. http://minio:9000/bucket/key
+type S3 struct {
+	name      string
+	endpoint  string
+	region    string
+	bucket    string
+	prefix    string
+	accessKey string
+	secretKey string
+	client    requests.HTTPDoer
+	now       func() time.Time
+}
+
+// NewS3 is a factory for S3, empty region means us-east-1
+func NewS3(cfg config.Sink, client requests.HTTPDoer) *S3 {
+	region := cfg.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	return &S3{
+		name:      cfg.Name,
+		endpoint:  strings.TrimSuffix(cfg.Endpoint, "/"),
+		region:    region,
+		bucket:    cfg.Bucket,
+		prefix:    cfg.Prefix,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		client:    client,
+		now:       time.Now,
+	}
+}
+
+func (s *S3) Name() string {
+	return s.name
+}
+
+func (s *S3) Upload(ctx context.Context, u *Upload) (*Result, error) {
+	metadata, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	key := s.prefix + u.BaseName()
+	url, err := s.putObject(ctx, key+".log", "text/plain", u.Log)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.putObject(ctx, key+".json", "application/json", metadata); err != nil {
+		return nil, err
+	}
+	return &Result{URL: url}, nil
+}
+
+// putObject uploads object and returns its url
+func (s *S3) putObject(ctx context.Context, key, contentType string, body []byte) (string, error) {
+	path := awsURIEncode("/" + s.bucket + "/" + key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	signV4(req, body, s.accessKey, s.secretKey, s.region, "s3", s.now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := ioutil.ReadAll(res.Body) // err is almost always nil
+		return "", fmt.Errorf("s3 returned code: %d, body: %s", res.StatusCode, string(resBody))
+	}
+	return req.URL.String(), nil
+}
+
+// signV4 adds x-amz-date, x-amz-content-sha256 and Authorization headers to request,
+// all headers set on request and host are signed
+func signV4(req *http.Request, body []byte, accessKey, secretKey, region, service string, t time.Time) {
+	amzDate := t.UTC().Format(amzDateFormat)
+	date := amzDate[:8]
+	if req.Header.Get("X-Amz-Content-Sha256") == "" {
+		req.Header.Set("X-Amz-Content-Sha256", hashHex(body))
+	}
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, 0, len(values))
+		for _, v := range values {
+			trimmed = append(trimmed, strings.Join(strings.Fields(v), " "))
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		awsURIEncode(req.URL.Path),
+		"", // query is never sent
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, accessKey, scope, signedHeaders, signature))
+}
+
+// awsURIEncode escapes everything except unreserved characters and slashes, as required by SigV4
+func awsURIEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hashHex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,128 @@
+package sink
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/requests"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// BuiltinLogsTF is a name of built-in logs.tf destination, it is used when client has no sinks configured
+	BuiltinLogsTF = "logstf"
+
+	TypeLogsTF    = "logstf"
+	TypeWebhook   = "webhook"
+	TypeDirectory = "directory"
+	TypeS3        = "s3"
+)
+
+var ErrUnknownSinkType = errors.New("unknown sink type")
+
+// Upload is a finished match log with its metadata
+type Upload struct {
+	Server     string    `json:"server"`
+	Domain     string    `json:"domain"`
+	PickupID   int       `json:"pickup_id"`
+	Map        string    `json:"map"`
+	Title      string    `json:"title"`
+	Incomplete bool      `json:"incomplete"`
+	EndedAt    time.Time `json:"ended_at"`
+	// LogsURL is logs.tf url if log was uploaded there
+	LogsURL string `json:"logs_url,omitempty"`
+	Log     []byte `json:"-"`
+}
+
+// BaseName returns name for stored log without extension, e.g. "ru_1_123_20211017T220951",
+// server id keeps logs of servers ending at the same second apart when pickup is unknown
+func (u *Upload) BaseName() string {
+	return fmt.Sprintf("%s_%d_%s", strings.Replace(u.Server, "#", "_", 1), u.PickupID, u.EndedAt.UTC().Format("20060102T150405"))
+}
+
+// Result describes where log was stored
+type Result struct {
+	URL string
+	// LogID is id of log uploaded to logs.tf, it is zero for other sinks
+	LogID int
+}
+
+// UploadSink is a destination of match logs, upload is cancelled once ctx is done
+type UploadSink interface {
+	Name() string
+	Upload(ctx context.Context, u *Upload) (*Result, error)
+}
+
+// New creates sink of configured type
+func New(cfg config.Sink, client requests.HTTPDoer) (UploadSink, error) {
+	switch cfg.Type {
+	case TypeLogsTF:
+		uploader := &requests.Client{Client: client, ApiKey: cfg.APIKey, URL: cfg.URL}
+		return NewLogsTF(cfg.Name, cfg.URL, uploader), nil
+	case TypeWebhook:
+		return NewWebhook(cfg.Name, cfg.URL, client), nil
+	case TypeDirectory:
+		return NewDirectory(cfg.Name, cfg.Dir)
+	case TypeS3:
+		return NewS3(cfg, client), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSinkType, cfg.Type)
+	}
+}
+
+// FanOut uploads log to several sinks concurrently
+type FanOut []UploadSink
+
+// Split separates logs.tf sinks from others, they are uploaded first so that others get logs.tf url
+func (f FanOut) Split() (logsTF, others FanOut) {
+	for _, s := range f {
+		if _, ok := s.(*LogsTF); ok {
+			logsTF = append(logsTF, s)
+		} else {
+			others = append(others, s)
+		}
+	}
+	return logsTF, others
+}
+
+// FanOutError holds errors of failed sinks by their names
+type FanOutError map[string]error
+
+func (e FanOutError) Error() string {
+	msgs := make([]string, 0, len(e))
+	for name, err := range e {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", name, err))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Upload uploads log to every sink, results of successful uploads are returned by sink names
+// along with FanOutError if some of them have failed
+func (f FanOut) Upload(ctx context.Context, u *Upload) (map[string]*Result, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]*Result, len(f))
+	errs := make(FanOutError)
+	for _, s := range f {
+		wg.Add(1)
+		go func(s UploadSink) {
+			defer wg.Done()
+			result, err := s.Upload(ctx, u)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[s.Name()] = err
+				return
+			}
+			results[s.Name()] = result
+		}(s)
+	}
+	wg.Wait()
+	if len(errs) != 0 {
+		return results, errs
+	}
+	return results, nil
+}
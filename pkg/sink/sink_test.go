@@ -0,0 +1,153 @@
+package sink_test
+
+import (
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/mocks"
+	"LogWatcher/pkg/sink"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+func testUpload() *sink.Upload {
+	return &sink.Upload{
+		Server:   "test#1",
+		Domain:   "test",
+		PickupID: 1,
+		Map:      "cp_process_final",
+		Title:    "test.tf2pickup.org #1",
+		EndedAt:  time.Date(2021, 10, 17, 22, 9, 51, 0, time.UTC),
+		Log:      []byte("log line\n"),
+	}
+}
+
+type failingSink string
+
+func (f failingSink) Name() string {
+	return string(f)
+}
+
+func (f failingSink) Upload(context.Context, *sink.Upload) (*sink.Result, error) {
+	return nil, errors.New("test error")
+}
+
+func TestFanOut_Upload(t *testing.T) {
+	dir, err := sink.NewDirectory("archive", t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := sink.FanOut{dir, failingSink("broken")}.Upload(context.Background(), testUpload())
+
+	var failed sink.FanOutError
+	if !errors.As(err, &failed) || len(failed) != 1 || failed["broken"] == nil {
+		t.Errorf("Upload() error = %v, want failure of broken sink", err)
+	}
+	if len(results) != 1 || results["archive"] == nil {
+		t.Errorf("Upload() results = %v, want result of archive sink", results)
+	}
+}
+
+func TestDirectory_Upload(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs")
+	d, err := sink.NewDirectory("archive", dir)
+	if err != nil {
+		t.Fatalf("NewDirectory() error = %v", err)
+	}
+	got, err := d.Upload(context.Background(), testUpload())
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if want := filepath.Join(dir, "test_1_1_20211017T220951.log"); got.URL != want {
+		t.Errorf("Upload() URL = %s, want %s", got.URL, want)
+	}
+	log, err := os.ReadFile(got.URL)
+	if err != nil || string(log) != "log line\n" {
+		t.Errorf("Upload() stored log = %q, err = %v", log, err)
+	}
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "*.tmp")); len(tmp) != 0 {
+		t.Errorf("Upload() left temporary files %v", tmp)
+	}
+	metadata, err := os.ReadFile(strings.TrimSuffix(got.URL, ".log") + ".json")
+	if err != nil {
+		t.Fatalf("Upload() metadata is not stored: %v", err)
+	}
+	var u sink.Upload
+	if err = json.Unmarshal(metadata, &u); err != nil || u.PickupID != 1 || u.Map != "cp_process_final" {
+		t.Errorf("Upload() stored metadata = %s, err = %v", metadata, err)
+	}
+}
+
+func TestWebhook_Upload(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "accepted", status: http.StatusAccepted},
+		{name: "rejected", status: http.StatusInternalServerError, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mocks.NewHTTPDoerMock(mc).DoMock.Set(func(req *http.Request) (*http.Response, error) {
+				if err := req.ParseMultipartForm(1 << 20); err != nil {
+					t.Fatalf("Upload() sent bad form: %v", err)
+				}
+				if !strings.Contains(req.FormValue("metadata"), `"pickup_id":1`) {
+					t.Errorf("Upload() sent metadata = %s", req.FormValue("metadata"))
+				}
+				file, _, err := req.FormFile("log")
+				if err != nil {
+					t.Fatalf("Upload() sent no log: %v", err)
+				}
+				if log, _ := ioutil.ReadAll(file); string(log) != "log line\n" {
+					t.Errorf("Upload() sent log = %q", log)
+				}
+				return &http.Response{StatusCode: tt.status, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			})
+			_, err := sink.NewWebhook("hook", "http://localhost/logs", client).Upload(context.Background(), testUpload())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Upload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogsTF_Upload(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	client := mocks.NewHTTPDoerMock(mc).DoMock.Set(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://logs.example.com/upload" {
+			t.Errorf("Upload() posted to %s", req.URL)
+		}
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Upload() sent bad form: %v", err)
+		}
+		if req.FormValue("key") != "secret" || req.FormValue("title") != "test.tf2pickup.org #1" {
+			t.Errorf("Upload() sent key = %s, title = %s", req.FormValue("key"), req.FormValue("title"))
+		}
+		body := `{"success": true, "log_id": 3080112, "url": "/3080112"}`
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+	})
+	s, err := sink.New(config.Sink{Name: "mirror", Type: sink.TypeLogsTF, URL: "https://logs.example.com/", APIKey: "secret"}, client)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got, err := s.Upload(context.Background(), testUpload())
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if want := (&sink.Result{URL: "https://logs.example.com/3080112", LogID: 3080112}); *got != *want {
+		t.Errorf("Upload() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package sink
+
+import (
+	"LogWatcher/pkg/requests"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// Webhook posts log to HTTP endpoint as multipart form
+// with "metadata" JSON part and "log" file part
+type Webhook struct {
+	name   string
+	url    string
+	client requests.HTTPDoer
+}
+
+// NewWebhook is a factory for Webhook
+func NewWebhook(name, url string, client requests.HTTPDoer) *Webhook {
+	return &Webhook{name: name, url: url, client: client}
+}
+
+func (w *Webhook) Name() string {
+	return w.name
+}
+
+func (w *Webhook) Upload(ctx context.Context, u *Upload) (*Result, error) {
+	metadata, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	mw := multipart.NewWriter(&b)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="metadata"`)
+	header.Set("Content-Type", "application/json")
+	part, _ := mw.CreatePart(header) // err is always nil for bytes.Buffer
+	part.Write(metadata)
+	part, _ = mw.CreateFormFile("log", u.BaseName()+".log")
+	part.Write(u.Log)
+	mw.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, &b)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	res, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(res.Body) // err is almost always nil
+		return nil, fmt.Errorf("webhook returned code: %d, body: %s", res.StatusCode, string(body))
+	}
+	return &Result{URL: w.url}, nil
+}
@@ -1,12 +1,28 @@
 package stateMachine
 
 import (
+	"LogWatcher/pkg/archive"
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/discord"
+	"LogWatcher/pkg/events"
+	"LogWatcher/pkg/finalizer"
+	"LogWatcher/pkg/metrics"
 	"LogWatcher/pkg/mongo"
+	"LogWatcher/pkg/notify"
+	"LogWatcher/pkg/outbox"
+	"LogWatcher/pkg/queue"
 	"LogWatcher/pkg/requests"
 	"LogWatcher/pkg/server"
+	"LogWatcher/pkg/sink"
 	"LogWatcher/pkg/stats"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"regexp"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -22,6 +38,12 @@ var (
 	gamePlayerDelAll = regexp.MustCompile(`rcon from "\d{1,3}.\d{1,3}.\d{1,3}.\d{1,3}:\d+": command "sm_game_player_delall"`)
 )
 
+const (
+	DefaultPickupTimeout = 10 * time.Second
+	DefaultUploadTimeout = outbox.DefaultUploadTimeout
+	DefaultMongoTimeout  = 10 * time.Second
+)
+
 type StateType int
 
 const (
@@ -50,11 +72,49 @@ type StateMachine struct {
 	Uploader requests.LogUploader
 	Match    stats.Matcher
 	Mongo    mongo.Inserter
-	Channel  chan string
+	// Queue holds lines received by router until worker processes them
+	Queue *queue.Queue
+	// Outbox receives failed uploads for retrying, they are lost if it is nil
+	Outbox outbox.Enqueuer
+	// Archive keeps compressed copy of every finished match log, nothing is archived if it is nil
+	Archive archive.Archiver
+	// Events receives live events of match, nothing is published if it is nil
+	Events events.Publisher
+	// Notifier receives match lifecycle notifications for webhooks, nothing is sent if it is nil
+	Notifier notify.Notifier
+	// Finalizer uploads and saves finished matches in background, they are finalized by worker if it is nil
+	Finalizer finalizer.Submitter
+	// Timeouts limit single requests to APIs and db, zero values mean defaults
+	Timeouts config.Timeouts
+	// ctx cancels requests to APIs and db in flight, e.g. on shutdown
+	ctx      context.Context
+	done     chan struct{}
+	lastLine string
+	// name labels metrics of server
+	name string
+	// mu is held while line is processed, so worker, Recover and Stop never change match concurrently
+	mu         sync.Mutex
+	lastPacket time.Time
+	// snapshotMu guards snapshot, copy of state taken by worker after every processed line,
+	// so Snapshot never waits for API requests and disk writes of line processing
+	snapshotMu sync.Mutex
+	snapshot   Snapshot
+	// replaying is set while match is replayed from journal, replayed holds its pickups not used yet
+	replaying bool
+	replayed  []*requests.Pickup
+
+	// sinksMu guards destinations of match, they are changed by router while worker is running
+	sinksMu    sync.Mutex
+	sinks      sink.FanOut
+	discordURL string
+	pickups    requests.PickupProvider
 }
 
 type Stater interface {
 	StartWorker()
+	Stop(discard bool)
+	Suspend()
+	Recover(resumeWindow time.Duration) error
 	ProcessLogLine(msg string)
 	ProcessGameStartedEvent(msg string)
 	ProcessGameLogLine(msg string)
@@ -69,40 +129,278 @@ func NewStateMachine(
 	matchData stats.Matcher,
 	inserter mongo.Inserter,
 ) *StateMachine {
-	return &StateMachine{
+	sm := &StateMachine{
 		State:    Pregame,
 		Log:      log,
 		File:     file,
 		Uploader: uploader,
 		Match:    matchData,
 		Mongo:    inserter,
-		Channel:  make(chan string),
+		done:     make(chan struct{}),
+		name:     file.Name(),
+		ctx:      context.Background(),
+	}
+	sm.Queue, _ = queue.New(config.Queue{}, sm.name) // err is always nil for default policy
+	metrics.ServerState.Set(1, sm.name, Pregame.String())
+	sm.snapshot = Snapshot{Server: sm.name, State: Pregame.String()}
+	return sm
+}
+
+// SetContext sets context of requests to APIs and db, they are cancelled once it is done.
+// It must be called before worker is started
+func (sm *StateMachine) SetContext(ctx context.Context) {
+	sm.ctx = ctx
+}
+
+// withTimeout returns context of single request, fallback is used if timeout isn't configured
+func (sm *StateMachine) withTimeout(timeout, fallback time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = fallback
+	}
+	ctx := sm.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// SetSinks sets destinations of match logs, nil means log is uploaded to logs.tf by Uploader only
+// and empty slice disables uploads.
+// It is safe to call while worker is running, change applies to the next finished match
+func (sm *StateMachine) SetSinks(sinks []sink.UploadSink) {
+	sm.sinksMu.Lock()
+	defer sm.sinksMu.Unlock()
+	sm.sinks = sinks
+}
+
+// SetDiscordWebhook sets url of Discord webhook announcing finished matches, empty url disables it
+func (sm *StateMachine) SetDiscordWebhook(url string) {
+	sm.sinksMu.Lock()
+	defer sm.sinksMu.Unlock()
+	sm.discordURL = url
+}
+
+// SetPickupProvider sets pickup site which matches are looked up on and linked to, nil disables lookups.
+// It is safe to call while worker is running
+func (sm *StateMachine) SetPickupProvider(p requests.PickupProvider) {
+	sm.sinksMu.Lock()
+	defer sm.sinksMu.Unlock()
+	sm.pickups = p
+}
+
+// pickupProvider returns current pickup site, matches have no pickup if it isn't set
+func (sm *StateMachine) pickupProvider() requests.PickupProvider {
+	sm.sinksMu.Lock()
+	defer sm.sinksMu.Unlock()
+	if sm.pickups == nil {
+		return requests.NoPickup{}
 	}
+	return sm.pickups
 }
 
 func (sm *StateMachine) StartWorker() {
-	for msg := range sm.Channel {
+	defer close(sm.done)
+	for {
+		msg, ok := sm.Queue.Pop()
+		if !ok {
+			return
+		}
+		sm.mu.Lock()
+		sm.lastPacket = time.Now()
 		sm.ProcessLogLine(msg)
+		sm.updateSnapshot()
+		sm.mu.Unlock()
+	}
+}
+
+// Snapshot is a state of server and its match in progress
+type Snapshot struct {
+	Server     string
+	State      string
+	Map        string
+	PickupID   int
+	Scores     stats.CurrentScores
+	BufferSize int
+	// LastPacket is zero if no lines were received since start
+	LastPacket time.Time
+	// Players are stats of match in progress by players' steam ids
+	Players map[string]stats.PlayerStats
+	// Queued is a number of lines waiting to be processed, Dropped is a number of lines lost due to full queue
+	Queued  int
+	Dropped uint64
+}
+
+// Snapshot returns current state of server, it is safe to call while worker is running.
+// It doesn't wait for line being processed, state as of the last processed line is returned then
+func (sm *StateMachine) Snapshot() Snapshot {
+	sm.snapshotMu.Lock()
+	snapshot := sm.snapshot
+	sm.snapshotMu.Unlock()
+
+	snapshot.Queued = sm.Queue.Len()
+	snapshot.Dropped = sm.Queue.Dropped()
+	return snapshot
+}
+
+// updateSnapshot copies current state for Snapshot, mu must be held
+func (sm *StateMachine) updateSnapshot() {
+	players := make(map[string]stats.PlayerStats)
+	for id, ps := range sm.Match.PlayerStats() {
+		players[id.String()] = *ps
+	}
+	buf := sm.File.Buffer()
+	snapshot := Snapshot{
+		Server:     sm.Match.String(),
+		State:      sm.State.String(),
+		Map:        sm.Match.Map(),
+		PickupID:   sm.Match.PickupID(),
+		Scores:     sm.Match.Score(),
+		BufferSize: buf.Len(),
+		LastPacket: sm.lastPacket,
+		Players:    players,
+	}
+	sm.snapshotMu.Lock()
+	sm.snapshot = snapshot
+	sm.snapshotMu.Unlock()
+}
+
+// Running reports whether worker hasn't exited yet
+func (sm *StateMachine) Running() bool {
+	select {
+	case <-sm.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Stop closes Queue and waits for worker to process queued lines and exit,
+// match in progress is uploaded marked as incomplete unless discard is set.
+// Lines pushed to Queue after calling Stop are dropped
+func (sm *StateMachine) Stop(discard bool) {
+	sm.Queue.Close()
+	<-sm.done
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	defer metrics.ServerState.DeletePartial("server", sm.name)
+	defer sm.closeJournal()
+	if sm.State == Pregame {
+		return
+	}
+	sm.setState(Pregame)
+	if discard {
+		sm.Flush()
+		return
+	}
+	sm.Match.SetIncomplete(true)
+	sm.ProcessGameOverEvent(sm.lastLine)
+}
+
+// Suspend closes Queue and waits for worker to exit without finishing match in progress,
+// it is kept in journal to be resumed after restart
+func (sm *StateMachine) Suspend() {
+	sm.Queue.Close()
+	<-sm.done
+	sm.closeJournal()
+	metrics.ServerState.DeletePartial("server", sm.name)
+}
+
+// Recover replays matches saved in journal before restart. Finished matches which weren't finalized
+// are finalized again, match in progress is resumed if journal was modified within resumeWindow,
+// otherwise it is uploaded marked as incomplete
+func (sm *StateMachine) Recover(resumeWindow time.Duration) error {
+	journal, ok := sm.File.(server.Journal)
+	if !ok {
+		return nil
+	}
+	recovered, err := journal.Recover()
+	if err != nil {
+		return err
+	}
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	for _, r := range recovered {
+		done := journal.Replay(r)
+		sm.replay(r, resumeWindow)
+		done()
+		if !r.Finished && sm.State == Pregame {
+			// replayed lines don't belong to any match, e.g. log was restarted before match was over
+			sm.File.FlushBuffer()
+		}
+	}
+	sm.updateSnapshot()
+	return nil
+}
+
+// replay processes lines of match recovered from journal
+func (sm *StateMachine) replay(recovered *server.Recovered, resumeWindow time.Duration) {
+	sm.Log.WithFields(logrus.Fields{
+		"server":   sm.Match.String(),
+		"lines":    len(recovered.Lines),
+		"map":      recovered.Map,
+		"finished": recovered.Finished,
+	}).Info("Replaying match from journal")
+	sm.Match.SetMap(recovered.Map)
+	sm.replaying, sm.replayed = true, recovered.Pickups
+	for _, line := range recovered.Lines {
+		sm.ProcessLogLine(line)
+	}
+	sm.replaying, sm.replayed = false, nil
+
+	// finished match is still in progress after replay if it was stopped before game over
+	if sm.State != Pregame && (recovered.Finished || time.Since(recovered.ModTime) > resumeWindow) {
+		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).
+			Info("Journal is too old to resume match, finishing it")
+		sm.setState(Pregame)
+		sm.Match.SetIncomplete(true)
+		sm.ProcessGameOverEvent(sm.lastLine)
+	}
+}
+
+// setState switches state machine to state and updates its metrics
+func (sm *StateMachine) setState(state StateType) {
+	if sm.State != state {
+		metrics.StateTransitions.Inc(sm.name, sm.State.String(), state.String())
+	}
+	metrics.ServerState.Set(0, sm.name, sm.State.String())
+	metrics.ServerState.Set(1, sm.name, state.String())
+	sm.State = state
+}
+
+func (sm *StateMachine) closeJournal() {
+	if journal, ok := sm.File.(server.Journal); ok {
+		if err := journal.Close(); err != nil {
+			sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).Errorf("Failed to close journal: %s", err)
+		}
 	}
 }
 
 func (sm *StateMachine) ProcessLogLine(msg string) {
+	sm.lastLine = msg
 	switch sm.State {
 	case Pregame:
 		sm.Match.TryParseGameMap(msg)
 		if roundStart.MatchString(msg) {
-			sm.State = Game
+			sm.setState(Game)
 			sm.ProcessGameStartedEvent(msg)
+			sm.publish(events.RoundStart, nil)
 		}
 	case Game:
 		sm.File.WriteLine(msg)
 		if roundWin.MatchString(msg) {
-			sm.State = RoundReset
+			sm.setState(RoundReset)
+			sm.Match.AddRound(msg)
+			if winner, ok := events.ParseRoundWin(msg); ok {
+				sm.publish(events.RoundWin, winner)
+			}
 			break
 		}
 		sm.ProcessGameLogLine(msg)
+		if kill, ok := events.ParseKill(msg); ok {
+			sm.publish(events.Kill, kill)
+		}
 		if logClosed.MatchString(msg) || gameOver.MatchString(msg) {
-			sm.State = Pregame
+			sm.setState(Pregame)
 			sm.ProcessGameOverEvent(msg)
 		}
 	case RoundReset:
@@ -112,46 +410,104 @@ func (sm *StateMachine) ProcessLogLine(msg string) {
 		}
 		if roundStart.MatchString(msg) {
 			sm.File.WriteLine(msg)
-			sm.State = Game
+			sm.setState(Game)
+			sm.publish(events.RoundStart, nil)
 		}
 		if logClosed.MatchString(msg) || gameOver.MatchString(msg) || gamePlayerDelAll.MatchString(msg) {
 			sm.File.WriteLine(msg)
-			sm.State = Pregame
+			sm.setState(Pregame)
 			sm.ProcessGameOverEvent(msg)
 		}
 		if logStarted.MatchString(msg) {
-			sm.State = Pregame
+			sm.setState(Pregame)
 			sm.Flush()
 		}
 		if roundLength.MatchString(msg) {
 			sm.File.WriteLine(msg)
+			sm.Match.SetRoundLength(msg)
 		}
 	}
 }
 
 func (sm *StateMachine) ProcessGameStartedEvent(msg string) {
+	if journal, ok := sm.File.(server.Journal); ok {
+		journal.SaveMap(sm.Match.Map())
+	}
 	sm.Match.SetStartTime(msg)
 	sm.File.WriteLine(msg)
 
-	pickup, err := sm.Uploader.FindMatchingPickup(sm.Match.Domain(), sm.Match.Map())
+	gameMap := sm.Match.Map()
+	pickup, err := sm.findPickup(gameMap)
+	if journal, ok := sm.File.(server.Journal); ok {
+		journal.SavePickup(pickup)
+	}
 	if err != nil {
 		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).
 			Errorf("Failed to get pickup id from API: %s", err)
+		sm.matchStarted(gameMap, 0)
 		return
 	}
-
 	sm.Match.SetPlayers(pickup.Players)
 	sm.Match.SetPickupID(pickup.ID)
-
-	if err := sm.Uploader.ResolvePlayers(sm.Match.Domain(), sm.Match.PickupPlayers()); err != nil {
-		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).
-			Errorf("Failed to resolve pickup player ids through API: %s", err)
-	}
 	sm.Log.WithFields(logrus.Fields{
 		"server":    sm.Match.String(),
 		"pickup_id": sm.Match.PickupID(),
 		"map":       sm.Match.Map(),
 	}).Infof("Pickup has started")
+	sm.matchStarted(gameMap, pickup.ID)
+}
+
+// findPickup finds pickup of started match with resolved players. Pickup saved in journal is used
+// while match is replayed, so pickup API isn't asked about match which may be over long ago
+func (sm *StateMachine) findPickup(gameMap string) (*requests.Pickup, error) {
+	if sm.replaying {
+		if len(sm.replayed) == 0 {
+			return &requests.Pickup{}, nil
+		}
+		pickup := sm.replayed[0]
+		sm.replayed = sm.replayed[1:]
+		return pickup, nil
+	}
+
+	pickups := sm.pickupProvider()
+	ctx, cancel := sm.withTimeout(sm.Timeouts.PickupAPI, DefaultPickupTimeout)
+	pickup, err := pickups.FindMatchingPickup(ctx, gameMap)
+	cancel()
+	if err != nil {
+		metrics.PickupLookups.Inc(sm.name, "error")
+		return &requests.Pickup{}, err
+	}
+	if pickup.ID == 0 {
+		metrics.PickupLookups.Inc(sm.name, "not_found")
+	} else {
+		metrics.PickupLookups.Inc(sm.name, "found")
+	}
+
+	ctx, cancel = sm.withTimeout(sm.Timeouts.PickupAPI, DefaultPickupTimeout)
+	defer cancel()
+	if err := pickups.ResolvePlayers(ctx, pickup.Players); err != nil {
+		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).
+			Errorf("Failed to resolve pickup player ids through API: %s", err)
+	}
+	return pickup, nil
+}
+
+// matchStarted announces start of match to event stream and webhooks,
+// match replayed from journal was announced before restart
+func (sm *StateMachine) matchStarted(gameMap string, pickupID int) {
+	if sm.replaying {
+		return
+	}
+	sm.publish(events.MatchStart, &events.MatchStartData{Map: gameMap, PickupID: pickupID})
+	if sm.Notifier != nil {
+		sm.Notifier.Notify(notify.Payload{
+			Event:    notify.MatchStart,
+			Server:   sm.name,
+			Domain:   sm.Match.Domain(),
+			PickupID: pickupID,
+			Map:      gameMap,
+		})
+	}
 }
 
 func (sm *StateMachine) ProcessGameLogLine(msg string) {
@@ -159,23 +515,225 @@ func (sm *StateMachine) ProcessGameLogLine(msg string) {
 	sm.Match.SetPlayerStats(playerStats)
 }
 
+// finishedMatch is detached copy of finished match and its destinations,
+// it is finalized while state machine already collects the next match
+type finishedMatch struct {
+	match      stats.Matcher
+	log        bytes.Buffer
+	sinks      sink.FanOut
+	discordURL string
+	pickups    requests.PickupProvider
+	// release removes journal of match, it is kept until match is finalized to survive crash
+	release func()
+}
+
+// title returns title of match on its pickup site
+func (m *finishedMatch) title() string {
+	return requests.MakeTitle(m.pickups.Site(), m.match)
+}
+
+// ProcessGameOverEvent hands finished match to Finalizer and flushes state machine for the next match,
+// match is finalized synchronously if Finalizer is nil
 func (sm *StateMachine) ProcessGameOverEvent(msg string) {
 	sm.Match.SetLength(msg)
 
-	payload := sm.Uploader.MakeMultipartMap(sm.Match, sm.File.Buffer())
-	if err := sm.Uploader.UploadLogFile(payload); err != nil {
-		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).Errorf("Failed to upload File to logs.tf: %s", err)
+	buf := sm.File.Buffer()
+	m := &finishedMatch{
+		match: stats.CopyMatch(sm.Match),
+		// buffer is reused after flush, so its content has to be copied
+		log: *bytes.NewBuffer(append([]byte(nil), buf.Bytes()...)),
+	}
+	sm.sinksMu.Lock()
+	m.sinks, m.discordURL = sm.sinks, sm.discordURL
+	sm.sinksMu.Unlock()
+	if m.sinks == nil {
+		m.sinks = sink.FanOut{sink.NewLogsTF(sink.BuiltinLogsTF, "", sm.Uploader)}
 	}
-	playersStats := stats.ExtractPlayerStats(sm.Match)
-	if err := sm.Mongo.InsertGameStats(playersStats); err != nil {
-		sm.Log.WithFields(logrus.Fields{"server": sm.Match.String()}).Errorf("Failed to insert stats to db: %s", err)
+	m.pickups = sm.pickupProvider()
+	m.release = func() {}
+	if journal, ok := sm.File.(server.Journal); ok {
+		m.release = journal.Detach()
+	}
+	sm.Flush()
+
+	if sm.Finalizer == nil {
+		sm.finalize(m)
+		m.release()
+		return
+	}
+	sm.Finalizer.Submit(func() {
+		sm.finalize(m)
+		m.release()
+	})
+}
+
+// finalize uploads finished match, saves it and announces its end
+func (sm *StateMachine) finalize(m *finishedMatch) {
+	// logs.tf is uploaded first, so other sinks and pickup site get url of log
+	logsTF, others := m.sinks.Split()
+	sm.uploadSinks(m, logsTF)
+	sm.uploadSinks(m, others)
+	matchInfo := stats.ExtractMatchInfo(m.match)
+	if sm.Archive != nil {
+		if err := sm.Archive.Store(matchInfo, m.log); err != nil {
+			sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Errorf("Failed to archive log: %s", err)
+		}
+	}
+	sm.insertMatch(m, matchInfo)
+	metrics.MatchesCompleted.Inc(sm.name, strconv.FormatBool(matchInfo.Incomplete))
+	sm.send(events.MatchEnd, &events.MatchEndData{
+		Map:        matchInfo.Map,
+		PickupID:   matchInfo.PickupID,
+		Scores:     matchInfo.Scores,
+		Incomplete: matchInfo.Incomplete,
+		LogsURL:    matchInfo.LogURL,
+	})
+	if sm.Notifier != nil {
+		sm.Notifier.Notify(notify.Payload{
+			Event:      notify.MatchEnd,
+			Server:     sm.name,
+			Domain:     matchInfo.Domain,
+			PickupID:   matchInfo.PickupID,
+			Map:        matchInfo.Map,
+			Scores:     matchInfo.Scores,
+			Length:     matchInfo.Length,
+			LogsURL:    matchInfo.LogURL,
+			Incomplete: matchInfo.Incomplete,
+		})
+	}
+	if m.discordURL != "" {
+		sm.notifyDiscord(m, matchInfo)
 	}
 	sm.Log.WithFields(logrus.Fields{
-		"server":    sm.Match.String(),
-		"pickup_id": sm.Match.PickupID(),
-		"map":       sm.Match.Map(),
+		"server":    m.match.String(),
+		"pickup_id": matchInfo.PickupID,
+		"map":       matchInfo.Map,
+		"logs_url":  matchInfo.LogURL,
 	}).Info("Pickup has ended")
-	sm.Flush()
+}
+
+// insertMatch saves stats of players and match info to db
+func (sm *StateMachine) insertMatch(m *finishedMatch, matchInfo stats.MongoMatchInfo) {
+	ctx, cancel := sm.withTimeout(sm.Timeouts.Mongo, DefaultMongoTimeout)
+	defer cancel()
+	playersStats := stats.ExtractPlayerStats(m.match)
+	if err := sm.Mongo.InsertGameStats(ctx, playersStats); err != nil {
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Errorf("Failed to insert stats to db: %s", err)
+	}
+	if err := sm.Mongo.InsertMatch(ctx, matchInfo); err != nil {
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Errorf("Failed to insert match to db: %s", err)
+	}
+}
+
+// uploadSinks sends log to destinations concurrently. Log id of the first logs.tf sink is saved to match
+// and reported to pickup site, failed logs.tf uploads are announced and built-in one is queued to Outbox
+func (sm *StateMachine) uploadSinks(m *finishedMatch, sinks sink.FanOut) {
+	if len(sinks) == 0 {
+		return
+	}
+	upload := &sink.Upload{
+		Server:     m.match.String(),
+		Domain:     m.match.Domain(),
+		PickupID:   m.match.PickupID(),
+		Map:        m.match.Map(),
+		Title:      m.title(),
+		Incomplete: m.match.Incomplete(),
+		EndedAt:    m.match.EndedAt(),
+		LogsURL:    m.match.LogURL(),
+		Log:        m.log.Bytes(),
+	}
+	ctx, cancel := sm.withTimeout(sm.Timeouts.Upload, DefaultUploadTimeout)
+	results, err := sinks.Upload(ctx, upload)
+	cancel()
+	failed, _ := err.(sink.FanOutError) // FanOut returns no other errors
+	for _, s := range sinks {
+		_, logsTF := s.(*sink.LogsTF)
+		if err, ok := failed[s.Name()]; ok {
+			sm.Log.WithFields(logrus.Fields{"server": m.match.String(), "sink": s.Name()}).
+				Errorf("Failed to upload log: %s", err)
+			if s.Name() == sink.BuiltinLogsTF {
+				sm.enqueueUpload(m, err)
+			}
+			if logsTF {
+				sm.notifyUploadFailed(m, err)
+			}
+			continue
+		}
+		result, ok := results[s.Name()]
+		if !ok {
+			continue
+		}
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String(), "sink": s.Name()}).
+			Debugf("Uploaded log to %s", result.URL)
+		if logsTF && m.match.LogURL() == "" {
+			m.match.SetLog(result.LogID, result.URL)
+			sm.reportLogsURL(m)
+		}
+	}
+}
+
+// notifyDiscord posts summary of finished match to Discord webhook
+func (sm *StateMachine) notifyDiscord(m *finishedMatch, matchInfo stats.MongoMatchInfo) {
+	if sm.Notifier == nil {
+		return
+	}
+	logsTF, _ := m.sinks.Split()
+	message := discord.MatchEnd(m.title(), matchInfo, len(logsTF) > 0, m.match.PlayerStats(), m.match.PickupPlayers())
+	body, _ := json.Marshal(message) // err is always nil for Message
+	sm.Notifier.Post("discord", m.discordURL, notify.MatchEnd, body)
+}
+
+// notifyUploadFailed tells webhooks that log failed to upload to logs.tf sink
+func (sm *StateMachine) notifyUploadFailed(m *finishedMatch, uploadErr error) {
+	if sm.Notifier == nil {
+		return
+	}
+	sm.Notifier.Notify(notify.Payload{
+		Event:      notify.UploadFailed,
+		Server:     sm.name,
+		Domain:     m.match.Domain(),
+		PickupID:   m.match.PickupID(),
+		Map:        m.match.Map(),
+		Scores:     m.match.Score(),
+		Length:     m.match.LengthSeconds(),
+		Incomplete: m.match.Incomplete(),
+		Error:      uploadErr.Error(),
+	})
+}
+
+// reportLogsURL links uploaded log to pickup on pickup site
+func (sm *StateMachine) reportLogsURL(m *finishedMatch) {
+	if m.match.PickupID() == 0 {
+		return
+	}
+	ctx, cancel := sm.withTimeout(sm.Timeouts.PickupAPI, DefaultPickupTimeout)
+	defer cancel()
+	err := m.pickups.ReportLogsURL(ctx, m.match.PickupID(), m.match.LogURL())
+	switch {
+	case errors.Is(err, requests.ErrNoPickupToken):
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Debug("Skipped reporting logs url to API, no token")
+	case err != nil:
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Errorf("Failed to report logs url to API: %s", err)
+	}
+}
+
+// enqueueUpload saves log which failed to upload to Outbox
+func (sm *StateMachine) enqueueUpload(m *finishedMatch, uploadErr error) {
+	var rejected *requests.UploadError
+	if sm.Outbox == nil || errors.As(uploadErr, &rejected) {
+		return
+	}
+	entry := outbox.Entry{
+		Server:    m.match.String(),
+		Domain:    m.match.Domain(),
+		PickupID:  m.match.PickupID(),
+		Map:       m.match.Map(),
+		Title:     m.title(),
+		LastError: uploadErr.Error(),
+	}
+	if err := sm.Outbox.Enqueue(entry, m.log); err != nil {
+		sm.Log.WithFields(logrus.Fields{"server": m.match.String()}).Errorf("Failed to queue upload for retry: %s", err)
+	}
 }
 
 // Flush is used to empty all game data
@@ -192,4 +750,22 @@ func (sm *StateMachine) ProcessCurrentScore(msg string) {
 	} else if match[1] == "Blue" {
 		sm.Match.SetBlueScore(score)
 	}
+	sm.publish(events.ScoreChange, &events.ScoreChangeData{Team: match[1], Score: score})
+}
+
+// publish sends live event of server to Events unless lines are replayed from journal,
+// they were published before restart
+func (sm *StateMachine) publish(t events.Type, data interface{}) {
+	if sm.replaying {
+		return
+	}
+	sm.send(t, data)
+}
+
+// send sends event of server to Events if it is set, it is safe to call from finalizer
+func (sm *StateMachine) send(t events.Type, data interface{}) {
+	if sm.Events == nil {
+		return
+	}
+	sm.Events.Publish(events.Event{Server: sm.name, Type: t, Time: time.Now(), Data: data})
 }
@@ -1,18 +1,33 @@
 package stateMachine_test
 
 import (
+	"LogWatcher/pkg/archive"
+	"LogWatcher/pkg/config"
+	"LogWatcher/pkg/discord"
+	"LogWatcher/pkg/events"
+	"LogWatcher/pkg/finalizer"
 	"LogWatcher/pkg/mocks"
 	"LogWatcher/pkg/mongo"
+	"LogWatcher/pkg/notify"
+	"LogWatcher/pkg/outbox"
 	"LogWatcher/pkg/requests"
 	"LogWatcher/pkg/server"
+	"LogWatcher/pkg/sink"
 	"LogWatcher/pkg/stateMachine"
 	"LogWatcher/pkg/stats"
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/go-cmp/cmp"
 	"github.com/leighmacdonald/steamid/steamid"
 	"github.com/sirupsen/logrus"
 )
@@ -29,8 +44,10 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 		log      *logrus.Logger
 		File     server.LogFiler
 		Uploader requests.LogUploader
+		Pickups  requests.PickupProvider
 		Match    stats.Matcher
 		Mongo    mongo.Inserter
+		Outbox   outbox.Enqueuer
 	}
 	type args struct {
 		msg string
@@ -48,12 +65,12 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				log:   log,
 				File: mocks.NewLogFilerMock(mc).
 					WriteLineMock.Expect(`: World triggered "Round_Start"`).Return(),
-				Uploader: mocks.NewLogUploaderMock(mc).
-					ResolvePlayersMock.Expect("test",
+				Pickups: mocks.NewPickupProviderMock(mc).
+					ResolvePlayersMock.Inspect(expectResolvePlayers(t,
 					[]*stats.PickupPlayer{
-						{PlayerID: "123", Class: "soldier", SteamID: "76561198011558250", Team: "red"},
-					}).Return(nil).
-					FindMatchingPickupMock.Expect("test", "cp_granary_pro_rc8").Return(
+						{PlayerID: "123", Class: "soldier", Team: "red"},
+					})).Return(nil).
+					FindMatchingPickupMock.Inspect(expectFindPickup(t, "cp_granary_pro_rc8")).Return(
 					&requests.Pickup{
 						Players: []*stats.PickupPlayer{
 							{PlayerID: "123", Class: "soldier", Team: "red"},
@@ -62,11 +79,6 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				Match: mocks.NewMatcherMock(mc).
 					TryParseGameMapMock.Expect(`: World triggered "Round_Start"`).Return().
 					SetStartTimeMock.Expect(`: World triggered "Round_Start"`).Return().
-					DomainMock.Return("test").
-					PickupPlayersMock.Return(
-					[]*stats.PickupPlayer{
-						{PlayerID: "123", Class: "soldier", SteamID: "76561198011558250", Team: "red"},
-					}).
 					StringMock.Return("test#1").
 					PickupIDMock.Return(0).
 					MapMock.Return("cp_granary_pro_rc8").
@@ -84,14 +96,13 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				log:   log,
 				File: mocks.NewLogFilerMock(mc).
 					WriteLineMock.Expect(`: World triggered "Round_Start"`).Return(),
-				Uploader: mocks.NewLogUploaderMock(mc).
-					FindMatchingPickupMock.Expect("test", "cp_granary_pro_rc8").Return(
+				Pickups: mocks.NewPickupProviderMock(mc).
+					FindMatchingPickupMock.Inspect(expectFindPickup(t, "cp_granary_pro_rc8")).Return(
 					nil, errors.New("test err"),
 				),
 				Match: mocks.NewMatcherMock(mc).
 					TryParseGameMapMock.Expect(`: World triggered "Round_Start"`).Return().
 					SetStartTimeMock.Expect(`: World triggered "Round_Start"`).Return().
-					DomainMock.Return("test").
 					StringMock.Return("test#1").
 					MapMock.Return("cp_granary_pro_rc8"),
 				Mongo: mocks.NewInserterMock(mc),
@@ -125,20 +136,15 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				log:   log,
 				File: mocks.NewLogFilerMock(mc).
 					WriteLineMock.Expect(`: World triggered "Round_Start"`).Return(),
-				Uploader: mocks.NewLogUploaderMock(mc).
-					ResolvePlayersMock.Expect("test",
+				Pickups: mocks.NewPickupProviderMock(mc).
+					ResolvePlayersMock.Inspect(expectResolvePlayers(t,
 					[]*stats.PickupPlayer{
-						{PlayerID: "123", Class: "soldier", SteamID: "76561198011558250", Team: "red"},
-					}).Return(errors.New("failed to resolve players")).
-					FindMatchingPickupMock.Expect("test", "cp_granary_pro_rc8").Return(&requests.Pickup{Players: []*stats.PickupPlayer{{PlayerID: "123", Class: "soldier", Team: "red"}}, ID: 0}, nil),
+						{PlayerID: "123", Class: "soldier", Team: "red"},
+					})).Return(errors.New("failed to resolve players")).
+					FindMatchingPickupMock.Inspect(expectFindPickup(t, "cp_granary_pro_rc8")).Return(&requests.Pickup{Players: []*stats.PickupPlayer{{PlayerID: "123", Class: "soldier", Team: "red"}}, ID: 0}, nil),
 				Match: mocks.NewMatcherMock(mc).
 					TryParseGameMapMock.Expect(`: World triggered "Round_Start"`).Return().
 					SetStartTimeMock.Expect(`: World triggered "Round_Start"`).Return().
-					DomainMock.Return("test").
-					PickupPlayersMock.Return(
-					[]*stats.PickupPlayer{
-						{PlayerID: "123", Class: "soldier", SteamID: "76561198011558250", Team: "red"},
-					}).
 					StringMock.Return("test#1").
 					PickupIDMock.Return(0).
 					MapMock.Return("cp_granary_pro_rc8").
@@ -163,7 +169,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					FlushBufferMock.Return(),
 				Uploader: mocks.NewLogUploaderMock(mc).
 					MakeMultipartMapMock.Return(map[string]io.Reader{}).
-					UploadLogFileMock.Expect(map[string]io.Reader{}).Return(nil),
+					UploadLogFileMock.Inspect(expectPayload(t, map[string]io.Reader{})).Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
 				Match: mocks.NewMatcherMock(mc).
 					MapMock.Return("cp_granary_pro_rc8").
 					DomainMock.Return("test").
@@ -179,9 +185,16 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					{SteamID: "76561198439712695"},
 				}).
 					LengthSecondsMock.Return(0).
-					StringMock.Return("test#1").
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(time.Time{}).
+					EndedAtMock.Return(time.Time{}).
+					RoundsMock.Return(nil).
+					LogIDMock.Return(0).
+					LogURLMock.Return("").
+					IncompleteMock.Return(false).
+					ServerIDMock.Return(1).
 					FlushMock.Return(),
-				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Expect([]interface{}{
+				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Inspect(expectStats(t, []interface{}{
 					stats.MongoPlayerInfo{
 						Player:        &stats.PickupPlayer{SteamID: "76561198439712695"},
 						Stats:         stats.PlayerStats{Kills: 1},
@@ -190,7 +203,8 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 						Length:        0,
 						SchemaVersion: 1,
 					},
-				}).Return(nil),
+				})).Return(nil).
+					InsertMatchMock.Return(nil),
 			},
 		},
 		{
@@ -202,6 +216,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				State: stateMachine.Game,
 				log:   log,
 				File:  mocks.NewLogFilerMock(mc).WriteLineMock.Expect(`: World triggered "Round_Win" (winner "Red")`).Return(),
+				Match: mocks.NewMatcherMock(mc).AddRoundMock.Expect(`: World triggered "Round_Win" (winner "Red")`).Return(),
 			},
 		},
 		{
@@ -251,7 +266,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					FlushBufferMock.Return(),
 				Uploader: mocks.NewLogUploaderMock(mc).
 					MakeMultipartMapMock.Return(map[string]io.Reader{}).
-					UploadLogFileMock.Expect(map[string]io.Reader{}).Return(nil),
+					UploadLogFileMock.Inspect(expectPayload(t, map[string]io.Reader{})).Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
 				Match: mocks.NewMatcherMock(mc).
 					MapMock.Return("cp_granary_pro_rc8").
 					DomainMock.Return("test").
@@ -264,9 +279,16 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					{SteamID: "76561198439712695"},
 				}).
 					LengthSecondsMock.Return(0).
-					StringMock.Return("test#1").
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(time.Time{}).
+					EndedAtMock.Return(time.Time{}).
+					RoundsMock.Return(nil).
+					LogIDMock.Return(0).
+					LogURLMock.Return("").
+					IncompleteMock.Return(false).
+					ServerIDMock.Return(1).
 					FlushMock.Return(),
-				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Expect([]interface{}{
+				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Inspect(expectStats(t, []interface{}{
 					stats.MongoPlayerInfo{
 						Player:        &stats.PickupPlayer{SteamID: "76561198439712695"},
 						Stats:         stats.PlayerStats{Kills: 1},
@@ -275,7 +297,8 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 						Length:        0,
 						SchemaVersion: 1,
 					},
-				}).Return(nil),
+				})).Return(nil).
+					InsertMatchMock.Return(nil),
 			},
 		},
 		{
@@ -296,6 +319,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				State: stateMachine.RoundReset,
 				log:   log,
 				File:  mocks.NewLogFilerMock(mc).WriteLineMock.Expect(`: World triggered "Round_Length" (seconds "350.12")`).Return(),
+				Match: mocks.NewMatcherMock(mc).SetRoundLengthMock.Expect(`: World triggered "Round_Length" (seconds "350.12")`).Return(),
 			},
 		},
 		{
@@ -312,7 +336,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					FlushBufferMock.Return(),
 				Uploader: mocks.NewLogUploaderMock(mc).
 					MakeMultipartMapMock.Return(map[string]io.Reader{}).
-					UploadLogFileMock.Expect(map[string]io.Reader{}).Return(errors.New("test error")),
+					UploadLogFileMock.Inspect(expectPayload(t, map[string]io.Reader{})).Return(nil, errors.New("test error")),
 				Match: mocks.NewMatcherMock(mc).
 					MapMock.Return("cp_granary_pro_rc8").
 					DomainMock.Return("test").
@@ -328,9 +352,16 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					{SteamID: "76561198439712695"},
 				}).
 					LengthSecondsMock.Return(0).
-					StringMock.Return("test#1").
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(time.Time{}).
+					EndedAtMock.Return(time.Time{}).
+					RoundsMock.Return(nil).
+					LogIDMock.Return(0).
+					LogURLMock.Return("").
+					IncompleteMock.Return(false).
+					ServerIDMock.Return(1).
 					FlushMock.Return(),
-				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Expect([]interface{}{
+				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Inspect(expectStats(t, []interface{}{
 					stats.MongoPlayerInfo{
 						Player:        &stats.PickupPlayer{SteamID: "76561198439712695"},
 						Stats:         stats.PlayerStats{Kills: 1},
@@ -339,7 +370,53 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 						Length:        0,
 						SchemaVersion: 1,
 					},
-				}).Return(nil),
+				})).Return(nil).
+					InsertMatchMock.Return(nil),
+			},
+		},
+		{
+			name: "error in UploadLogFile queued to outbox",
+			args: args{
+				msg: `: World triggered "Game_Over" reason "`,
+			},
+			fields: fields{
+				State: stateMachine.Game,
+				log:   log,
+				File: mocks.NewLogFilerMock(mc).
+					WriteLineMock.Expect(`: World triggered "Game_Over" reason "`).Return().
+					BufferMock.Return(*bytes.NewBufferString("log")).
+					FlushBufferMock.Return(),
+				Uploader: mocks.NewLogUploaderMock(mc).
+					MakeMultipartMapMock.Return(map[string]io.Reader{}).
+					UploadLogFileMock.Inspect(expectPayload(t, map[string]io.Reader{})).Return(nil, errors.New("test error")),
+				Match: mocks.NewMatcherMock(mc).
+					MapMock.Return("cp_granary_pro_rc8").
+					DomainMock.Return("test").
+					PickupIDMock.Return(5).
+					IncompleteMock.Return(false).
+					PlayerStatsMock.Return(stats.PlayerStatsCollection{}).
+					SetPlayerStatsMock.Return().
+					SetLengthMock.Expect(`: World triggered "Game_Over" reason "`).Return().
+					PickupPlayersMock.Return(nil).
+					LengthSecondsMock.Return(0).
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(time.Time{}).
+					EndedAtMock.Return(time.Time{}).
+					RoundsMock.Return(nil).
+					LogIDMock.Return(0).
+					LogURLMock.Return("").
+					ServerIDMock.Return(1).
+					FlushMock.Return(),
+				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil),
+				Outbox: mocks.NewEnqueuerMock(mc).EnqueueMock.Expect(outbox.Entry{
+					Server:    "test#1",
+					Domain:    "test",
+					PickupID:  5,
+					Map:       "cp_granary_pro_rc8",
+					Title:     "tf2pickup.test #5",
+					LastError: "test error",
+				}, *bytes.NewBufferString("log")).Return(nil),
+				Pickups: mocks.NewPickupProviderMock(mc).SiteMock.Return("tf2pickup.test"),
 			},
 		},
 		{
@@ -356,7 +433,7 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					FlushBufferMock.Return(),
 				Uploader: mocks.NewLogUploaderMock(mc).
 					MakeMultipartMapMock.Return(map[string]io.Reader{}).
-					UploadLogFileMock.Expect(map[string]io.Reader{}).Return(nil),
+					UploadLogFileMock.Inspect(expectPayload(t, map[string]io.Reader{})).Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
 				Match: mocks.NewMatcherMock(mc).
 					MapMock.Return("cp_granary_pro_rc8").
 					DomainMock.Return("test").
@@ -372,9 +449,16 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 					{SteamID: "76561198439712695"},
 				}).
 					LengthSecondsMock.Return(0).
-					StringMock.Return("test#1").
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(time.Time{}).
+					EndedAtMock.Return(time.Time{}).
+					RoundsMock.Return(nil).
+					LogIDMock.Return(0).
+					LogURLMock.Return("").
+					IncompleteMock.Return(false).
+					ServerIDMock.Return(1).
 					FlushMock.Return(),
-				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Expect([]interface{}{
+				Mongo: mocks.NewInserterMock(mc).InsertGameStatsMock.Inspect(expectStats(t, []interface{}{
 					stats.MongoPlayerInfo{
 						Player:        &stats.PickupPlayer{SteamID: "76561198439712695"},
 						Stats:         stats.PlayerStats{Kills: 1},
@@ -383,7 +467,8 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 						Length:        0,
 						SchemaVersion: 1,
 					},
-				}).Return(errors.New("test error")),
+				})).Return(errors.New("test error")).
+					InsertMatchMock.Return(nil),
 			},
 		},
 	}
@@ -396,7 +481,9 @@ func TestStateMachine_ProcessLogLine(t *testing.T) {
 				Uploader: tt.fields.Uploader,
 				Match:    tt.fields.Match,
 				Mongo:    tt.fields.Mongo,
+				Outbox:   tt.fields.Outbox,
 			}
+			sm.SetPickupProvider(tt.fields.Pickups)
 			sm.ProcessLogLine(tt.args.msg)
 		})
 	}
@@ -437,3 +524,731 @@ func TestStateType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestStateMachine_Stop(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	tests := []struct {
+		name  string
+		state stateMachine.StateType
+		file  server.LogFiler
+		match stats.Matcher
+	}{
+		{
+			name:  "pregame",
+			state: stateMachine.Pregame,
+			file:  mocks.NewLogFilerMock(mc).NameMock.Return("test#1"),
+			match: mocks.NewMatcherMock(mc),
+		},
+		{
+			name:  "discard game in progress",
+			state: stateMachine.Game,
+			file:  mocks.NewLogFilerMock(mc).NameMock.Return("test#1").FlushBufferMock.Return(),
+			match: mocks.NewMatcherMock(mc).FlushMock.Return(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := stateMachine.NewStateMachine(log, tt.file, mocks.NewLogUploaderMock(mc), tt.match, mocks.NewInserterMock(mc))
+			sm.State = tt.state
+			go sm.StartWorker()
+			sm.Stop(true)
+			if sm.State != stateMachine.Pregame {
+				t.Errorf("Stop() state = %v, want %v", sm.State, stateMachine.Pregame)
+			}
+		})
+	}
+}
+
+func TestStateMachine_Recover(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	roundStart := `L 10/01/2021 - 21:38:46: World triggered "Round_Start"`
+	kill := `L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" killed "KEYREAL<65><[U:1:861133286]><Red>" with "sniperrifle"`
+
+	gameOver := `L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`
+
+	tests := []struct {
+		name      string
+		modTime   time.Time
+		finished  bool
+		uploader  requests.LogUploader
+		pickups   requests.PickupProvider
+		inserter  mongo.Inserter
+		wantState stateMachine.StateType
+	}{
+		{
+			name:     "resume match",
+			modTime:  time.Now(),
+			uploader: mocks.NewLogUploaderMock(mc),
+			// pickup is restored from journal, pickup API isn't asked again
+			pickups:   mocks.NewPickupProviderMock(mc),
+			inserter:  mocks.NewInserterMock(mc),
+			wantState: stateMachine.Game,
+		},
+		{
+			name:    "finish stale match",
+			modTime: time.Now().Add(-time.Hour),
+			uploader: mocks.NewLogUploaderMock(mc).
+				MakeMultipartMapMock.Return(map[string]io.Reader{}).
+				UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
+			pickups: mocks.NewPickupProviderMock(mc).
+				SiteMock.Return("tf2pickup.test").
+				ReportLogsURLMock.Inspect(expectLogsURL(t, 1, "https://logs.tf/1")).Return(nil),
+			inserter:  mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil),
+			wantState: stateMachine.Pregame,
+		},
+		{
+			name:     "finalize finished match",
+			modTime:  time.Now(),
+			finished: true,
+			uploader: mocks.NewLogUploaderMock(mc).
+				MakeMultipartMapMock.Return(map[string]io.Reader{}).
+				UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
+			pickups: mocks.NewPickupProviderMock(mc).
+				SiteMock.Return("tf2pickup.test").
+				ReportLogsURLMock.Inspect(expectLogsURL(t, 1, "https://logs.tf/1")).Return(nil),
+			inserter: mocks.NewInserterMock(mc).
+				InsertGameStatsMock.Return(nil).
+				InsertMatchMock.Inspect(func(_ context.Context, document interface{}) {
+					if info := document.(stats.MongoMatchInfo); info.Incomplete || info.Length != 1800 {
+						t.Errorf("InsertMatch() got %+v, want complete match", info)
+					}
+				}).Return(nil),
+			wantState: stateMachine.Pregame,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Journal{Dir: t.TempDir()}
+			content := "#map cp_granary_pro_rc8\n" + roundStart + "\n" + `#pickup {"Players":null,"ID":1}` + "\n" + kill + "\n"
+			path := filepath.Join(cfg.Dir, server.JournalName(client))
+			if tt.finished {
+				content += gameOver + "\n"
+				path += ".1.finished"
+			}
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chtimes(path, tt.modTime, tt.modTime); err != nil {
+				t.Fatal(err)
+			}
+			journal, err := server.NewJournalFile(client, cfg, log)
+			if err != nil {
+				t.Fatalf("NewJournalFile() error = %v", err)
+			}
+			defer journal.Close()
+
+			match := stats.NewMatch(client)
+			sm := stateMachine.NewStateMachine(log, journal, tt.uploader, match, tt.inserter)
+			sm.SetPickupProvider(tt.pickups)
+			notifier := &recordingNotifier{}
+			sm.Notifier = notifier
+			bus := events.NewBus()
+			sub := bus.Subscribe(events.Filter{}, 0)
+			defer sub.Close()
+			sm.Events = bus
+			if err = sm.Recover(10 * time.Minute); err != nil {
+				t.Fatalf("Recover() error = %v", err)
+			}
+			// match start and replayed lines were announced before restart, only finalization is new
+			for _, p := range notifier.payloads {
+				if p.Event != notify.MatchEnd {
+					t.Errorf("Recover() sent %s notification, want match_end only", p.Event)
+				}
+			}
+			for len(sub.C) > 0 {
+				if e := <-sub.C; e.Type != events.MatchEnd {
+					t.Errorf("Recover() published %s event, want match_end only", e.Type)
+				}
+			}
+			if sm.State != tt.wantState {
+				t.Errorf("Recover() state = %v, want %v", sm.State, tt.wantState)
+			}
+			if tt.wantState == stateMachine.Game && match.Map() != "cp_granary_pro_rc8" {
+				t.Errorf("Recover() map = %v, want %v", match.Map(), "cp_granary_pro_rc8")
+			}
+			if tt.wantState == stateMachine.Game && match.PickupID() != 1 {
+				t.Errorf("Recover() pickup id = %v, want %v", match.PickupID(), 1)
+			}
+			if files, _ := filepath.Glob(filepath.Join(cfg.Dir, "*.finished")); len(files) != 0 {
+				t.Errorf("Recover() left journals of finished matches %v", files)
+			}
+			if content, _ := os.ReadFile(path); tt.wantState == stateMachine.Game && !bytes.Contains(content, []byte(kill)) {
+				t.Errorf("Recover() journal of resumed match = %q, want replayed lines kept", content)
+			}
+		})
+	}
+}
+
+type recordingSink struct {
+	uploads []*sink.Upload
+}
+
+func (r *recordingSink) Name() string {
+	return "recording"
+}
+
+func (r *recordingSink) Upload(_ context.Context, u *sink.Upload) (*sink.Result, error) {
+	r.uploads = append(r.uploads, u)
+	return &sink.Result{URL: "test"}, nil
+}
+
+func TestStateMachine_SetSinks(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	file := server.NewLogFile(client)
+	file.WriteLine(`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`)
+	recording := &recordingSink{}
+	// logs.tf uploader is not expected to be called
+	sm := stateMachine.NewStateMachine(log, file, mocks.NewLogUploaderMock(mc), stats.NewMatch(client),
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	sm.SetSinks([]sink.UploadSink{recording})
+	sm.ProcessGameOverEvent(`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`)
+
+	if len(recording.uploads) != 1 {
+		t.Fatalf("SetSinks() sink got %d uploads, want 1", len(recording.uploads))
+	}
+	got := recording.uploads[0]
+	if got.Domain != "test" || got.LogsURL != "" || !bytes.Contains(got.Log, []byte("Round_Start")) {
+		t.Errorf("SetSinks() sink got upload %+v", got)
+	}
+}
+
+func TestStateMachine_SetSinksLogsTF(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	recording := &recordingSink{}
+	mirror := sink.NewLogsTF("mirror", "https://logs.example.com", mocks.NewLogUploaderMock(mc).
+		MakeMultipartMapMock.Return(map[string]io.Reader{}).
+		UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil))
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client), mocks.NewLogUploaderMock(mc), stats.NewMatch(client),
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	// other sinks get url of log uploaded to logs.tf compatible sink
+	sm.SetSinks([]sink.UploadSink{recording, mirror})
+	sm.ProcessGameOverEvent(`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`)
+
+	if len(recording.uploads) != 1 || recording.uploads[0].LogsURL != "https://logs.example.com/1" {
+		t.Errorf("SetSinks() sink got uploads %+v, want logs url of mirror", recording.uploads)
+	}
+}
+
+func TestStateMachine_Archive(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	dir := t.TempDir()
+	a, err := archive.New(config.Archive{Dir: dir}, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client), mocks.NewLogUploaderMock(mc), stats.NewMatch(client),
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	sm.SetSinks([]sink.UploadSink{})
+	sm.Archive = a
+	sm.ProcessGameOverEvent(`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "test_1_0_*"))
+	if len(files) != 2 {
+		t.Errorf("ProcessGameOverEvent() archived files = %v, want log and metadata", files)
+	}
+}
+
+func TestStateMachine_Snapshot(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc), stats.NewMatch(client), mocks.NewInserterMock(mc))
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		FindMatchingPickupMock.Return(&requests.Pickup{ID: 5}, nil).
+		ResolvePlayersMock.Return(nil))
+	go sm.StartWorker()
+
+	lines := []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+	}
+	kill := `L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" killed "KEYREAL<65><[U:1:861133286]><Red>" with "sniperrifle"`
+	for i := 0; i < 50; i++ {
+		lines = append(lines, kill)
+	}
+	// snapshots are taken concurrently with worker, run with -race to check
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, line := range lines {
+			sm.Queue.Push(line)
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		sm.Snapshot()
+	}
+	<-done
+	sm.Suspend() // waits for the last line to be processed
+
+	got := sm.Snapshot()
+	if got.Server != "test#1" || got.State != "game" || got.PickupID != 5 || got.LastPacket.IsZero() || got.BufferSize == 0 {
+		t.Errorf("Snapshot() = %+v", got)
+	}
+	if kills := got.Players["76561198439712695"].Kills; kills != 50 {
+		t.Errorf("Snapshot() kills = %v, want 50", kills)
+	}
+}
+
+func TestStateMachine_SnapshotDuringPickupLookup(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	lookup := make(chan struct{})
+	release := make(chan struct{})
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc), stats.NewMatch(client), mocks.NewInserterMock(mc))
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		FindMatchingPickupMock.Set(func(ctx context.Context, gameMap string) (*requests.Pickup, error) {
+		close(lookup)
+		<-release
+		return &requests.Pickup{ID: 5}, nil
+	}).
+		ResolvePlayersMock.Return(nil))
+	go sm.StartWorker()
+
+	sm.Queue.Push(`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`)
+	sm.Queue.Push(`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`)
+	<-lookup
+	// worker is blocked by pickup API, snapshot of the previous line is returned
+	if got := sm.Snapshot(); got.State != "pregame" || got.Map != "cp_process_final" {
+		t.Errorf("Snapshot() during lookup = %+v", got)
+	}
+	close(release)
+	waitSnapshot(t, sm, "after lookup", func(s stateMachine.Snapshot) bool {
+		return s.State == "game" && s.PickupID == 5
+	})
+	sm.Suspend()
+}
+
+func TestStateMachine_Events(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc), stats.NewMatch(client),
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	sm.SetSinks([]sink.UploadSink{})
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		FindMatchingPickupMock.Return(&requests.Pickup{ID: 5}, nil).
+		ResolvePlayersMock.Return(nil))
+	bus := events.NewBus()
+	sm.Events = bus
+	sub := bus.Subscribe(events.Filter{}, 0)
+	defer sub.Close()
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" killed "KEYREAL<65><[U:1:861133286]><Red>" with "sniperrifle"`,
+		`L 10/01/2021 - 21:40:00: World triggered "Round_Win" (winner "Red")`,
+		`L 10/01/2021 - 21:40:00: Team "Red" current score "1" with "6" players`,
+		`L 10/01/2021 - 21:40:10: World triggered "Round_Start"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.ProcessLogLine(line)
+	}
+
+	want := []events.Type{
+		events.MatchStart, events.RoundStart, events.Kill, events.RoundWin,
+		events.ScoreChange, events.RoundStart, events.MatchEnd,
+	}
+	for i, wantType := range want {
+		select {
+		case e := <-sub.C:
+			if e.Type != wantType || e.Server != "test#1" {
+				t.Fatalf("event %d = %s of %s, want %s of test#1", i, e.Type, e.Server, wantType)
+			}
+			if e.Type == events.MatchEnd {
+				data := e.Data.(*events.MatchEndData)
+				if data.PickupID != 5 || data.Map != "cp_process_final" || data.Scores.Red != 1 {
+					t.Errorf("match end data = %+v", data)
+				}
+			}
+		default:
+			t.Fatalf("got %d events, want %d", i, len(want))
+		}
+	}
+}
+
+type recordingNotifier struct {
+	payloads []notify.Payload
+	posts    map[string][]byte
+}
+
+func (r *recordingNotifier) Notify(p notify.Payload) {
+	r.payloads = append(r.payloads, p)
+}
+
+func (r *recordingNotifier) Post(name, url string, event notify.Event, body []byte) {
+	if r.posts == nil {
+		r.posts = make(map[string][]byte)
+	}
+	r.posts[url] = body
+}
+
+func TestStateMachine_Notifier(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc).
+			MakeMultipartMapMock.Return(map[string]io.Reader{}).
+			UploadLogFileMock.Return(nil, errors.New("logs.tf is down")),
+		stats.NewMatch(client), mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		SiteMock.Return("tf2pickup.test").
+		FindMatchingPickupMock.Return(&requests.Pickup{ID: 5}, nil).
+		ResolvePlayersMock.Return(nil))
+	notifier := &recordingNotifier{}
+	sm.Notifier = notifier
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.ProcessLogLine(line)
+	}
+
+	var got []notify.Event
+	for _, p := range notifier.payloads {
+		got = append(got, p.Event)
+		if p.Server != "test#1" || p.Domain != "test" || p.PickupID != 5 || p.Map != "cp_process_final" {
+			t.Errorf("%s payload = %+v", p.Event, p)
+		}
+	}
+	want := []notify.Event{notify.MatchStart, notify.UploadFailed, notify.MatchEnd}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("notifications mismatch (-want +got):\n%s", diff)
+	}
+	if failed := notifier.payloads[1]; failed.Error != "logs.tf is down" || failed.Length != 1800 {
+		t.Errorf("upload failed payload = %+v", failed)
+	}
+}
+
+func TestStateMachine_SetDiscordWebhook(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	client := config.Client{Server: 1, Domain: "test"}
+	match := stats.NewMatch(client)
+	match.SetPickupID(5)
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client), mocks.NewLogUploaderMock(mc), match,
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	sm.SetSinks([]sink.UploadSink{})
+	sm.SetPickupProvider(&requests.TF2Pickup{BaseURL: "https://api.tf2pickup.test"})
+	sm.SetDiscordWebhook("http://discord/webhook")
+	notifier := &recordingNotifier{}
+	sm.Notifier = notifier
+	sm.ProcessGameOverEvent(`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`)
+
+	var message discord.Message
+	if err := json.Unmarshal(notifier.posts["http://discord/webhook"], &message); err != nil {
+		t.Fatalf("Discord message was not posted: %s", err)
+	}
+	if len(message.Embeds) != 1 || message.Embeds[0].Title != "tf2pickup.test #5" {
+		t.Errorf("Discord message = %+v", message)
+	}
+}
+
+func TestStateMachine_NoPickup(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	inserted := make(chan stats.MongoMatchInfo, 1)
+	client := config.Client{Server: 1, Domain: "scrim", Pickup: config.Pickup{Provider: requests.ProviderNone}}
+	// pickup API isn't called, but log is still uploaded and stats are saved
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc).
+			MakeMultipartMapMock.Return(map[string]io.Reader{}).
+			UploadLogFileMock.Return(&requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil),
+		stats.NewMatch(client),
+		mocks.NewInserterMock(mc).
+			InsertGameStatsMock.Return(nil).
+			InsertMatchMock.Set(func(ctx context.Context, document interface{}) error {
+			inserted <- document.(stats.MongoMatchInfo)
+			return nil
+		}))
+	sm.SetPickupProvider(requests.NoPickup{})
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 21:39:46: "jel<62><[U:1:479446967]><Blue>" killed "KEYREAL<65><[U:1:861133286]><Red>" with "sniperrifle"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.ProcessLogLine(line)
+	}
+	if info := <-inserted; info.Map != "cp_process_final" || info.PickupID != 0 || info.LogID != 1 {
+		t.Errorf("inserted match = %+v", info)
+	}
+}
+
+func TestStateMachine_Finalizer(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	release := make(chan struct{})
+	uploaded := make(chan string, 1)
+	inserted := make(chan stats.MongoMatchInfo, 1)
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc).
+			MakeMultipartMapMock.Set(func(title, gameMap string, buf bytes.Buffer) map[string]io.Reader {
+			uploaded <- buf.String()
+			return map[string]io.Reader{}
+		}).
+			UploadLogFileMock.Set(func(ctx context.Context, payload map[string]io.Reader) (*requests.UploadResult, error) {
+			<-release
+			return nil, errors.New("logs.tf is down")
+		}),
+		stats.NewMatch(client),
+		mocks.NewInserterMock(mc).
+			InsertGameStatsMock.Return(nil).
+			InsertMatchMock.Set(func(ctx context.Context, document interface{}) error {
+			inserted <- document.(stats.MongoMatchInfo)
+			return nil
+		}))
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		SiteMock.Return("tf2pickup.test").
+		FindMatchingPickupMock.Return(&requests.Pickup{ID: 5}, nil).
+		ResolvePlayersMock.Return(nil))
+	pool := finalizer.NewPool(1)
+	sm.Finalizer = pool
+	go sm.StartWorker()
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.Queue.Push(line)
+	}
+	// upload of finished match is still blocked, but the next match is already collected
+	waitSnapshot(t, sm, "after game over", func(s stateMachine.Snapshot) bool {
+		return s.State == "pregame" && s.Map == "" && s.BufferSize == 0 && !s.LastPacket.IsZero()
+	})
+	sm.Queue.Push(`L 10/01/2021 - 22:09:30: Loading map "koth_product_final"`)
+	sm.Queue.Push(`L 10/01/2021 - 22:09:46: World triggered "Round_Start"`)
+	waitSnapshot(t, sm, "of next match", func(s stateMachine.Snapshot) bool {
+		return s.State == "game" && s.Map == "koth_product_final"
+	})
+
+	close(release)
+	sm.Suspend()
+	pool.Close()
+	if log := <-uploaded; !strings.Contains(log, "21:38:46") || strings.Contains(log, "22:09:46") {
+		t.Errorf("uploaded log = %s", log)
+	}
+	if info := <-inserted; info.Map != "cp_process_final" || info.PickupID != 5 || info.Length != 1800 {
+		t.Errorf("inserted match = %+v", info)
+	}
+}
+
+// waitSnapshot waits for worker to process lines until snapshot matches ok
+func waitSnapshot(t *testing.T, sm *stateMachine.StateMachine, name string, ok func(stateMachine.Snapshot) bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := sm.Snapshot()
+		if ok(got) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Snapshot() %s = %+v", name, got)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestStateMachine_JournalKeptUntilFinalized(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	release := make(chan struct{})
+	client := config.Client{Server: 1, Domain: "test"}
+	cfg := config.Journal{Dir: t.TempDir()}
+	journal, err := server.NewJournalFile(client, cfg, log)
+	if err != nil {
+		t.Fatalf("NewJournalFile() error = %v", err)
+	}
+	defer journal.Close()
+	sm := stateMachine.NewStateMachine(log, journal,
+		mocks.NewLogUploaderMock(mc).
+			MakeMultipartMapMock.Return(map[string]io.Reader{}).
+			UploadLogFileMock.Set(func(ctx context.Context, payload map[string]io.Reader) (*requests.UploadResult, error) {
+			<-release
+			return &requests.UploadResult{Success: true, LogID: 1, URL: "/1"}, nil
+		}),
+		stats.NewMatch(client),
+		mocks.NewInserterMock(mc).InsertGameStatsMock.Return(nil).InsertMatchMock.Return(nil))
+	pool := finalizer.NewPool(1)
+	sm.Finalizer = pool
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.ProcessLogLine(line)
+	}
+	finished := filepath.Join(cfg.Dir, server.JournalName(client)+".*.finished")
+	if files, _ := filepath.Glob(finished); len(files) != 1 {
+		t.Errorf("journal of match being finalized = %v, want it kept", files)
+	}
+
+	close(release)
+	pool.Close()
+	if files, _ := filepath.Glob(finished); len(files) != 0 {
+		t.Errorf("journal of finalized match = %v, want it removed", files)
+	}
+}
+
+func TestStateMachine_SetContext(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.FatalLevel)
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := config.Client{Server: 1, Domain: "test"}
+	sm := stateMachine.NewStateMachine(log, server.NewLogFile(client),
+		mocks.NewLogUploaderMock(mc).
+			MakeMultipartMapMock.Return(map[string]io.Reader{}).
+			UploadLogFileMock.Set(func(ctx context.Context, _ map[string]io.Reader) (*requests.UploadResult, error) {
+				return nil, ctx.Err()
+			}),
+		stats.NewMatch(client),
+		mocks.NewInserterMock(mc).
+			InsertGameStatsMock.Set(func(ctx context.Context, _ []interface{}) error {
+				return ctx.Err()
+			}).
+			InsertMatchMock.Set(func(ctx context.Context, _ interface{}) error {
+				return ctx.Err()
+			}))
+	sm.SetPickupProvider(mocks.NewPickupProviderMock(mc).
+		SiteMock.Return("tf2pickup.test").
+		FindMatchingPickupMock.Set(func(ctx context.Context, gameMap string) (*requests.Pickup, error) {
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Second {
+				t.Errorf("FindMatchingPickup() deadline = %v, want configured timeout", deadline)
+			}
+			cancel() // e.g. shutdown takes too long
+			return nil, ctx.Err()
+		}))
+	sm.SetContext(ctx)
+	sm.Timeouts = config.Timeouts{PickupAPI: time.Second}
+	sm.Outbox = mocks.NewEnqueuerMock(mc).EnqueueMock.Inspect(func(entry outbox.Entry, _ bytes.Buffer) {
+		if entry.LastError != context.Canceled.Error() {
+			t.Errorf("Enqueue() last error = %v, want %v", entry.LastError, context.Canceled)
+		}
+	}).Return(nil)
+
+	for _, line := range []string{
+		`L 10/01/2021 - 21:38:30: Loading map "cp_process_final"`,
+		`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`,
+		`L 10/01/2021 - 22:08:46: World triggered "Game_Over" reason "Reached Win Limit"`,
+	} {
+		sm.ProcessLogLine(line)
+	}
+}
+
+// expect helpers check arguments of mock methods which take context, it is created per request so it can't be expected
+
+func expectFindPickup(t *testing.T, gameMap string) func(context.Context, string) {
+	return func(_ context.Context, gotMap string) {
+		if gotMap != gameMap {
+			t.Errorf("FindMatchingPickup() got %v, want %v", gotMap, gameMap)
+		}
+	}
+}
+
+func expectResolvePlayers(t *testing.T, players []*stats.PickupPlayer) func(context.Context, []*stats.PickupPlayer) {
+	return func(_ context.Context, gotPlayers []*stats.PickupPlayer) {
+		if !minimock.Equal(players, gotPlayers) {
+			t.Errorf("ResolvePlayers() got unexpected params: %s", minimock.Diff(players, gotPlayers))
+		}
+	}
+}
+
+func expectPayload(t *testing.T, payload map[string]io.Reader) func(context.Context, map[string]io.Reader) {
+	return func(_ context.Context, got map[string]io.Reader) {
+		if !minimock.Equal(payload, got) {
+			t.Errorf("UploadLogFile() got unexpected payload: %s", minimock.Diff(payload, got))
+		}
+	}
+}
+
+func expectLogsURL(t *testing.T, pickupID int, logsURL string) func(context.Context, int, string) {
+	return func(_ context.Context, gotID int, gotURL string) {
+		if gotID != pickupID || gotURL != logsURL {
+			t.Errorf("ReportLogsURL() got %v, %v, want %v, %v", gotID, gotURL, pickupID, logsURL)
+		}
+	}
+}
+
+func expectStats(t *testing.T, documents []interface{}) func(context.Context, []interface{}) {
+	return func(_ context.Context, got []interface{}) {
+		if !minimock.Equal(documents, got) {
+			t.Errorf("InsertGameStats() got unexpected documents: %s", minimock.Diff(documents, got))
+		}
+	}
+}
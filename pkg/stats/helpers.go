@@ -8,7 +8,10 @@ import (
 	"github.com/leighmacdonald/steamid/steamid"
 )
 
-const CurrentStatsSchemaVersion = 1
+const (
+	CurrentStatsSchemaVersion = 1
+	CurrentMatchSchemaVersion = 1
+)
 
 var (
 	timeStamp = regexp.MustCompile(`\d{2}/\d{2}/\d{4} - \d{2}:\d{2}:\d{2}`)
@@ -96,8 +99,56 @@ func ExtractPlayerStats(md Matcher) []interface{} {
 	return s
 }
 
+// ExtractMatchInfo returns match document with game info and round history
+func ExtractMatchInfo(md Matcher) MongoMatchInfo {
+	return MongoMatchInfo{
+		Server:        md.String(),
+		Domain:        md.Domain(),
+		PickupID:      md.PickupID(),
+		Map:           md.Map(),
+		Scores:        md.Score(),
+		LaunchedAt:    md.LaunchedAt(),
+		EndedAt:       md.EndedAt(),
+		Length:        md.LengthSeconds(),
+		Rounds:        md.Rounds(),
+		Incomplete:    md.Incomplete(),
+		LogID:         md.LogID(),
+		LogURL:        md.LogURL(),
+		SchemaVersion: CurrentMatchSchemaVersion,
+	}
+}
+
 func ParseTimeStamp(msg string) time.Time {
 	match := timeStamp.FindString(msg)
 	t, _ := time.Parse(`01/2/2006 - 15:04:05`, match) // err is always nil
 	return t
 }
+
+// CopyMatch returns detached copy of match, so finished match can be processed
+// while original one is flushed and reused for the next match
+func CopyMatch(md Matcher) *Match {
+	m := &Match{
+		pickupID:    md.PickupID(),
+		domain:      md.Domain(),
+		serverID:    md.ServerID(),
+		_map:        md.Map(),
+		launchedAt:  md.LaunchedAt(),
+		endedAt:     md.EndedAt(),
+		matchLength: time.Duration(md.LengthSeconds()) * time.Second,
+		incomplete:  md.Incomplete(),
+		logID:       md.LogID(),
+		logURL:      md.LogURL(),
+		Scores:      md.Score(),
+		stats:       make(PlayerStatsCollection, len(md.PlayerStats())),
+	}
+	for _, player := range md.PickupPlayers() {
+		p := *player
+		m.players = append(m.players, &p)
+	}
+	for id, s := range md.PlayerStats() {
+		ps := *s
+		m.stats[id] = &ps
+	}
+	m.rounds = append(m.rounds, md.Rounds()...)
+	return m
+}
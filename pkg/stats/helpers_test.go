@@ -1,6 +1,7 @@
 package stats_test
 
 import (
+	"LogWatcher/pkg/config"
 	"LogWatcher/pkg/mocks"
 	"LogWatcher/pkg/stats"
 	"reflect"
@@ -180,3 +181,96 @@ func TestExtractPlayerStats(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractMatchInfo(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	launchedAt := time.Date(2021, 10, 1, 21, 38, 46, 0, time.UTC)
+	endedAt := launchedAt.Add(10 * time.Minute)
+	rounds := []stats.Round{{Winner: "Red", EndedAt: endedAt, Length: 600, Scores: stats.CurrentScores{Red: 1}}}
+
+	type args struct {
+		md stats.Matcher
+	}
+	tests := []struct {
+		name string
+		args args
+		want stats.MongoMatchInfo
+	}{
+		{
+			name: "default",
+			args: args{
+				md: mocks.NewMatcherMock(mc).
+					StringMock.Return("test#1").
+					DomainMock.Return("test").
+					PickupIDMock.Return(123).
+					MapMock.Return("cp_process_f9a").
+					ScoreMock.Return(stats.CurrentScores{Red: 1}).
+					LaunchedAtMock.Return(launchedAt).
+					EndedAtMock.Return(endedAt).
+					LengthSecondsMock.Return(600).
+					RoundsMock.Return(rounds).
+					IncompleteMock.Return(false).
+					LogIDMock.Return(3080112).
+					LogURLMock.Return("https://logs.tf/3080112"),
+			},
+			want: stats.MongoMatchInfo{
+				Server:        "test#1",
+				Domain:        "test",
+				PickupID:      123,
+				Map:           "cp_process_f9a",
+				Scores:        stats.CurrentScores{Red: 1},
+				LaunchedAt:    launchedAt,
+				EndedAt:       endedAt,
+				Length:        600,
+				Rounds:        rounds,
+				LogID:         3080112,
+				LogURL:        "https://logs.tf/3080112",
+				SchemaVersion: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stats.ExtractMatchInfo(tt.args.md); !cmp.Equal(got, tt.want) {
+				t.Errorf("ExtractMatchInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyMatch(t *testing.T) {
+	launchedAt := time.Date(2021, 10, 1, 21, 38, 46, 0, time.UTC)
+	steamID := steamid.SID64FromString("76561198439712695")
+
+	match := stats.NewMatch(config.Client{Domain: "test", Server: 1})
+	match.SetPickupID(123)
+	match.SetMap("cp_process_f9a")
+	match.SetPlayers([]*stats.PickupPlayer{{SteamID: "76561198439712695", Name: "player"}})
+	match.SetPlayerStats(stats.PlayerStatsCollection{steamID: {Kills: 1}})
+	match.SetRedScore(1)
+	match.SetIncomplete(true)
+	match.SetLog(3080112, "https://logs.tf/3080112")
+	match.SetStartTime(`L 10/01/2021 - 21:38:46: World triggered "Round_Start"`)
+	match.SetLength(`L 10/01/2021 - 21:48:46: World triggered "Game_Over" reason "Reached Win Limit"`)
+	want := stats.ExtractMatchInfo(match)
+
+	got := stats.CopyMatch(match)
+	match.PlayerStats()[steamID].Kills = 2
+	match.PickupPlayers()[0].Name = "renamed"
+	match.Flush()
+
+	if diff := cmp.Diff(want, stats.ExtractMatchInfo(got)); diff != "" {
+		t.Errorf("CopyMatch() match info mismatch (-want +got):\n%s", diff)
+	}
+	if got.LaunchedAt() != launchedAt {
+		t.Errorf("CopyMatch() launched at = %v, want %v", got.LaunchedAt(), launchedAt)
+	}
+	if diff := cmp.Diff(stats.PlayerStatsCollection{steamID: {Kills: 1}}, got.PlayerStats()); diff != "" {
+		t.Errorf("CopyMatch() player stats mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*stats.PickupPlayer{{SteamID: "76561198439712695", Name: "player"}}, got.PickupPlayers()); diff != "" {
+		t.Errorf("CopyMatch() players mismatch (-want +got):\n%s", diff)
+	}
+}
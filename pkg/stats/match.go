@@ -4,12 +4,17 @@ import (
 	"LogWatcher/pkg/config"
 	"fmt"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/leighmacdonald/steamid/steamid"
 )
 
-var mapLoaded = regexp.MustCompile(`: Loading map "(.+?)"`)
+var (
+	mapLoaded   = regexp.MustCompile(`: Loading map "(.+?)"`)
+	roundWinner = regexp.MustCompile(`: World triggered "Round_Win" \(winner "(Red|Blue)"\)`)
+	roundLength = regexp.MustCompile(`: World triggered "Round_Length" \(seconds "(\d+(?:\.\d+)?)"\)`)
+)
 
 // PlayerStats represents game stats from one player from single game
 type PlayerStats struct {
@@ -42,6 +47,32 @@ type MongoPlayerInfo struct {
 	SchemaVersion int
 }
 
+// MongoMatchInfo represents single game, used as model for mongo entries of match collection
+type MongoMatchInfo struct {
+	Server     string
+	Domain     string
+	PickupID   int
+	Map        string
+	Scores     CurrentScores
+	LaunchedAt time.Time
+	EndedAt    time.Time
+	Length     int
+	Rounds     []Round
+	Incomplete bool
+	// LogID is logs.tf log id, zero if log wasn't uploaded
+	LogID         int
+	LogURL        string
+	SchemaVersion int
+}
+
+// Round represents result of single round, scores are team scores after round has ended
+type Round struct {
+	Winner  string
+	EndedAt time.Time
+	Length  float64
+	Scores  CurrentScores
+}
+
 // CurrentScores represents teams score in single round
 type CurrentScores struct {
 	Red  int
@@ -58,13 +89,19 @@ type Match struct {
 	players     []*PickupPlayer
 	stats       PlayerStatsCollection
 	launchedAt  time.Time
+	endedAt     time.Time
 	matchLength time.Duration
+	incomplete  bool
+	rounds      []Round
+	logID       int
+	logURL      string
 	Scores      CurrentScores
 }
 
 // Matcher is interface for Match object
 type Matcher interface {
 	String() string
+	ServerID() int
 	PickupPlayers() []*PickupPlayer
 	PlayerStats() PlayerStatsCollection
 	SetPlayerStats(stats PlayerStatsCollection)
@@ -81,6 +118,17 @@ type Matcher interface {
 	TryParseGameMap(msg string)
 	SetRedScore(score int)
 	SetBlueScore(score int)
+	SetIncomplete(incomplete bool)
+	Incomplete() bool
+	AddRound(msg string)
+	SetRoundLength(msg string)
+	Rounds() []Round
+	Score() CurrentScores
+	LaunchedAt() time.Time
+	EndedAt() time.Time
+	SetLog(id int, url string)
+	LogID() int
+	LogURL() string
 }
 
 // PlayerStatsCollection represents game stats for all players from single game
@@ -109,6 +157,7 @@ func (m *Match) PickupID() int {
 
 func (m *Match) SetLength(msg string) {
 	ts := ParseTimeStamp(msg)
+	m.endedAt = ts
 	m.matchLength = ts.Sub(m.launchedAt)
 }
 
@@ -124,6 +173,10 @@ func (m *Match) String() string {
 	return fmt.Sprintf("%s#%d", m.domain, m.serverID)
 }
 
+func (m *Match) ServerID() int {
+	return m.serverID
+}
+
 func (m *Match) SetPickupID(id int) {
 	m.pickupID = id
 }
@@ -148,6 +201,11 @@ func (m *Match) Map() string {
 func (m *Match) Flush() {
 	m.pickupID = 0
 	m._map = ""
+	m.incomplete = false
+	m.rounds = nil
+	m.logID = 0
+	m.logURL = ""
+	m.Scores = CurrentScores{}
 	m.stats = make(PlayerStatsCollection)
 }
 
@@ -163,10 +221,75 @@ func (m *Match) SetPlayerStats(stats PlayerStatsCollection) {
 	m.stats = stats
 }
 
+// SetRedScore sets red team score, it is also saved to last round as score is reported after round has ended
 func (m *Match) SetRedScore(score int) {
 	m.Scores.Red = score
+	if len(m.rounds) > 0 {
+		m.rounds[len(m.rounds)-1].Scores.Red = score
+	}
 }
 
+// SetBlueScore sets blue team score, it is also saved to last round as score is reported after round has ended
 func (m *Match) SetBlueScore(score int) {
 	m.Scores.Blue = score
+	if len(m.rounds) > 0 {
+		m.rounds[len(m.rounds)-1].Scores.Blue = score
+	}
+}
+
+func (m *Match) Score() CurrentScores {
+	return m.Scores
+}
+
+// SetIncomplete marks match as cut short, e.g. on shutdown
+func (m *Match) SetIncomplete(incomplete bool) {
+	m.incomplete = incomplete
+}
+
+func (m *Match) Incomplete() bool {
+	return m.incomplete
+}
+
+// AddRound appends round parsed from "Round_Win" message to round history
+func (m *Match) AddRound(msg string) {
+	round := Round{EndedAt: ParseTimeStamp(msg), Scores: m.Scores}
+	if match := roundWinner.FindStringSubmatch(msg); len(match) > 0 {
+		round.Winner = match[1]
+	}
+	m.rounds = append(m.rounds, round)
+}
+
+// SetRoundLength sets length of last round from "Round_Length" message
+func (m *Match) SetRoundLength(msg string) {
+	match := roundLength.FindStringSubmatch(msg)
+	if len(match) == 0 || len(m.rounds) == 0 {
+		return
+	}
+	m.rounds[len(m.rounds)-1].Length, _ = strconv.ParseFloat(match[1], 64) // err is always nil
+}
+
+func (m *Match) Rounds() []Round {
+	return m.rounds
+}
+
+func (m *Match) LaunchedAt() time.Time {
+	return m.launchedAt
+}
+
+func (m *Match) EndedAt() time.Time {
+	return m.endedAt
+}
+
+// SetLog saves id and url of log uploaded to logs.tf
+func (m *Match) SetLog(id int, url string) {
+	m.logID = id
+	m.logURL = url
+}
+
+func (m *Match) LogID() int {
+	return m.logID
+}
+
+func (m *Match) LogURL() string {
+	return m.logURL
 }
@@ -552,3 +552,50 @@ func TestMatch_SetBlueScore(t *testing.T) {
 		})
 	}
 }
+
+func TestMatch_SetIncomplete(t *testing.T) {
+	m := &Match{}
+	m.SetIncomplete(true)
+	if !m.Incomplete() {
+		t.Errorf("Incomplete() = %v, want %v", m.Incomplete(), true)
+	}
+	m.Flush()
+	if m.Incomplete() {
+		t.Errorf("Incomplete() after Flush() = %v, want %v", m.Incomplete(), false)
+	}
+}
+
+func TestMatch_Rounds(t *testing.T) {
+	m := &Match{}
+	m.SetRoundLength(`L 10/01/2021 - 21:40:00: World triggered "Round_Length" (seconds "100.50")`)
+	m.AddRound(`L 10/01/2021 - 21:40:00: World triggered "Round_Win" (winner "Red")`)
+	m.SetRoundLength(`L 10/01/2021 - 21:40:00: World triggered "Round_Length" (seconds "350.12")`)
+	m.SetRedScore(1)
+	m.AddRound(`L 10/01/2021 - 21:45:00: World triggered "Round_Win" (winner "Blue")`)
+	m.SetBlueScore(1)
+
+	want := []Round{
+		{
+			Winner:  "Red",
+			EndedAt: time.Date(2021, 10, 1, 21, 40, 0, 0, time.UTC),
+			Length:  350.12,
+			Scores:  CurrentScores{Red: 1},
+		},
+		{
+			Winner:  "Blue",
+			EndedAt: time.Date(2021, 10, 1, 21, 45, 0, 0, time.UTC),
+			Scores:  CurrentScores{Red: 1, Blue: 1},
+		},
+	}
+	if diff := cmp.Diff(want, m.Rounds()); diff != "" {
+		t.Errorf("Rounds() mismatch (-want +got):\n%s", diff)
+	}
+	if got := m.Score(); got != (CurrentScores{Red: 1, Blue: 1}) {
+		t.Errorf("Score() = %v, want %v", got, CurrentScores{Red: 1, Blue: 1})
+	}
+
+	m.Flush()
+	if len(m.Rounds()) != 0 || m.Score() != (CurrentScores{}) {
+		t.Errorf("Flush() left rounds %v and score %v", m.Rounds(), m.Score())
+	}
+}